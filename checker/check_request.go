@@ -29,6 +29,9 @@ type CheckRequest struct {
 	Dlogger               DetailLogger
 	Repo                  clients.Repo
 	VulnerabilitiesClient clients.VulnerabilitiesClient
+	// EmailDomainOrgs maps lowercase email domains to the organizations
+	// the commit authors using them are affiliated with.
+	EmailDomainOrgs map[string]string
 	// UPGRADEv6: return raw results instead of scores.
 	RawResults    *RawResults
 	RequiredTypes []RequestType
@@ -30,20 +30,28 @@ type RawResults struct {
 	CIIBestPracticesResults     CIIBestPracticesData
 	CITestResults               CITestData
 	CodeReviewResults           CodeReviewData
+	CodeownersResults           CodeownersData
 	ContributorsResults         ContributorsData
 	DangerousWorkflowResults    DangerousWorkflowData
+	DependencyConfusionResults  DependencyConfusionData
 	DependencyUpdateToolResults DependencyUpdateToolData
+	DockerfileHardeningResults  DockerfileHardeningData
 	FuzzingResults              FuzzingData
 	LicenseResults              LicenseData
 	MaintainedResults           MaintainedData
 	Metadata                    MetadataData
 	PackagingResults            PackagingData
 	PinningDependenciesResults  PinningDependenciesData
+	SASTResults                 SASTData
+	SecretsResults              SecretsData
 	SecurityPolicyResults       SecurityPolicyData
+	SecuritySettingsResults     SecuritySettingsData
+	SignedCommitsResults        SignedCommitsData
 	SignedReleasesResults       SignedReleasesData
 	TokenPermissionsResults     TokenPermissionsData
 	VulnerabilitiesResults      VulnerabilitiesData
 	WebhookResults              WebhooksData
+	WorkflowSecretsResults      WorkflowSecretsData
 }
 
 type MetadataData struct {
@@ -51,14 +59,72 @@ type MetadataData struct {
 }
 
 type RevisionCIInfo struct {
-	HeadSHA           string
-	CheckRuns         []clients.CheckRun
-	Statuses          []clients.Status
+	HeadSHA   string
+	CheckRuns []clients.CheckRun
+	Statuses  []clients.Status
+	// Jobs are the CI jobs recognized in the check runs and statuses.
+	Jobs              []CIJob
 	PullRequestNumber int
 }
 
 type CITestData struct {
 	CIInfo []RevisionCIInfo
+	// Configs are the CI configuration files of the repository.
+	Configs []CIConfig
+}
+
+// CISystem is the name of a CI system.
+type CISystem string
+
+const (
+	CIGitHubActions  CISystem = "GitHub Actions"
+	CIGitLab         CISystem = "GitLab CI"
+	CIJenkins        CISystem = "Jenkins"
+	CIBuildkite      CISystem = "Buildkite"
+	CICircleCI       CISystem = "CircleCI"
+	CITravis         CISystem = "Travis CI"
+	CIAzurePipelines CISystem = "Azure Pipelines"
+	CIAppVeyor       CISystem = "AppVeyor"
+	CICirrus         CISystem = "Cirrus CI"
+	CISemaphore      CISystem = "Semaphore"
+	CIDrone          CISystem = "Drone"
+	CIPackit         CISystem = "Packit"
+	CIProw           CISystem = "Prow"
+	// CIOther is a CI system recognized only by the name of its jobs.
+	CIOther CISystem = "other"
+)
+
+// CIJobKind is what a CI job does, inferred from its name.
+type CIJobKind string
+
+const (
+	// CIJobTest runs tests.
+	CIJobTest CIJobKind = "test"
+	// CIJobLint runs linters, formatters or static analysis.
+	CIJobLint CIJobKind = "lint"
+	// CIJobBuild builds, packages or deploys the project.
+	CIJobBuild CIJobKind = "build"
+	// CIJobUnknown is a job whose name does not tell what it does,
+	// e.g. the single status reported by a CI system for a whole pipeline.
+	CIJobUnknown CIJobKind = "unknown"
+)
+
+// CIJob is a CI job run on the head commit of a pull request.
+type CIJob struct {
+	System CISystem
+	Name   string
+	URL    string
+	Kind   CIJobKind
+	// Success is true if the job completed successfully.
+	Success bool
+}
+
+// CIConfig is a configuration file of a CI system.
+type CIConfig struct {
+	System CISystem
+	File   File
+	// RunsTests is true if the configuration runs test commands or test jobs.
+	RunsTests bool
 }
 
 // FuzzingData represents different fuzzing done.
@@ -71,6 +137,46 @@ type FuzzingData struct {
 // PackagingData contains results for the Packaging check.
 type PackagingData struct {
 	Packages []Package
+	// Targets are the registries the packaging pipelines publish to.
+	Targets []PublicationTarget
+}
+
+// PackageRegistry is a registry to which a pipeline publishes packages.
+type PackageRegistry string
+
+const (
+	PackageRegistryNpm       PackageRegistry = "npm"
+	PackageRegistryPyPI      PackageRegistry = "pypi"
+	PackageRegistryMaven     PackageRegistry = "maven"
+	PackageRegistryCratesIO  PackageRegistry = "crates.io"
+	PackageRegistryNuGet     PackageRegistry = "nuget"
+	PackageRegistryRubyGems  PackageRegistry = "rubygems"
+	PackageRegistryHex       PackageRegistry = "hex"
+	PackageRegistryPackagist PackageRegistry = "packagist"
+	PackageRegistryHelm      PackageRegistry = "helm"
+	// PackageRegistryOCI is any OCI registry, e.g., a container registry.
+	PackageRegistryOCI PackageRegistry = "oci"
+	// PackageRegistryReleases is the releases of the repository host,
+	// as published by release tools like goreleaser or release-please.
+	PackageRegistryReleases PackageRegistry = "releases"
+)
+
+// PublicationTarget is a registry to which a pipeline publishes packages.
+type PublicationTarget struct {
+	// File is the location of the publishing command or action.
+	File     File
+	Registry PackageRegistry
+	// Tool is the command or action publishing the packages.
+	Tool string
+	// Name is the name of the package, as declared in the
+	// package manifest of the repository, if any.
+	Name string
+	// Signed is true if the pipeline signs the published artifacts.
+	Signed bool
+	// Verified is true if the registry has a package with this
+	// repository as source, false if it has none, and nil
+	// if the registry could not be queried.
+	Verified *bool
 }
 
 // Package represents a package.
@@ -84,8 +190,27 @@ type Package struct {
 	// Note: Msg is populated only for debug messages.
 	Msg  *string
 	Runs []Run
+	// Auth is the authentication used to publish the package.
+	Auth PublishingAuth
+	// Provenance is true if the workflow generates provenance for the package.
+	Provenance bool
 }
 
+// PublishingAuth is the authentication used to publish a package.
+type PublishingAuth string
+
+const (
+	// PublishingAuthUnknown is used when the authentication could not be determined,
+	// e.g., because the credentials are configured outside of the workflow.
+	PublishingAuthUnknown PublishingAuth = "unknown"
+	// PublishingAuthTrusted is OIDC-based trusted publishing, which uses
+	// short-lived credentials issued for the workflow run.
+	PublishingAuthTrusted PublishingAuth = "trustedPublishing"
+	// PublishingAuthToken is a long-lived registry token or password
+	// stored in the secrets of the repository.
+	PublishingAuthToken PublishingAuth = "longLivedToken"
+)
+
 // DependencyUseType represents a type of dependency use.
 type DependencyUseType string
 
@@ -106,6 +231,23 @@ const (
 	DependencyUseTypePipCommand DependencyUseType = "pipCommand"
 	// DependencyUseTypeNugetCommand is a nuget command.
 	DependencyUseTypeNugetCommand DependencyUseType = "nugetCommand"
+	// DependencyUseTypeManifestContainerImage is a container image referenced by
+	// a Kubernetes, Helm, Kustomize or Compose manifest.
+	DependencyUseTypeManifestContainerImage DependencyUseType = "manifestContainerImage"
+	// DependencyUseTypeTerraformModule is a Terraform or OpenTofu module source.
+	DependencyUseTypeTerraformModule DependencyUseType = "terraformModule"
+	// DependencyUseTypeTerraformProvider is a Terraform or OpenTofu provider.
+	DependencyUseTypeTerraformProvider DependencyUseType = "terraformProvider"
+	// DependencyUseTypeNpmLockfile is the lockfile of an npm, yarn, pnpm or bun project.
+	DependencyUseTypeNpmLockfile DependencyUseType = "npmLockfile"
+	// DependencyUseTypePythonLockfile is the lockfile of a Poetry, uv, PDM or Pipenv project.
+	DependencyUseTypePythonLockfile DependencyUseType = "pythonLockfile"
+	// DependencyUseTypeCargoLockfile is the Cargo.lock of a Rust binary crate.
+	DependencyUseTypeCargoLockfile DependencyUseType = "cargoLockfile"
+	// DependencyUseTypeGemLockfile is the lockfile of a Bundler project.
+	DependencyUseTypeGemLockfile DependencyUseType = "gemLockfile"
+	// DependencyUseTypePipRequirement is an entry of a pip requirements file.
+	DependencyUseTypePipRequirement DependencyUseType = "pipRequirement"
 )
 
 // PinningDependenciesData represents pinned dependency data.
@@ -120,10 +262,33 @@ type Dependency struct {
 	Name     *string
 	PinnedAt *string
 	Location *File
-	Msg      *string // Only for debug messages.
-	Pinned   *bool
-	Type     DependencyUseType
-}
+	// Caller is the location of the `uses` calling the composite action
+	// or reusable workflow where the dependency was found.
+	Caller *File
+	Msg    *string // Only for debug messages.
+	Pinned *bool
+	// VersionComment is the version in the comment following
+	// a dependency pinned by hash, e.g., `# v3.1.0`.
+	VersionComment *string
+	// PinnedCommitIssue is set when the commit a GitHub Action
+	// is pinned at fails verification against the action repository.
+	PinnedCommitIssue *PinnedCommitIssue
+	Type              DependencyUseType
+}
+
+// PinnedCommitIssue is the reason a pinned commit failed verification.
+type PinnedCommitIssue string
+
+const (
+	// PinnedCommitImpostor is a commit not reachable from any branch or tag
+	// of the action repository, e.g., a commit from a fork.
+	PinnedCommitImpostor PinnedCommitIssue = "impostorCommit"
+	// PinnedCommitMislabeled is a commit that does not match the version in its comment.
+	PinnedCommitMislabeled PinnedCommitIssue = "mislabeledCommit"
+	// PinnedCommitUnverified is a commit that could not be verified,
+	// e.g., because the API of the action repository returned an error.
+	PinnedCommitUnverified PinnedCommitIssue = "unverifiedCommit"
+)
 
 // MaintainedData contains the raw results
 // for the Maintained check.
@@ -132,6 +297,41 @@ type MaintainedData struct {
 	Issues               []clients.Issue
 	DefaultBranchCommits []clients.Commit
 	ArchivedStatus       ArchivedStatus
+	// TrackedItems contains the recently updated issues and pull
+	// requests with the activity of the maintainers on them.
+	TrackedItems []TrackedItem
+}
+
+// TrackedItemType is the type of a TrackedItem.
+type TrackedItemType string
+
+const (
+	TrackedItemIssue       TrackedItemType = "issue"
+	TrackedItemPullRequest TrackedItemType = "pullRequest"
+)
+
+// TrackedItem is an issue or a pull request.
+// Maintainers are the users associated with the repository as collaborators
+// or higher, and the contributors who are members of the owning organization.
+//
+//nolint:govet
+type TrackedItem struct {
+	Type TrackedItemType
+	// URI is set for the issues, Number for the pull requests.
+	URI    string
+	Number int
+	Author string
+	// External is true if the author is not a maintainer.
+	External  bool
+	CreatedAt time.Time
+	// FirstMaintainerResponse is the time of the first comment or review
+	// of a maintainer other than the author.
+	FirstMaintainerResponse *time.Time
+	// LastMaintainerActivity is the time of the last comment or review of a
+	// maintainer, or the creation time if the author is a maintainer.
+	LastMaintainerActivity *time.Time
+	ClosedAt               *time.Time
+	MergedAt               *time.Time
 }
 
 type LicenseAttributionType string
@@ -157,11 +357,49 @@ type LicenseFile struct {
 	File               File
 }
 
+// LicenseDeclarationSource is the source of a file-level license declaration.
+type LicenseDeclarationSource string
+
+const (
+	// LicenseDeclarationSourceHeader is an SPDX-License-Identifier header in the file,
+	// or in its `.license` companion file.
+	LicenseDeclarationSourceHeader LicenseDeclarationSource = "spdxHeader"
+	// LicenseDeclarationSourceREUSEDep5 is a paragraph of a `.reuse/dep5` file.
+	LicenseDeclarationSourceREUSEDep5 LicenseDeclarationSource = "reuseDep5"
+	// LicenseDeclarationSourceREUSEToml is an annotation of a `REUSE.toml` file.
+	LicenseDeclarationSourceREUSEToml LicenseDeclarationSource = "reuseToml"
+)
+
+// LicenseDeclaration is an SPDX license expression declared for one
+// or more files of the repository.
+type LicenseDeclaration struct {
+	// File is the file with the declaration: the source file for a header,
+	// the REUSE file for an annotation.
+	File       File
+	Source     LicenseDeclarationSource
+	Expression string
+	// Licenses are the license identifiers of the expression.
+	Licenses []string
+	// Error is set if the expression is not a valid SPDX license expression.
+	Error string
+	// ConflictsWithLicenseFile is true if the expression cannot be
+	// satisfied by the licenses of the project.
+	ConflictsWithLicenseFile bool
+}
+
 // LicenseData contains the raw results
 // for the License check.
 // Some repos may have more than one license.
 type LicenseData struct {
 	LicenseFiles []LicenseFile
+	// ProjectLicenses are the SPDX identifiers of the license files,
+	// and of the files in the top-level LICENSES directory.
+	ProjectLicenses []string
+	Declarations    []LicenseDeclaration
+	// SourceFiles is the number of source files in the repository.
+	SourceFiles int
+	// DeclaredSourceFiles is the number of source files with a license declaration.
+	DeclaredSourceFiles int
 }
 
 // CodeReviewData contains the raw results
@@ -188,9 +426,92 @@ type Changeset struct {
 	Author         clients.User
 }
 
+// CodeownersData contains the raw results
+// for the Code-Owners check.
+type CodeownersData struct {
+	// File is the CODEOWNERS file used by the platform, nil if there is none.
+	File           *File
+	Owners         []CodeOwner
+	SensitivePaths []CodeownersPath
+	NumFiles       int
+	NumOwnedFiles  int
+}
+
+// CodeOwner represents an owner listed in a CODEOWNERS file.
+type CodeOwner struct {
+	// Name is the owner as listed in the file, e.g. `@user`, `@org/team` or an email.
+	Name   string
+	Access clients.CodeOwnerAccess
+	// File is the location of the first rule listing the owner.
+	File File
+}
+
+// CodeownersPathType is the type of a sensitive path.
+type CodeownersPathType string
+
+const (
+	// CodeownersPathWorkflow represents a CI workflow or a GitHub action of the repository.
+	CodeownersPathWorkflow CodeownersPathType = "workflow"
+	// CodeownersPathBuild represents a build script or configuration.
+	CodeownersPathBuild CodeownersPathType = "build"
+	// CodeownersPathRelease represents a release configuration.
+	CodeownersPathRelease CodeownersPathType = "release"
+	// CodeownersPathCodeowners represents the CODEOWNERS file itself.
+	CodeownersPathCodeowners CodeownersPathType = "codeowners"
+)
+
+// CodeownersPath represents a sensitive file of the repository and its owners.
+type CodeownersPath struct {
+	Path string
+	Type CodeownersPathType
+	// Owners are the owners of the file which exist and can write to the repository,
+	// or whose access could not be determined.
+	Owners []string
+}
+
 // ContributorsData represents contributor information.
 type ContributorsData struct {
 	Users []clients.User
+	// Commits are the recent commits of the default branch, except the
+	// commits of bots.
+	Commits []ContributorCommit
+	// Windows contains the concentration metrics of the commits over the
+	// ContributionWindowDays, from the shortest to the longest.
+	Windows []ContributionWindow
+}
+
+// ContributionWindowDays are the time windows of the contribution metrics.
+var ContributionWindowDays = []int{30, 90, 365}
+
+// ContributorCommit is a commit with the affiliation of its author.
+type ContributorCommit struct {
+	CommittedDate time.Time
+	SHA           string
+	// Author is the login of the author, or its email if the forge
+	// did not link it to an account.
+	Author string
+	// Affiliation is the organization inferred from the domain of the verified
+	// email of the author, e.g., `redhat.com`, or the organization the domain
+	// is mapped to. It is empty if the affiliation is unknown.
+	Affiliation string
+}
+
+// ContributionWindow contains the concentration metrics of the commits
+// in the last Days days.
+type ContributionWindow struct {
+	Days    int
+	Commits int
+	Authors int
+	// BusFactor is the smallest number of authors of at least half of the commits.
+	BusFactor int
+	// TopAuthorShare is the percentage of the commits of the most active author.
+	TopAuthorShare int
+	// Affiliations is the number of affiliations of the commits with a known affiliation.
+	Affiliations int
+	// TopAffiliation is the most represented affiliation, and TopAffiliationShare
+	// the percentage of the commits with a known affiliation it represents.
+	TopAffiliation      string
+	TopAffiliationShare int
 }
 
 // VulnerabilitiesData contains the raw results
@@ -206,6 +527,22 @@ const (
 	SecurityPolicyInformationTypeEmail SecurityPolicyInformationType = "emailAddress"
 	SecurityPolicyInformationTypeLink  SecurityPolicyInformationType = "httpLink"
 	SecurityPolicyInformationTypeText  SecurityPolicyInformationType = "vulnDisclosureText"
+	// SecurityPolicyInformationTypeSupportedVersions is a section
+	// on the versions receiving security fixes.
+	SecurityPolicyInformationTypeSupportedVersions SecurityPolicyInformationType = "supportedVersions"
+	// SecurityPolicyInformationTypePrivateReporting is a private channel to
+	// report vulnerabilities: a security address, a form or GitHub private
+	// vulnerability reporting.
+	SecurityPolicyInformationTypePrivateReporting SecurityPolicyInformationType = "privateReporting"
+	// SecurityPolicyInformationTypeResponseTime is a commitment
+	// to respond to reports within a given time.
+	SecurityPolicyInformationTypeResponseTime SecurityPolicyInformationType = "responseTime"
+	// SecurityPolicyInformationTypeEmbargo is a term of the embargo
+	// or coordinated disclosure of vulnerabilities.
+	SecurityPolicyInformationTypeEmbargo SecurityPolicyInformationType = "embargo"
+	// SecurityPolicyInformationTypeEncryptionKey is a PGP key,
+	// or a link to one, to encrypt reports.
+	SecurityPolicyInformationTypeEncryptionKey SecurityPolicyInformationType = "encryptionKey"
 )
 
 type SecurityPolicyValueType struct {
@@ -226,10 +563,27 @@ type SecurityPolicyFile struct {
 	File File
 }
 
+// SecurityTxt is a security.txt file (RFC 9116).
+type SecurityTxt struct {
+	// Expires is nil if the file has no valid Expires field.
+	Expires    *time.Time
+	File       File
+	Contacts   []string
+	Encryption []string
+	Policies   []string
+	// Errors are the violations of RFC 9116 found in the file,
+	// e.g., a missing Contact field.
+	Errors []string
+}
+
 // SecurityPolicyData contains the raw results
 // for the Security-Policy check.
 type SecurityPolicyData struct {
-	PolicyFiles []SecurityPolicyFile
+	// PrivateVulnerabilityReporting is nil if the setting
+	// could not be retrieved.
+	PrivateVulnerabilityReporting *bool
+	PolicyFiles                   []SecurityPolicyFile
+	SecurityTxtFiles              []SecurityTxt
 }
 
 // BinaryArtifactData contains the raw results
@@ -239,6 +593,16 @@ type BinaryArtifactData struct {
 	Files []File
 }
 
+// SignedCommitsData contains the raw results
+// for the Signed-Commits check.
+type SignedCommitsData struct {
+	// Commits are the recent commits of the default branch.
+	Commits []clients.Commit
+	// Tags are the tags of the releases, or all the tags if
+	// releases are not supported by the repository host.
+	Tags []clients.Tag
+}
+
 // SignedReleasesData contains the raw results
 // for the Signed-Releases check.
 type SignedReleasesData struct {
@@ -252,6 +616,174 @@ type DependencyUpdateToolData struct {
 	Tools []Tool
 }
 
+// RegistryConfigIssueType is the type of a risky package registry configuration.
+type RegistryConfigIssueType string
+
+const (
+	// RegistryConfigUnscopedSources means packages are resolved from both public and private
+	// sources, without scoping or source mapping, which allows dependency confusion.
+	RegistryConfigUnscopedSources RegistryConfigIssueType = "unscopedSources"
+	// RegistryConfigInsecureURL means a registry is reached over plaintext HTTP, or without TLS verification.
+	RegistryConfigInsecureURL RegistryConfigIssueType = "insecureURL"
+	// RegistryConfigEmbeddedCredentials means credentials are stored in the configuration file.
+	RegistryConfigEmbeddedCredentials RegistryConfigIssueType = "embeddedCredentials"
+)
+
+// RegistryConfigIssue represents a risky package registry configuration.
+// The credentials are never stored: the snippet of File is redacted.
+type RegistryConfigIssue struct {
+	Type RegistryConfigIssueType
+	// Ecosystem is the package ecosystem, e.g., `npm` or `pypi`.
+	Ecosystem string
+	// Registry is the URL or the name of the registry, with the credentials redacted.
+	Registry string
+	File     File
+}
+
+// DependencyConfusionData contains the raw results
+// for the Dependency-Confusion check.
+type DependencyConfusionData struct {
+	Issues []RegistryConfigIssue
+	// NumConfigFiles is the number of files configuring package registries.
+	NumConfigFiles int
+}
+
+// DockerfileIssueType is the type of a Dockerfile hardening issue.
+type DockerfileIssueType string
+
+const (
+	// DockerfileRunsAsRoot means the final stage does not switch to a non-root user.
+	DockerfileRunsAsRoot DockerfileIssueType = "runsAsRoot"
+	// DockerfileRemoteAdd means an ADD instruction fetches a remote URL without a checksum.
+	DockerfileRemoteAdd DockerfileIssueType = "remoteAdd"
+	// DockerfileSecretInArgOrEnv means a secret is passed with ARG or ENV,
+	// and stored in the image metadata or history.
+	DockerfileSecretInArgOrEnv DockerfileIssueType = "secretInArgOrEnv"
+	// DockerfileFetchPipeExecute means a RUN instruction executes a downloaded script, e.g., `curl | sh`.
+	DockerfileFetchPipeExecute DockerfileIssueType = "fetchPipeExecute"
+	// DockerfileMissingHealthcheck means the final stage exposes a port without a HEALTHCHECK.
+	DockerfileMissingHealthcheck DockerfileIssueType = "missingHealthcheck"
+	// DockerfileLatestBaseImage means a stage is based on an image without tag or with the `latest` tag.
+	DockerfileLatestBaseImage DockerfileIssueType = "latestBaseImage"
+)
+
+// DockerfileIssue represents a hardening issue found in a Dockerfile.
+type DockerfileIssue struct {
+	Type DockerfileIssueType
+	// Stage is the name of the stage, or its base image for unnamed stages.
+	Stage string
+	File  File
+}
+
+// DockerfileHardeningData contains the raw results
+// for the Dockerfile-Hardening check.
+type DockerfileHardeningData struct {
+	Issues         []DockerfileIssue
+	NumDockerfiles int
+}
+
+// SASTTool is the name of a static analysis tool.
+type SASTTool string
+
+const (
+	// SASTToolCodeQL is CodeQL.
+	SASTToolCodeQL SASTTool = "CodeQL"
+	// SASTToolCodeScanning is a tool uploading its results to GitHub code scanning.
+	SASTToolCodeScanning SASTTool = "GitHub code scanning"
+	// SASTToolLGTM is LGTM.
+	SASTToolLGTM SASTTool = "LGTM"
+	// SASTToolSonar is SonarQube or SonarCloud.
+	SASTToolSonar SASTTool = "Sonar"
+	// SASTToolSemgrep is Semgrep.
+	SASTToolSemgrep SASTTool = "Semgrep"
+	// SASTToolSnykCode is Snyk Code.
+	SASTToolSnykCode SASTTool = "Snyk Code"
+	// SASTToolGosec is gosec.
+	SASTToolGosec SASTTool = "gosec"
+	// SASTToolGolangciLint is golangci-lint.
+	SASTToolGolangciLint SASTTool = "golangci-lint"
+	// SASTToolBandit is Bandit.
+	SASTToolBandit SASTTool = "Bandit"
+	// SASTToolBrakeman is Brakeman.
+	SASTToolBrakeman SASTTool = "Brakeman"
+	// SASTToolESLintSecurity is ESLint with a security plugin.
+	SASTToolESLintSecurity SASTTool = "ESLint security plugin"
+	// SASTToolGitLabSAST is GitLab SAST.
+	SASTToolGitLabSAST SASTTool = "GitLab SAST"
+)
+
+// SASTConfig is a workflow, CI job or configuration file setting up a SAST tool.
+type SASTConfig struct {
+	Tool SASTTool
+	File File
+}
+
+// SASTPullRequest is a merged pull request and the SAST tools
+// which analyzed its head commit successfully.
+type SASTPullRequest struct {
+	HeadSHA string
+	Tools   []SASTTool
+	Number  int
+}
+
+// SASTData contains the raw results
+// for the SAST check.
+type SASTData struct {
+	Configs      []SASTConfig
+	PullRequests []SASTPullRequest
+}
+
+// SecuritySettingsData contains the raw results
+// for the Security-Settings check.
+type SecuritySettingsData struct {
+	Settings            clients.SecuritySettings
+	WorkflowPermissions clients.WorkflowPermissions
+}
+
+// SecretType is the type of a hard-coded secret.
+type SecretType string
+
+const (
+	// SecretAWSAccessKey is an AWS access key ID.
+	SecretAWSAccessKey SecretType = "awsAccessKey"
+	// SecretGoogleAPIKey is a Google Cloud API key.
+	SecretGoogleAPIKey SecretType = "googleAPIKey"
+	// SecretPrivateKey is a PEM-encoded private key.
+	SecretPrivateKey SecretType = "privateKey"
+	// SecretGitHubToken is a GitHub access token.
+	SecretGitHubToken SecretType = "githubToken"
+	// SecretGitLabToken is a GitLab access token.
+	SecretGitLabToken SecretType = "gitlabToken"
+	// SecretSlackToken is a Slack token.
+	SecretSlackToken SecretType = "slackToken"
+	// SecretStripeKey is a Stripe live secret key.
+	SecretStripeKey SecretType = "stripeKey"
+	// SecretNpmToken is an npm access token.
+	SecretNpmToken SecretType = "npmToken"
+	// SecretPyPIToken is a PyPI API token.
+	SecretPyPIToken SecretType = "pypiToken"
+	// SecretJWT is a JSON Web Token.
+	SecretJWT SecretType = "jwt"
+	// SecretHighEntropy is a high-entropy string assigned to a secret-like name.
+	SecretHighEntropy SecretType = "highEntropyString"
+)
+
+// Secret represents a hard-coded secret found in a file.
+// The value of the secret is never stored: the snippet of
+// File is the line containing the secret, with the secret redacted.
+type Secret struct {
+	Type SecretType
+	File File
+	// HighConfidence is false for secrets found by heuristics only.
+	HighConfidence bool
+}
+
+// SecretsData contains the raw results
+// for the Secrets check.
+type SecretsData struct {
+	Secrets []Secret
+}
+
 // WebhooksData contains the raw results
 // for the Webhook check.
 type WebhooksData struct {
@@ -327,9 +859,12 @@ type DangerousWorkflowData struct {
 
 // DangerousWorkflow represents a dangerous workflow.
 type DangerousWorkflow struct {
-	Job  *WorkflowJob
-	Type DangerousWorkflowType
-	File File
+	Job *WorkflowJob
+	// Caller is the location of the `uses` calling the composite action
+	// or reusable workflow where the pattern was found.
+	Caller *File
+	Type   DangerousWorkflowType
+	File   File
 }
 
 // WorkflowJob represents a workflow job.
@@ -338,6 +873,44 @@ type WorkflowJob struct {
 	ID   *string
 }
 
+// WorkflowSecretExposureType is a type of risky flow of the secrets of a workflow.
+type WorkflowSecretExposureType string
+
+const (
+	// WorkflowSecretInherited represents a reusable workflow receiving all the secrets
+	// with `secrets: inherit`.
+	WorkflowSecretInherited WorkflowSecretExposureType = "inheritedSecrets"
+	// WorkflowSecretUnpinnedReceiver represents a secret passed to an action or
+	// a reusable workflow not pinned by commit hash.
+	WorkflowSecretUnpinnedReceiver WorkflowSecretExposureType = "unpinnedReceiver"
+	// WorkflowSecretLogged represents a secret printed to the logs by a script.
+	WorkflowSecretLogged WorkflowSecretExposureType = "loggedSecret"
+	// WorkflowSecretForkTrigger represents a secret used by a job which can be
+	// triggered by pull requests from forks.
+	WorkflowSecretForkTrigger WorkflowSecretExposureType = "forkTrigger"
+)
+
+// WorkflowSecretsData contains the raw results
+// for the Workflow-Secrets check.
+type WorkflowSecretsData struct {
+	Exposures    []WorkflowSecretExposure
+	NumWorkflows int
+}
+
+// WorkflowSecretExposure represents a risky flow of a secret in a workflow job.
+type WorkflowSecretExposure struct {
+	Job *WorkflowJob
+	// Secret is the name of the secret, empty when all the secrets are exposed.
+	Secret string
+	// Receiver is the action or reusable workflow receiving the secret,
+	// empty for scripts.
+	Receiver string
+	Type     WorkflowSecretExposureType
+	File     File
+	// ThirdParty is true if the receiver is not owned by the owner of the repository.
+	ThirdParty bool
+}
+
 // TokenPermissionsData represents data about a permission failure.
 type TokenPermissionsData struct {
 	TokenPermissions []TokenPermission
@@ -35,6 +35,7 @@ func TestContributors(t *testing.T) {
 		err      error
 		name     string
 		contrib  []clients.User
+		commits  []clients.Commit
 		expected checker.CheckResult
 	}{
 		{
@@ -137,6 +138,29 @@ func TestContributors(t *testing.T) {
 				Score: 10,
 			},
 		},
+		{
+			err:  nil,
+			name: "Companies inferred from verified commit emails",
+			contrib: []clients.User{
+				{
+					Login:            "user1",
+					NumContributions: 10,
+				},
+				{
+					Login:            "user2",
+					NumContributions: 10,
+				},
+			},
+			commits: []clients.Commit{
+				{Author: clients.CommitAuthor{Login: "user1", Email: "user1@company1.com"}},
+				{Author: clients.CommitAuthor{Login: "user2", Email: "user2@gmail.com"}},
+				// Unverified emails are ignored.
+				{Author: clients.CommitAuthor{Email: "user2@company2.com"}},
+			},
+			expected: checker.CheckResult{
+				Score: 3,
+			},
+		},
 		{
 			err:     nil,
 			name:    "No contributors",
@@ -167,6 +191,7 @@ func TestContributors(t *testing.T) {
 				}
 				return tt.contrib, nil
 			})
+			mockRepo.EXPECT().ListCommits().Return(tt.commits, nil).AnyTimes()
 
 			req := checker.CheckRequest{
 				RepoClient: mockRepo,
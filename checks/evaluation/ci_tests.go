@@ -16,7 +16,6 @@ package evaluation
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/ossf/scorecard/v4/checker"
 	"github.com/ossf/scorecard/v4/finding"
@@ -25,47 +24,49 @@ import (
 const (
 	// CheckCITests is the registered name for CITests.
 	CheckCITests = "CI-Tests"
-	success      = "success"
 )
 
 func CITests(_ string, c *checker.CITestData, dl checker.DetailLogger) checker.CheckResult {
+	// The CI systems configured in the repository, and whether they run tests.
+	configured := make(map[checker.CISystem]bool)
+	runsTests := make(map[checker.CISystem]bool)
+	for i := range c.Configs {
+		config := &c.Configs[i]
+		configured[config.System] = true
+		if config.RunsTests {
+			runsTests[config.System] = true
+			continue
+		}
+		dl.Debug(&checker.LogMessage{
+			Path:   config.File.Path,
+			Type:   finding.FileTypeSource,
+			Offset: config.File.Offset,
+			Text:   fmt.Sprintf("%s configuration without tests", config.System),
+		})
+	}
+
 	totalMerged := 0
 	totalTested := 0
 	for i := range c.CIInfo {
-		r := c.CIInfo[i]
+		r := &c.CIInfo[i]
 		totalMerged++
 
-		var foundCI bool
-
-		// GitHub Statuses.
-		prSuccessStatus, err := prHasSuccessStatus(r, dl)
-		if err != nil {
-			return checker.CreateRuntimeErrorResult(CheckCITests, err)
-		}
-		if prSuccessStatus {
-			totalTested++
-			foundCI = true
-			continue
-		}
-
-		// GitHub Check Runs.
-		prCheckSuccessful, err := prHasSuccessfulCheck(r, dl)
-		if err != nil {
-			return checker.CreateRuntimeErrorResult(CheckCITests, err)
-		}
-		if prCheckSuccessful {
-			totalTested++
-			foundCI = true
-		}
-
-		if !foundCI {
+		job := prTestJob(r, configured, runsTests)
+		if job == nil {
 			// Log message says commit, but really we only care about PRs, and
 			// use only one commit (branch HEAD) to refer to all commits in a PR
-
 			dl.Debug(&checker.LogMessage{
 				Text: fmt.Sprintf("merged PR %d without CI test at HEAD: %s", r.PullRequestNumber, r.HeadSHA),
 			})
+			continue
 		}
+		totalTested++
+		dl.Debug(&checker.LogMessage{
+			Path: job.URL,
+			Type: finding.FileTypeURL,
+			Text: fmt.Sprintf("CI test found: pr: %d, system: %s, job: %s", r.PullRequestNumber,
+				job.System, job.Name),
+		})
 	}
 
 	if totalMerged == 0 {
@@ -76,64 +77,31 @@ func CITests(_ string, c *checker.CITestData, dl checker.DetailLogger) checker.C
 	return checker.CreateProportionalScoreResult(CheckCITests, reason, totalTested, totalMerged)
 }
 
-// PR has a status marked 'success' and a CI-related context.
-//
-//nolint:unparam
-func prHasSuccessStatus(r checker.RevisionCIInfo, dl checker.DetailLogger) (bool, error) {
-	for _, status := range r.Statuses {
-		if status.State != success {
+// prTestJob returns a successful CI job testing the PR, nil if there is none.
+// The lint jobs do not test the PR. The jobs whose name does not tell what they
+// do test it, unless the configuration of their CI system is in the repository
+// and does not run tests. The build jobs only test it if the configuration of
+// their CI system is in the repository and runs tests, e.g. a `build` job
+// running `go test`.
+func prTestJob(r *checker.RevisionCIInfo, configured, runsTests map[checker.CISystem]bool) *checker.CIJob {
+	for i := range r.Jobs {
+		job := &r.Jobs[i]
+		if !job.Success {
 			continue
 		}
-		if isTest(status.Context) || isTest(status.TargetURL) {
-			dl.Debug(&checker.LogMessage{
-				Path: status.URL,
-				Type: finding.FileTypeURL,
-				Text: fmt.Sprintf("CI test found: pr: %s, context: %s", r.HeadSHA,
-					status.Context),
-			})
-			return true, nil
-		}
-	}
-	return false, nil
-}
-
-// PR has a successful CI-related check.
-//
-//nolint:unparam
-func prHasSuccessfulCheck(r checker.RevisionCIInfo, dl checker.DetailLogger) (bool, error) {
-	for _, cr := range r.CheckRuns {
-		if cr.Status != "completed" {
-			continue
-		}
-		if cr.Conclusion != success {
-			continue
-		}
-		if isTest(cr.App.Slug) {
-			dl.Debug(&checker.LogMessage{
-				Path: cr.URL,
-				Type: finding.FileTypeURL,
-				Text: fmt.Sprintf("CI test found: pr: %d, context: %s", r.PullRequestNumber,
-					cr.App.Slug),
-			})
-			return true, nil
-		}
-	}
-	return false, nil
-}
-
-// isTest returns true if the given string is a CI test.
-func isTest(s string) bool {
-	l := strings.ToLower(s)
-
-	// Add more patterns here!
-	for _, pattern := range []string{
-		"appveyor", "buildkite", "circleci", "e2e", "github-actions", "jenkins",
-		"mergeable", "packit-as-a-service", "semaphoreci", "test", "travis-ci",
-		"flutter-dashboard", "Cirrus CI", "azure-pipelines",
-	} {
-		if strings.Contains(l, pattern) {
-			return true
+		switch job.Kind {
+		case checker.CIJobTest:
+			return job
+		case checker.CIJobUnknown:
+			if !configured[job.System] || runsTests[job.System] {
+				return job
+			}
+		case checker.CIJobBuild:
+			if runsTests[job.System] {
+				return job
+			}
+		case checker.CIJobLint:
 		}
 	}
-	return false
+	return nil
 }
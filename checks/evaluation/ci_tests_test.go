@@ -17,432 +17,161 @@ import (
 	"testing"
 
 	"github.com/ossf/scorecard/v4/checker"
-	"github.com/ossf/scorecard/v4/clients"
 	scut "github.com/ossf/scorecard/v4/utests"
 )
 
-func Test_isTest(t *testing.T) {
-	t.Parallel()
-	type args struct {
-		s string
-	}
-	tests := []struct {
-		name string
-		args args
-		want bool
-	}{
-		{
-			name: "appveyor",
-			args: args{
-				s: "appveyor",
-			},
-			want: true,
-		},
-		{
-			name: "circleci",
-			args: args{
-				s: "circleci",
-			},
-			want: true,
-		},
-		{
-			name: "jenkins",
-			args: args{
-				s: "jenkins",
-			},
-			want: true,
-		},
-		{
-			name: "e2e",
-			args: args{
-				s: "e2e",
-			},
-			want: true,
-		},
-		{
-			name: "github-actions",
-			args: args{
-				s: "github-actions",
-			},
-			want: true,
-		},
-		{
-			name: "mergeable",
-			args: args{
-				s: "mergeable",
-			},
-			want: true,
-		},
-		{
-			name: "packit-as-a-service",
-			args: args{
-				s: "packit-as-a-service",
-			},
-			want: true,
-		},
-		{
-			name: "semaphoreci",
-			args: args{
-				s: "semaphoreci",
-			},
-			want: true,
-		},
-		{
-			name: "test",
-			args: args{
-				s: "test",
-			},
-			want: true,
-		},
-		{
-			name: "travis-ci",
-			args: args{
-				s: "travis-ci",
-			},
-			want: true,
-		},
-		{
-			name: "azure-pipelines",
-			args: args{
-				s: "azure-pipelines",
-			},
-			want: true,
-		},
-		{
-			name: "non-existing",
-			args: args{
-				s: "non-existing",
-			},
-			want: false,
-		},
-	}
-	for _, tt := range tests {
-		tt := tt
-		t.Run(tt.name, func(t *testing.T) {
-			t.Parallel()
-			if got := isTest(tt.args.s); got != tt.want {
-				t.Errorf("isTest() = %v, want %v for test %v", got, tt.want, tt.name)
-			}
-		})
-	}
-}
-
-func Test_prHasSuccessfulCheck(t *testing.T) {
-	t.Parallel()
-
-	//enabled nolint because this is a test
-	//nolint
-	tests := []struct {
-		name    string
-		args    checker.RevisionCIInfo
-		want    bool
-		wantErr bool
-	}{
-		{
-			name: "check run with conclusion success",
-			args: checker.RevisionCIInfo{
-				PullRequestNumber: 1,
-				HeadSHA:           "sha",
-				CheckRuns: []clients.CheckRun{
-					{
-						App:        clients.CheckRunApp{Slug: "test"},
-						Conclusion: "success",
-						URL:        "url",
-						Status:     "completed",
-					},
-				},
-			},
-			want:    true,
-			wantErr: false,
-		},
-		{
-			name: "check run with conclusion not success",
-			args: checker.RevisionCIInfo{
-				PullRequestNumber: 1,
-				HeadSHA:           "sha",
-				CheckRuns: []clients.CheckRun{
-					{
-						App:        clients.CheckRunApp{Slug: "test"},
-						Conclusion: "failed",
-						URL:        "url",
-						Status:     "completed",
-					},
-				},
-			},
-			want:    false,
-			wantErr: false,
-		},
-		{
-			name: "check run with conclusion not success",
-			args: checker.RevisionCIInfo{
-				PullRequestNumber: 1,
-				HeadSHA:           "sha",
-				CheckRuns: []clients.CheckRun{
-					{
-						App:        clients.CheckRunApp{Slug: "test"},
-						Conclusion: "success",
-						URL:        "url",
-						Status:     "notcompleted",
-					},
-				},
-			},
-			want:    false,
-			wantErr: false,
-		},
-	}
-	for _, tt := range tests {
-		tt := tt
-		dl := &scut.TestDetailLogger{}
-
-		//nolint:errcheck
-		got, _ := prHasSuccessfulCheck(tt.args, dl)
-		if got != tt.want {
-			t.Errorf("prHasSuccessfulCheck() = %v, want %v", got, tt.want)
-		}
-	}
-}
-
-func Test_prHasSuccessStatus(t *testing.T) {
+func TestCITests(t *testing.T) {
 	t.Parallel()
 	type args struct { //nolint:govet
-		r  checker.RevisionCIInfo
-		dl checker.DetailLogger
+		in0 string
+		c   *checker.CITestData
+		dl  checker.DetailLogger
 	}
 	tests := []struct { //nolint:govet
-		name    string
-		args    args
-		want    bool
-		wantErr bool
+		name string
+		args args
+		want int
 	}{
 		{
-			name: "empty revision",
-			args: args{
-				r: checker.RevisionCIInfo{},
-			},
-			want:    false,
-			wantErr: false,
-		},
-		{
-			name: "no statuses",
-			args: args{
-				r: checker.RevisionCIInfo{
-					Statuses: []clients.Status{},
-				},
-			},
-		},
-		{
-			name: "status is not success",
+			name: "Status completed with failure",
 			args: args{
-				r: checker.RevisionCIInfo{
-					Statuses: []clients.Status{
+				in0: "",
+				c: &checker.CITestData{
+					CIInfo: []checker.RevisionCIInfo{
 						{
-							State: "failure",
+							Jobs: []checker.CIJob{
+								{System: checker.CIOther, Name: "e2e", Kind: checker.CIJobTest},
+								{System: checker.CIOther, Name: CheckCITests, Kind: checker.CIJobTest},
+							},
 						},
 					},
 				},
+				dl: &scut.TestDetailLogger{},
 			},
+			want: 0,
 		},
 		{
-			name: "status is success",
+			name: "valid",
 			args: args{
-				r: checker.RevisionCIInfo{
-					Statuses: []clients.Status{
+				in0: "",
+				c: &checker.CITestData{
+					CIInfo: []checker.RevisionCIInfo{
 						{
-							State:   "success",
-							Context: CheckCITests,
+							Jobs: []checker.CIJob{
+								{System: checker.CIOther, Name: "e2e", Kind: checker.CIJobTest, Success: true},
+								{System: checker.CIOther, Name: CheckCITests, Kind: checker.CIJobTest, Success: true},
+							},
 						},
 					},
 				},
 				dl: &scut.TestDetailLogger{},
 			},
-			want:    true,
-			wantErr: false,
+			want: 10,
 		},
-	}
-	for _, tt := range tests {
-		tt := tt
-		t.Run(tt.name, func(t *testing.T) {
-			t.Parallel()
-			got, err := prHasSuccessStatus(tt.args.r, tt.args.dl) //nolint:govet
-			if (err != nil) != tt.wantErr {                       //nolint:govet
-				t.Errorf("prHasSuccessStatus() error = %v, wantErr %v", err, tt.wantErr) //nolint:govet
-				return
-			}
-			if got != tt.want { //nolint:govet
-				t.Errorf("prHasSuccessStatus() got = %v, want %v", got, tt.want) //nolint:govet
-			}
-		})
-	}
-}
-
-func Test_prHasSuccessfulCheckAdditional(t *testing.T) {
-	t.Parallel()
-	type args struct { //nolint:govet
-		r  checker.RevisionCIInfo
-		dl checker.DetailLogger
-	}
-	tests := []struct { //nolint:govet
-		name    string
-		args    args
-		want    bool
-		wantErr bool
-	}{
 		{
-			name: "empty revision",
+			name: "no ci info",
 			args: args{
-				r: checker.RevisionCIInfo{},
+				in0: "",
+				c:   &checker.CITestData{},
+				dl:  &scut.TestDetailLogger{},
 			},
-			want:    false,
-			wantErr: false,
+			want: -1,
 		},
 		{
-			name: "status is not completed",
+			name: "lint and build jobs only",
 			args: args{
-				r: checker.RevisionCIInfo{
-					CheckRuns: []clients.CheckRun{
+				c: &checker.CITestData{
+					CIInfo: []checker.RevisionCIInfo{
 						{
-							Status: "notcompleted",
+							Jobs: []checker.CIJob{
+								{System: checker.CICircleCI, Name: "ci/circleci: lint", Kind: checker.CIJobLint, Success: true},
+								{System: checker.CIGitLab, Name: "docker-build", Kind: checker.CIJobBuild, Success: true},
+							},
 						},
 					},
 				},
+				dl: &scut.TestDetailLogger{},
 			},
+			want: 0,
 		},
 		{
-			name: "status is not success",
+			name: "generic job of a CI system without configuration",
 			args: args{
-				r: checker.RevisionCIInfo{
-					CheckRuns: []clients.CheckRun{
+				c: &checker.CITestData{
+					CIInfo: []checker.RevisionCIInfo{
 						{
-							Status:     "completed",
-							Conclusion: "failure",
+							Jobs: []checker.CIJob{
+								{System: checker.CIGitHubActions, Kind: checker.CIJobUnknown, Success: true},
+							},
 						},
 					},
 				},
+				dl: &scut.TestDetailLogger{},
 			},
+			want: 10,
 		},
 		{
-			name: "conclusion is success",
+			name: "generic job of a CI system whose configuration runs tests",
 			args: args{
-				r: checker.RevisionCIInfo{
-					CheckRuns: []clients.CheckRun{
+				c: &checker.CITestData{
+					CIInfo: []checker.RevisionCIInfo{
 						{
-							Status:     "completed",
-							Conclusion: "success",
+							Jobs: []checker.CIJob{
+								{System: checker.CIJenkins, Name: "continuous-integration/jenkins/pr-merge", Kind: checker.CIJobUnknown, Success: true},
+							},
 						},
-					},
-				},
-			},
-		},
-		{
-			name: "conclusion is succesls with a valid app slug",
-			args: args{
-				r: checker.RevisionCIInfo{
-					CheckRuns: []clients.CheckRun{
 						{
-							Status:     "completed",
-							Conclusion: "success",
-							App:        clients.CheckRunApp{Slug: "e2e"},
+							Jobs: []checker.CIJob{
+								{System: checker.CIJenkins, Name: "continuous-integration/jenkins/pr-merge", Kind: checker.CIJobUnknown},
+							},
 						},
 					},
+					Configs: []checker.CIConfig{
+						{System: checker.CIJenkins, File: checker.File{Path: "Jenkinsfile"}, RunsTests: true},
+					},
 				},
 				dl: &scut.TestDetailLogger{},
 			},
-			want:    true,
-			wantErr: false,
+			want: 5,
 		},
-	}
-	for _, tt := range tests {
-		tt := tt
-		t.Run(tt.name, func(t *testing.T) {
-			t.Parallel()
-			got, err := prHasSuccessfulCheck(tt.args.r, tt.args.dl)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("prHasSuccessfulCheck() error = %v, wantErr %v", err, tt.wantErr)
-				return
-			}
-			if got != tt.want { //nolint:govet
-				t.Errorf("prHasSuccessfulCheck() got = %v, want %v", got, tt.want)
-			}
-		})
-	}
-}
-
-func TestCITests(t *testing.T) {
-	t.Parallel()
-	type args struct { //nolint:govet
-		in0 string
-		c   *checker.CITestData
-		dl  checker.DetailLogger
-	}
-	tests := []struct { //nolint:govet
-		name string
-		args args
-		want int
-	}{
 		{
-			name: "Status completed with failure",
+			name: "generic job of a CI system whose configuration does not run tests",
 			args: args{
-				in0: "",
 				c: &checker.CITestData{
 					CIInfo: []checker.RevisionCIInfo{
 						{
-							CheckRuns: []clients.CheckRun{
-								{
-									Status: "completed",
-									App:    clients.CheckRunApp{Slug: "e2e"},
-								},
-							},
-							Statuses: []clients.Status{
-								{
-									State:     "failure",
-									Context:   CheckCITests,
-									TargetURL: "e2e",
-								},
+							Jobs: []checker.CIJob{
+								{System: checker.CIGitHubActions, Kind: checker.CIJobUnknown, Success: true},
 							},
 						},
 					},
+					Configs: []checker.CIConfig{
+						{System: checker.CIGitHubActions, File: checker.File{Path: ".github/workflows/lint.yml"}},
+					},
 				},
 				dl: &scut.TestDetailLogger{},
 			},
 			want: 0,
 		},
 		{
-			name: "valid",
+			name: "build job of a CI system whose configuration runs tests",
 			args: args{
-				in0: "",
 				c: &checker.CITestData{
 					CIInfo: []checker.RevisionCIInfo{
 						{
-							CheckRuns: []clients.CheckRun{
-								{
-									Status:     "completed",
-									Conclusion: "success",
-									App:        clients.CheckRunApp{Slug: "e2e"},
-								},
-							},
-							Statuses: []clients.Status{
-								{
-									State:     "success",
-									Context:   CheckCITests,
-									TargetURL: "e2e",
-								},
+							Jobs: []checker.CIJob{
+								{System: checker.CIGitHubActions, Name: "CI / build", Kind: checker.CIJobBuild, Success: true},
 							},
 						},
 					},
+					Configs: []checker.CIConfig{
+						{System: checker.CIGitHubActions, File: checker.File{Path: ".github/workflows/ci.yml"}, RunsTests: true},
+					},
 				},
 				dl: &scut.TestDetailLogger{},
 			},
 			want: 10,
 		},
-		{
-			name: "no ci info",
-			args: args{
-				in0: "",
-				c:   &checker.CITestData{},
-				dl:  &scut.TestDetailLogger{},
-			},
-			want: -1,
-		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,100 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"fmt"
+
+	"github.com/ossf/scorecard/v4/checker"
+	sce "github.com/ossf/scorecard/v4/errors"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/codeownersCoverFiles"
+	"github.com/ossf/scorecard/v4/probes/codeownersCoverSensitivePaths"
+	"github.com/ossf/scorecard/v4/probes/codeownersOwnersValid"
+	"github.com/ossf/scorecard/v4/probes/hasCodeownersFile"
+)
+
+const (
+	// codeownersCoverageWeight is the part of the score given by the share of files with owners.
+	codeownersCoverageWeight = 4
+	// codeownersSensitiveWeight is the part of the score given by the share of sensitive files with owners.
+	codeownersSensitiveWeight = 4
+	// codeownersValidOwnersWeight is the part of the score given when all the owners are valid.
+	codeownersValidOwnersWeight = 2
+)
+
+// Codeowners applies the score policy for the Code-Owners check.
+func Codeowners(name string,
+	findings []finding.Finding,
+	dl checker.DetailLogger,
+) checker.CheckResult {
+	expectedProbes := []string{
+		hasCodeownersFile.Probe,
+		codeownersOwnersValid.Probe,
+		codeownersCoverSensitivePaths.Probe,
+		codeownersCoverFiles.Probe,
+	}
+
+	if !finding.UniqueProbesEqual(findings, expectedProbes) {
+		e := sce.WithMessage(sce.ErrScorecardInternal, "invalid probe results")
+		return checker.CreateRuntimeErrorResult(name, e)
+	}
+
+	checker.LogFindings(findings, dl)
+
+	var invalidOwners, ownedSensitive, unownedSensitive int
+	coverage := 0.0
+	for i := range findings {
+		f := &findings[i]
+		switch f.Probe {
+		case hasCodeownersFile.Probe:
+			if f.Outcome == finding.OutcomeNegative {
+				return checker.CreateMinScoreResult(name, "no CODEOWNERS file found")
+			}
+		case codeownersOwnersValid.Probe:
+			if f.Outcome == finding.OutcomeNegative {
+				invalidOwners++
+			}
+		case codeownersCoverSensitivePaths.Probe:
+			switch f.Outcome {
+			case finding.OutcomePositive:
+				ownedSensitive++
+			case finding.OutcomeNegative:
+				unownedSensitive++
+			default:
+			}
+		case codeownersCoverFiles.Probe:
+			if total := f.Values[codeownersCoverFiles.TotalFilesKey]; total > 0 {
+				coverage = float64(f.Values[codeownersCoverFiles.OwnedFilesKey]) / float64(total)
+			}
+		}
+	}
+
+	sensitiveCoverage := 1.0
+	if ownedSensitive+unownedSensitive > 0 {
+		sensitiveCoverage = float64(ownedSensitive) / float64(ownedSensitive+unownedSensitive)
+	}
+	score := coverage*codeownersCoverageWeight + sensitiveCoverage*codeownersSensitiveWeight
+	if invalidOwners == 0 {
+		score += codeownersValidOwnersWeight
+	}
+
+	reason := fmt.Sprintf("%.0f%% of files and %d out of %d sensitive files have code owners",
+		coverage*100, ownedSensitive, ownedSensitive+unownedSensitive)
+	if invalidOwners > 0 {
+		reason += fmt.Sprintf(", %d invalid code owners", invalidOwners)
+	}
+	return checker.CreateResultWithScore(name, reason, int(score))
+}
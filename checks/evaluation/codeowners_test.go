@@ -0,0 +1,108 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"testing"
+
+	"github.com/ossf/scorecard/v4/checker"
+	sce "github.com/ossf/scorecard/v4/errors"
+	"github.com/ossf/scorecard/v4/finding"
+	scut "github.com/ossf/scorecard/v4/utests"
+)
+
+func TestCodeowners(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		findings []finding.Finding
+		result   scut.TestReturn
+	}{
+		{
+			name: "no CODEOWNERS file",
+			findings: []finding.Finding{
+				{Probe: "hasCodeownersFile", Outcome: finding.OutcomeNegative},
+				{Probe: "codeownersOwnersValid", Outcome: finding.OutcomeNotApplicable},
+				{Probe: "codeownersCoverSensitivePaths", Outcome: finding.OutcomeNotApplicable},
+				{Probe: "codeownersCoverFiles", Outcome: finding.OutcomeNotApplicable},
+			},
+			result: scut.TestReturn{
+				Score:         checker.MinResultScore,
+				NumberOfWarn:  1,
+				NumberOfDebug: 3,
+			},
+		},
+		{
+			name: "all files owned by valid owners",
+			findings: []finding.Finding{
+				{Probe: "hasCodeownersFile", Outcome: finding.OutcomePositive},
+				{Probe: "codeownersOwnersValid", Outcome: finding.OutcomePositive},
+				{Probe: "codeownersCoverSensitivePaths", Outcome: finding.OutcomePositive},
+				{
+					Probe:   "codeownersCoverFiles",
+					Outcome: finding.OutcomePositive,
+					Values:  map[string]int{"ownedFiles": 20, "totalFiles": 20},
+				},
+			},
+			result: scut.TestReturn{
+				Score:        checker.MaxResultScore,
+				NumberOfInfo: 4,
+			},
+		},
+		{
+			name: "unowned sensitive files and invalid owner",
+			findings: []finding.Finding{
+				{Probe: "hasCodeownersFile", Outcome: finding.OutcomePositive},
+				{Probe: "codeownersOwnersValid", Outcome: finding.OutcomePositive},
+				{Probe: "codeownersOwnersValid", Outcome: finding.OutcomeNegative},
+				{Probe: "codeownersOwnersValid", Outcome: finding.OutcomeNotAvailable},
+				{Probe: "codeownersCoverSensitivePaths", Outcome: finding.OutcomePositive},
+				{Probe: "codeownersCoverSensitivePaths", Outcome: finding.OutcomeNegative},
+				{
+					Probe:   "codeownersCoverFiles",
+					Outcome: finding.OutcomeNegative,
+					Values:  map[string]int{"ownedFiles": 5, "totalFiles": 10},
+				},
+			},
+			result: scut.TestReturn{
+				Score:         4,
+				NumberOfInfo:  3,
+				NumberOfWarn:  3,
+				NumberOfDebug: 1,
+			},
+		},
+		{
+			name: "missing probe",
+			findings: []finding.Finding{
+				{Probe: "hasCodeownersFile", Outcome: finding.OutcomePositive},
+			},
+			result: scut.TestReturn{
+				Score: checker.InconclusiveResultScore,
+				Error: sce.ErrScorecardInternal,
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Parallel testing scoping hack.
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			dl := scut.TestDetailLogger{}
+			got := Codeowners(tt.name, tt.findings, &dl)
+			if !scut.ValidateTestReturn(t, tt.name, &tt.result, &got, &dl) {
+				t.Errorf("got %v, expected %v", got, tt.result)
+			}
+		})
+	}
+}
@@ -21,6 +21,8 @@ import (
 	"github.com/ossf/scorecard/v4/checker"
 	sce "github.com/ossf/scorecard/v4/errors"
 	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/busFactorAboveOne"
+	"github.com/ossf/scorecard/v4/probes/commitsFromMultipleAffiliations"
 	"github.com/ossf/scorecard/v4/probes/contributorsFromOrgOrCompany"
 )
 
@@ -33,8 +35,11 @@ func Contributors(name string,
 	findings []finding.Finding,
 	dl checker.DetailLogger,
 ) checker.CheckResult {
+	// The bus factor and the affiliations of the commits do not change the score.
 	expectedProbes := []string{
 		contributorsFromOrgOrCompany.Probe,
+		busFactorAboveOne.Probe,
+		commitsFromMultipleAffiliations.Probe,
 	}
 
 	if !finding.UniqueProbesEqual(findings, expectedProbes) {
@@ -72,7 +77,7 @@ func logFindings(findings []finding.Finding, dl checker.DetailLogger) {
 	var sb strings.Builder
 	for i := range findings {
 		f := &findings[i]
-		if f.Outcome == finding.OutcomePositive {
+		if f.Outcome == finding.OutcomePositive && f.Probe == contributorsFromOrgOrCompany.Probe {
 			sb.WriteString(fmt.Sprintf("%s, ", f.Message))
 		}
 	}
@@ -39,6 +39,14 @@ func TestContributors(t *testing.T) {
 					Probe:   "contributorsFromOrgOrCompany",
 					Outcome: finding.OutcomePositive,
 				},
+				{
+					Probe:   "busFactorAboveOne",
+					Outcome: finding.OutcomeNotApplicable,
+				},
+				{
+					Probe:   "commitsFromMultipleAffiliations",
+					Outcome: finding.OutcomeNotApplicable,
+				},
 			},
 			result: scut.TestReturn{
 				Score:        6,
@@ -51,6 +59,14 @@ func TestContributors(t *testing.T) {
 					Probe:   "contributorsFromOrgOrCompany",
 					Outcome: finding.OutcomeNegative,
 				},
+				{
+					Probe:   "busFactorAboveOne",
+					Outcome: finding.OutcomeNotApplicable,
+				},
+				{
+					Probe:   "commitsFromMultipleAffiliations",
+					Outcome: finding.OutcomeNotApplicable,
+				},
 			},
 			result: scut.TestReturn{
 				Score: 0,
@@ -70,12 +86,41 @@ func TestContributors(t *testing.T) {
 					Probe:   "contributorsFromOrgOrCompany",
 					Outcome: finding.OutcomePositive,
 				},
+				{
+					Probe:   "busFactorAboveOne",
+					Outcome: finding.OutcomeNotApplicable,
+				},
+				{
+					Probe:   "commitsFromMultipleAffiliations",
+					Outcome: finding.OutcomeNotApplicable,
+				},
 			},
 			result: scut.TestReturn{
 				Score:        checker.MaxResultScore,
 				NumberOfInfo: 1,
 			},
 		},
+		{
+			name: "Bus factor and affiliations do not change the score",
+			findings: []finding.Finding{
+				{
+					Probe:   "contributorsFromOrgOrCompany",
+					Outcome: finding.OutcomePositive,
+				},
+				{
+					Probe:   "busFactorAboveOne",
+					Outcome: finding.OutcomeNegative,
+				},
+				{
+					Probe:   "commitsFromMultipleAffiliations",
+					Outcome: finding.OutcomePositive,
+				},
+			},
+			result: scut.TestReturn{
+				Score:        3,
+				NumberOfInfo: 1,
+			},
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
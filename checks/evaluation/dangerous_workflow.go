@@ -45,6 +45,7 @@ func DangerousWorkflow(name string, dl checker.DetailLogger,
 			err := sce.WithMessage(sce.ErrScorecardInternal, "invalid type")
 			return checker.CreateRuntimeErrorResult(name, err)
 		}
+		text += calledFrom(e.Caller)
 
 		dl.Warn(&checker.LogMessage{
 			Path:    e.File.Path,
@@ -61,6 +62,15 @@ func DangerousWorkflow(name string, dl checker.DetailLogger,
 	return createResult(name, checker.MaxResultScore)
 }
 
+// calledFrom describes the caller of the composite action or reusable
+// workflow where a finding was detected, if any.
+func calledFrom(caller *checker.File) string {
+	if caller == nil {
+		return ""
+	}
+	return fmt.Sprintf(" (called from %s:%d)", caller.Path, caller.Offset)
+}
+
 // Create the result.
 func createResult(name string, score int) checker.CheckResult {
 	if score != checker.MaxResultScore {
@@ -0,0 +1,79 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"fmt"
+
+	"github.com/ossf/scorecard/v4/checker"
+	sce "github.com/ossf/scorecard/v4/errors"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/dependencySourcesScoped"
+	"github.com/ossf/scorecard/v4/probes/packageRegistriesUseHTTPS"
+	"github.com/ossf/scorecard/v4/probes/registryCredentialsNotEmbedded"
+)
+
+// dependencyConfusionPenalties are the points lost for each kind of issue,
+// once regardless of the number of configuration files affected.
+var dependencyConfusionPenalties = map[string]int{
+	dependencySourcesScoped.Probe:        5,
+	registryCredentialsNotEmbedded.Probe: 5,
+	packageRegistriesUseHTTPS.Probe:      3,
+}
+
+// DependencyConfusion applies the score policy for the Dependency-Confusion check.
+func DependencyConfusion(name string,
+	findings []finding.Finding,
+	dl checker.DetailLogger,
+) checker.CheckResult {
+	expectedProbes := []string{
+		dependencySourcesScoped.Probe,
+		packageRegistriesUseHTTPS.Probe,
+		registryCredentialsNotEmbedded.Probe,
+	}
+
+	if !finding.UniqueProbesEqual(findings, expectedProbes) {
+		e := sce.WithMessage(sce.ErrScorecardInternal, "invalid probe results")
+		return checker.CreateRuntimeErrorResult(name, e)
+	}
+
+	if findings[0].Outcome == finding.OutcomeNotApplicable {
+		return checker.CreateInconclusiveResult(name, "no package registry configuration found")
+	}
+
+	checker.LogFindings(findings, dl)
+
+	issues := 0
+	failedProbes := make(map[string]bool)
+	for i := range findings {
+		if findings[i].Outcome == finding.OutcomeNegative {
+			issues++
+			failedProbes[findings[i].Probe] = true
+		}
+	}
+	if issues == 0 {
+		return checker.CreateMaxScoreResult(name, "no risky package registry configuration detected")
+	}
+
+	score := checker.MaxResultScore
+	for probe := range failedProbes {
+		score -= dependencyConfusionPenalties[probe]
+	}
+	if score < checker.MinResultScore {
+		score = checker.MinResultScore
+	}
+	return checker.CreateResultWithScore(name,
+		fmt.Sprintf("%d risky package registry settings detected", issues), score)
+}
@@ -0,0 +1,101 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"testing"
+
+	"github.com/ossf/scorecard/v4/checker"
+	sce "github.com/ossf/scorecard/v4/errors"
+	"github.com/ossf/scorecard/v4/finding"
+	scut "github.com/ossf/scorecard/v4/utests"
+)
+
+func TestDockerfileHardening(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		findings []finding.Finding
+		result   scut.TestReturn
+	}{
+		{
+			name: "no Dockerfiles",
+			findings: []finding.Finding{
+				{Probe: "dockerfileRunsAsNonRoot", Outcome: finding.OutcomeNotApplicable},
+				{Probe: "dockerfileNoRemoteAdd", Outcome: finding.OutcomeNotApplicable},
+				{Probe: "dockerfileNoSecretsInArgsOrEnv", Outcome: finding.OutcomeNotApplicable},
+				{Probe: "dockerfileNoFetchPipeExecute", Outcome: finding.OutcomeNotApplicable},
+				{Probe: "dockerfileServicesHaveHealthcheck", Outcome: finding.OutcomeNotApplicable},
+				{Probe: "dockerfileNoLatestBaseImage", Outcome: finding.OutcomeNotApplicable},
+			},
+			result: scut.TestReturn{
+				Score: checker.InconclusiveResultScore,
+			},
+		},
+		{
+			name: "hardened Dockerfiles",
+			findings: []finding.Finding{
+				{Probe: "dockerfileRunsAsNonRoot", Outcome: finding.OutcomePositive},
+				{Probe: "dockerfileNoRemoteAdd", Outcome: finding.OutcomePositive},
+				{Probe: "dockerfileNoSecretsInArgsOrEnv", Outcome: finding.OutcomePositive},
+				{Probe: "dockerfileNoFetchPipeExecute", Outcome: finding.OutcomePositive},
+				{Probe: "dockerfileServicesHaveHealthcheck", Outcome: finding.OutcomePositive},
+				{Probe: "dockerfileNoLatestBaseImage", Outcome: finding.OutcomePositive},
+			},
+			result: scut.TestReturn{
+				Score:        checker.MaxResultScore,
+				NumberOfInfo: 6,
+			},
+		},
+		{
+			name: "root user in two Dockerfiles and latest base image",
+			findings: []finding.Finding{
+				{Probe: "dockerfileRunsAsNonRoot", Outcome: finding.OutcomeNegative},
+				{Probe: "dockerfileRunsAsNonRoot", Outcome: finding.OutcomeNegative},
+				{Probe: "dockerfileNoRemoteAdd", Outcome: finding.OutcomePositive},
+				{Probe: "dockerfileNoSecretsInArgsOrEnv", Outcome: finding.OutcomePositive},
+				{Probe: "dockerfileNoFetchPipeExecute", Outcome: finding.OutcomePositive},
+				{Probe: "dockerfileServicesHaveHealthcheck", Outcome: finding.OutcomePositive},
+				{Probe: "dockerfileNoLatestBaseImage", Outcome: finding.OutcomeNegative},
+			},
+			result: scut.TestReturn{
+				Score:        6,
+				NumberOfInfo: 4,
+				NumberOfWarn: 3,
+			},
+		},
+		{
+			name: "missing probe",
+			findings: []finding.Finding{
+				{Probe: "dockerfileRunsAsNonRoot", Outcome: finding.OutcomePositive},
+			},
+			result: scut.TestReturn{
+				Score: checker.InconclusiveResultScore,
+				Error: sce.ErrScorecardInternal,
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Parallel testing scoping hack.
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			dl := scut.TestDetailLogger{}
+			got := DockerfileHardening(tt.name, tt.findings, &dl)
+			if !scut.ValidateTestReturn(t, tt.name, &tt.result, &got, &dl) {
+				t.Errorf("got %v, expected %v", got, tt.result)
+			}
+		})
+	}
+}
@@ -19,8 +19,11 @@ import (
 	sce "github.com/ossf/scorecard/v4/errors"
 	"github.com/ossf/scorecard/v4/finding"
 	"github.com/ossf/scorecard/v4/probes/hasFSFOrOSIApprovedLicense"
+	"github.com/ossf/scorecard/v4/probes/hasLicenseDeclaredInSourceFiles"
+	"github.com/ossf/scorecard/v4/probes/hasLicenseExpressionsMatchingLicenseFile"
 	"github.com/ossf/scorecard/v4/probes/hasLicenseFile"
 	"github.com/ossf/scorecard/v4/probes/hasLicenseFileAtTopDir"
+	"github.com/ossf/scorecard/v4/probes/hasValidLicenseExpressions"
 )
 
 // License applies the score policy for the License check.
@@ -28,11 +31,14 @@ func License(name string,
 	findings []finding.Finding,
 	dl checker.DetailLogger,
 ) checker.CheckResult {
-	// We have 3 unique probes, each should have a finding.
+	// We have 6 unique probes, each should have a finding.
 	expectedProbes := []string{
 		hasLicenseFile.Probe,
 		hasFSFOrOSIApprovedLicense.Probe,
 		hasLicenseFileAtTopDir.Probe,
+		hasLicenseDeclaredInSourceFiles.Probe,
+		hasValidLicenseExpressions.Probe,
+		hasLicenseExpressionsMatchingLicenseFile.Probe,
 	}
 
 	if !finding.UniqueProbesEqual(findings, expectedProbes) {
@@ -43,8 +49,18 @@ func License(name string,
 	// Compute the score.
 	score := 0
 	m := make(map[string]bool)
+	var declarationFindings []finding.Finding
 	for i := range findings {
 		f := &findings[i]
+		switch f.Probe {
+		case hasLicenseDeclaredInSourceFiles.Probe,
+			hasValidLicenseExpressions.Probe,
+			hasLicenseExpressionsMatchingLicenseFile.Probe:
+			// The file-level license declarations are
+			// reported, but do not change the score.
+			declarationFindings = append(declarationFindings, *f)
+			continue
+		}
 		switch f.Outcome {
 		case finding.OutcomeNotApplicable:
 			dl.Info(&checker.LogMessage{
@@ -97,6 +113,8 @@ func License(name string,
 			continue // for linting
 		}
 	}
+	checker.LogFindings(declarationFindings, dl)
+
 	_, defined := m[hasLicenseFile.Probe]
 	if !defined {
 		if score > 0 {
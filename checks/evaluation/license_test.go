@@ -44,10 +44,23 @@ func TestLicense(t *testing.T) {
 					Probe:   "hasLicenseFileAtTopDir",
 					Outcome: finding.OutcomePositive,
 				},
+				{
+					Probe:   "hasLicenseDeclaredInSourceFiles",
+					Outcome: finding.OutcomeNotApplicable,
+				},
+				{
+					Probe:   "hasValidLicenseExpressions",
+					Outcome: finding.OutcomeNotApplicable,
+				},
+				{
+					Probe:   "hasLicenseExpressionsMatchingLicenseFile",
+					Outcome: finding.OutcomeNotApplicable,
+				},
 			},
 			result: scut.TestReturn{
-				Score:        checker.MaxResultScore,
-				NumberOfInfo: 2,
+				Score:         checker.MaxResultScore,
+				NumberOfInfo:  2,
+				NumberOfDebug: 3,
 			},
 		}, {
 			name: "Negative outcomes from all probes = Min score",
@@ -64,10 +77,23 @@ func TestLicense(t *testing.T) {
 					Probe:   "hasLicenseFileAtTopDir",
 					Outcome: finding.OutcomeNegative,
 				},
+				{
+					Probe:   "hasLicenseDeclaredInSourceFiles",
+					Outcome: finding.OutcomeNotApplicable,
+				},
+				{
+					Probe:   "hasValidLicenseExpressions",
+					Outcome: finding.OutcomeNotApplicable,
+				},
+				{
+					Probe:   "hasLicenseExpressionsMatchingLicenseFile",
+					Outcome: finding.OutcomeNotApplicable,
+				},
 			},
 			result: scut.TestReturn{
-				Score:        checker.MinResultScore,
-				NumberOfWarn: 2,
+				Score:         checker.MinResultScore,
+				NumberOfWarn:  2,
+				NumberOfDebug: 3,
 			},
 		}, {
 			name: "Has license file but not a top level or in OSI/FSF format",
@@ -84,10 +110,23 @@ func TestLicense(t *testing.T) {
 					Probe:   "hasLicenseFileAtTopDir",
 					Outcome: finding.OutcomeNegative,
 				},
+				{
+					Probe:   "hasLicenseDeclaredInSourceFiles",
+					Outcome: finding.OutcomeNotApplicable,
+				},
+				{
+					Probe:   "hasValidLicenseExpressions",
+					Outcome: finding.OutcomeNotApplicable,
+				},
+				{
+					Probe:   "hasLicenseExpressionsMatchingLicenseFile",
+					Outcome: finding.OutcomeNotApplicable,
+				},
 			},
 			result: scut.TestReturn{
-				Score:        6,
-				NumberOfWarn: 2,
+				Score:         6,
+				NumberOfWarn:  2,
+				NumberOfDebug: 3,
 			},
 		}, {
 			name: "Findings missing a probe = Error",
@@ -120,11 +159,24 @@ func TestLicense(t *testing.T) {
 					Probe:   "hasLicenseFileAtTopDir",
 					Outcome: finding.OutcomePositive,
 				},
+				{
+					Probe:   "hasLicenseDeclaredInSourceFiles",
+					Outcome: finding.OutcomeNotApplicable,
+				},
+				{
+					Probe:   "hasValidLicenseExpressions",
+					Outcome: finding.OutcomeNotApplicable,
+				},
+				{
+					Probe:   "hasLicenseExpressionsMatchingLicenseFile",
+					Outcome: finding.OutcomeNotApplicable,
+				},
 			},
 			result: scut.TestReturn{
-				Score:        9,
-				NumberOfInfo: 1,
-				NumberOfWarn: 1,
+				Score:         9,
+				NumberOfInfo:  1,
+				NumberOfWarn:  1,
+				NumberOfDebug: 3,
 			},
 		}, {
 			name: "Has an OSI/FSF approved license but not at top level dir",
@@ -141,11 +193,61 @@ func TestLicense(t *testing.T) {
 					Probe:   "hasLicenseFileAtTopDir",
 					Outcome: finding.OutcomeNegative,
 				},
+				{
+					Probe:   "hasLicenseDeclaredInSourceFiles",
+					Outcome: finding.OutcomeNotApplicable,
+				},
+				{
+					Probe:   "hasValidLicenseExpressions",
+					Outcome: finding.OutcomeNotApplicable,
+				},
+				{
+					Probe:   "hasLicenseExpressionsMatchingLicenseFile",
+					Outcome: finding.OutcomeNotApplicable,
+				},
 			},
 			result: scut.TestReturn{
-				Score:        7,
-				NumberOfInfo: 1,
-				NumberOfWarn: 1,
+				Score:         7,
+				NumberOfInfo:  1,
+				NumberOfWarn:  1,
+				NumberOfDebug: 3,
+			},
+		}, {
+			name: "License declarations are reported but do not change the score",
+			findings: []finding.Finding{
+				{
+					Probe:   "hasLicenseFile",
+					Outcome: finding.OutcomePositive,
+				},
+				{
+					Probe:   "hasFSFOrOSIApprovedLicense",
+					Outcome: finding.OutcomePositive,
+				},
+				{
+					Probe:   "hasLicenseFileAtTopDir",
+					Outcome: finding.OutcomePositive,
+				},
+				{
+					Probe:   "hasLicenseDeclaredInSourceFiles",
+					Outcome: finding.OutcomeNegative,
+				},
+				{
+					Probe:   "hasValidLicenseExpressions",
+					Outcome: finding.OutcomePositive,
+				},
+				{
+					Probe:   "hasLicenseExpressionsMatchingLicenseFile",
+					Outcome: finding.OutcomeNegative,
+				},
+				{
+					Probe:   "hasLicenseExpressionsMatchingLicenseFile",
+					Outcome: finding.OutcomeNegative,
+				},
+			},
+			result: scut.TestReturn{
+				Score:        checker.MaxResultScore,
+				NumberOfInfo: 3,
+				NumberOfWarn: 3,
 			},
 		},
 	}
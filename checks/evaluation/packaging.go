@@ -18,7 +18,17 @@ import (
 	"github.com/ossf/scorecard/v4/checker"
 	sce "github.com/ossf/scorecard/v4/errors"
 	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/packagedInRegistry"
 	"github.com/ossf/scorecard/v4/probes/packagedWithAutomatedWorkflow"
+	"github.com/ossf/scorecard/v4/probes/packagedWithProvenance"
+	"github.com/ossf/scorecard/v4/probes/packagedWithTrustedPublishing"
+)
+
+const (
+	// longLivedTokenPenalty is the penalty for publishing with a long-lived registry token.
+	longLivedTokenPenalty = 3
+	// missingProvenancePenalty is the penalty for publishing without provenance.
+	missingProvenancePenalty = 2
 )
 
 // Packaging applies the score policy for the Packaging check.
@@ -28,6 +38,9 @@ func Packaging(name string,
 ) checker.CheckResult {
 	expectedProbes := []string{
 		packagedWithAutomatedWorkflow.Probe,
+		packagedWithTrustedPublishing.Probe,
+		packagedWithProvenance.Probe,
+		packagedInRegistry.Probe,
 	}
 
 	if !finding.UniqueProbesEqual(findings, expectedProbes) {
@@ -35,26 +48,54 @@ func Packaging(name string,
 		return checker.CreateRuntimeErrorResult(name, e)
 	}
 
-	// Currently there is only a single packaging probe that returns
-	// a single positive or negative outcome. As such, in this evaluation,
-	// we return max score if the outcome is positive and lowest score if
-	// the outcome is negative.
-	maxScore := false
-	for _, f := range findings {
-		f := f
-		if f.Outcome == finding.OutcomePositive {
-			maxScore = true
+	// The packagedWithAutomatedWorkflow probe returns a single negative
+	// outcome if no packaging workflow is detected, in which case the
+	// result is inconclusive.
+	packaged := false
+	for i := range findings {
+		f := &findings[i]
+		if f.Probe == packagedWithAutomatedWorkflow.Probe && f.Outcome == finding.OutcomePositive {
+			packaged = true
 			// Log all findings except the negative ones.
 			dl.Info(&checker.LogMessage{
-				Finding: &f,
+				Finding: f,
 			})
 		}
 	}
-	if maxScore {
-		return checker.CreateMaxScoreResult(name, "packaging workflow detected")
+	if !packaged {
+		checker.LogFindings(negativeFindings(findings), dl)
+		return checker.CreateInconclusiveResult(name,
+			"packaging workflow not detected")
 	}
 
-	checker.LogFindings(negativeFindings(findings), dl)
-	return checker.CreateInconclusiveResult(name,
-		"packaging workflow not detected")
+	// Packaging workflows lose points if they publish with long-lived
+	// registry tokens rather than trusted publishing, or without provenance.
+	var longLivedToken, noProvenance bool
+	for i := range findings {
+		f := &findings[i]
+		switch f.Probe {
+		case packagedWithTrustedPublishing.Probe:
+			longLivedToken = longLivedToken || f.Outcome == finding.OutcomeNegative
+		case packagedWithProvenance.Probe:
+			noProvenance = noProvenance || f.Outcome == finding.OutcomeNegative
+		case packagedInRegistry.Probe:
+			// The lookups of the packages in the registries are
+			// reported, but do not change the score.
+		default:
+			continue
+		}
+		checker.LogFindings([]finding.Finding{*f}, dl)
+	}
+
+	score := checker.MaxResultScore
+	reason := "packaging workflow detected"
+	if longLivedToken {
+		score -= longLivedTokenPenalty
+		reason += ", published with a long-lived registry token"
+	}
+	if noProvenance {
+		score -= missingProvenancePenalty
+		reason += ", published without provenance"
+	}
+	return checker.CreateResultWithScore(name, reason, score)
 }
@@ -36,10 +36,75 @@ func TestPackaging(t *testing.T) {
 					Probe:   "packagedWithAutomatedWorkflow",
 					Outcome: finding.OutcomePositive,
 				},
+				{
+					Probe:   "packagedWithTrustedPublishing",
+					Outcome: finding.OutcomePositive,
+				},
+				{
+					Probe:   "packagedWithProvenance",
+					Outcome: finding.OutcomePositive,
+				},
+				{
+					Probe:   "packagedInRegistry",
+					Outcome: finding.OutcomePositive,
+				},
 			},
 			result: scut.TestReturn{
 				Score:        checker.MaxResultScore,
+				NumberOfInfo: 4,
+			},
+		},
+		{
+			name: "test long-lived token without provenance",
+			findings: []finding.Finding{
+				{
+					Probe:   "packagedWithAutomatedWorkflow",
+					Outcome: finding.OutcomePositive,
+				},
+				{
+					Probe:   "packagedWithTrustedPublishing",
+					Outcome: finding.OutcomeNegative,
+				},
+				{
+					Probe:   "packagedWithProvenance",
+					Outcome: finding.OutcomeNegative,
+				},
+				{
+					Probe:   "packagedInRegistry",
+					Outcome: finding.OutcomeNegative,
+				},
+			},
+			result: scut.TestReturn{
+				Score:        5,
 				NumberOfInfo: 1,
+				NumberOfWarn: 3,
+			},
+		},
+		{
+			name: "test unknown authentication without provenance",
+			findings: []finding.Finding{
+				{
+					Probe:   "packagedWithAutomatedWorkflow",
+					Outcome: finding.OutcomePositive,
+				},
+				{
+					Probe:   "packagedWithTrustedPublishing",
+					Outcome: finding.OutcomeNotAvailable,
+				},
+				{
+					Probe:   "packagedWithProvenance",
+					Outcome: finding.OutcomeNegative,
+				},
+				{
+					Probe:   "packagedInRegistry",
+					Outcome: finding.OutcomeNotApplicable,
+				},
+			},
+			result: scut.TestReturn{
+				Score:         8,
+				NumberOfInfo:  1,
+				NumberOfWarn:  1,
+				NumberOfDebug: 2,
 			},
 		},
 		{
@@ -62,6 +127,18 @@ func TestPackaging(t *testing.T) {
 					Probe:   "packagedWithAutomatedWorkflow",
 					Outcome: finding.OutcomeNegative,
 				},
+				{
+					Probe:   "packagedWithTrustedPublishing",
+					Outcome: finding.OutcomeNotApplicable,
+				},
+				{
+					Probe:   "packagedWithProvenance",
+					Outcome: finding.OutcomeNotApplicable,
+				},
+				{
+					Probe:   "packagedInRegistry",
+					Outcome: finding.OutcomeNotApplicable,
+				},
 			},
 			result: scut.TestReturn{
 				Score:        checker.InconclusiveResultScore,
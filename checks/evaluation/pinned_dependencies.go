@@ -16,6 +16,7 @@ package evaluation
 
 import (
 	"fmt"
+	"path"
 
 	"github.com/ossf/scorecard/v4/checker"
 	"github.com/ossf/scorecard/v4/checks/fileparser"
@@ -50,6 +51,11 @@ const (
 	normalWeight            int = gitHubOwnedActionWeight + thirdPartyActionWeight
 )
 
+var ecosystemDependencyTypes = [][]checker.DependencyUseType{
+	{checker.DependencyUseTypeTerraformModule, checker.DependencyUseTypeTerraformProvider},
+	{checker.DependencyUseTypePythonLockfile, checker.DependencyUseTypePipRequirement},
+}
+
 // PinningDependencies applies the score policy for the Pinned-Dependencies check.
 func PinningDependencies(name string, c *checker.CheckRequest,
 	r *checker.PinningDependenciesData,
@@ -99,7 +105,32 @@ func PinningDependencies(name string, c *checker.CheckRequest,
 			})
 			continue
 		}
-		if !*rr.Pinned {
+		switch {
+		case rr.PinnedCommitIssue != nil && *rr.PinnedCommitIssue == checker.PinnedCommitUnverified:
+			// The commit could not be verified: it is scored as pinned.
+			dl.Debug(&checker.LogMessage{
+				Path:      rr.Location.Path,
+				Type:      rr.Location.Type,
+				Offset:    rr.Location.Offset,
+				EndOffset: rr.Location.EndOffset,
+				Text:      generatePinnedCommitIssueText(&rr),
+				Snippet:   rr.Location.Snippet,
+			})
+		case rr.PinnedCommitIssue != nil:
+			dl.Warn(&checker.LogMessage{
+				Path:      rr.Location.Path,
+				Type:      rr.Location.Type,
+				Offset:    rr.Location.Offset,
+				EndOffset: rr.Location.EndOffset,
+				Text:      generatePinnedCommitIssueText(&rr),
+				Snippet:   rr.Location.Snippet,
+			})
+			// An impostor commit offers no guarantee about the code being run.
+			if *rr.PinnedCommitIssue == checker.PinnedCommitImpostor {
+				unpinned := false
+				rr.Pinned = &unpinned
+			}
+		case !*rr.Pinned:
 			dl.Warn(&checker.LogMessage{
 				Path:        rr.Location.Path,
 				Type:        rr.Location.Type,
@@ -127,7 +158,7 @@ func PinningDependencies(name string, c *checker.CheckRequest,
 		scores = append(scores, checker.ProportionalScoreWeighted{
 			Success: pr[t].pinned,
 			Total:   pr[t].total,
-			Weight:  normalWeight,
+			Weight:  weightForDependencyType(t, pr),
 		})
 	}
 
@@ -151,14 +182,60 @@ func PinningDependencies(name string, c *checker.CheckRequest,
 func generateRemediation(remediationMd *remediation.RemediationMetadata, rr *checker.Dependency) *rule.Remediation {
 	switch rr.Type {
 	case checker.DependencyUseTypeGHAction:
+		// The remediation tool only updates workflows, not composite actions.
+		if !fileparser.IsWorkflowFile(rr.Location.Path) {
+			return nil
+		}
 		return remediationMd.CreateWorkflowPinningRemediation(rr.Location.Path)
-	case checker.DependencyUseTypeDockerfileContainerImage:
+	case checker.DependencyUseTypeDockerfileContainerImage,
+		checker.DependencyUseTypeManifestContainerImage:
 		return remediation.CreateDockerfilePinningRemediation(rr, remediation.CraneDigester{})
+	case checker.DependencyUseTypeTerraformModule:
+		return remediation.CreateTerraformModulePinningRemediation(rr)
+	case checker.DependencyUseTypeTerraformProvider:
+		return remediation.CreateTerraformProviderPinningRemediation(rr)
 	default:
 		return nil
 	}
 }
 
+// weightForDependencyType returns the weight of a dependency type. Dependency types
+// that are part of the same ecosystem, e.g., Terraform modules and providers,
+// share its weight equally when several of them are present.
+func weightForDependencyType(t checker.DependencyUseType, pr map[checker.DependencyUseType]pinnedResult) int {
+	for _, ecosystem := range ecosystemDependencyTypes {
+		if !isDependencyTypeIn(t, ecosystem) {
+			continue
+		}
+		present := 0
+		for _, et := range ecosystem {
+			if _, ok := pr[et]; ok {
+				present++
+			}
+		}
+		return normalWeight / present
+	}
+	return normalWeight
+}
+
+func isDependencyTypeIn(t checker.DependencyUseType, types []checker.DependencyUseType) bool {
+	for _, et := range types {
+		if t == et {
+			return true
+		}
+	}
+	return false
+}
+
+func isLockfileDependencyType(t checker.DependencyUseType) bool {
+	return isDependencyTypeIn(t, []checker.DependencyUseType{
+		checker.DependencyUseTypeNpmLockfile,
+		checker.DependencyUseTypePythonLockfile,
+		checker.DependencyUseTypeCargoLockfile,
+		checker.DependencyUseTypeGemLockfile,
+	})
+}
+
 func updatePinningResults(rr *checker.Dependency,
 	wp *worklowPinningResult, pr map[checker.DependencyUseType]pinnedResult,
 ) {
@@ -181,10 +258,38 @@ func generateText(rr *checker.Dependency) string {
 		// Check if we are dealing with a GitHub action or a third-party one.
 		gitHubOwned := fileparser.IsGitHubOwnedAction(rr.Location.Snippet)
 		owner := generateOwnerToDisplay(gitHubOwned)
-		return fmt.Sprintf("%s not pinned by hash", owner)
+		return fmt.Sprintf("%s not pinned by hash", owner) + calledFrom(rr.Caller)
+	}
+
+	if isLockfileDependencyType(rr.Type) {
+		// Manifests without a lockfile have no version to be pinned at.
+		if rr.PinnedAt == nil {
+			return fmt.Sprintf("%s has no %s", path.Base(rr.Location.Path), rr.Type)
+		}
+		return fmt.Sprintf("%s without integrity hashes", rr.Type)
 	}
 
-	return fmt.Sprintf("%s not pinned by hash", rr.Type)
+	return fmt.Sprintf("%s not pinned by hash", rr.Type) + calledFrom(rr.Caller)
+}
+
+func generatePinnedCommitIssueText(rr *checker.Dependency) string {
+	switch *rr.PinnedCommitIssue {
+	case checker.PinnedCommitImpostor:
+		return fmt.Sprintf("%s pinned at a commit not found in any branch or tag of %s", rr.Type, *rr.Name) +
+			calledFrom(rr.Caller)
+	case checker.PinnedCommitMislabeled:
+		version := ""
+		if rr.VersionComment != nil {
+			version = *rr.VersionComment
+		}
+		return fmt.Sprintf("%s pinned at a commit not matching its version comment '%s'", rr.Type, version) +
+			calledFrom(rr.Caller)
+	case checker.PinnedCommitUnverified:
+		return fmt.Sprintf("%s pinned at a commit that could not be verified against %s", rr.Type, *rr.Name) +
+			calledFrom(rr.Caller)
+	default:
+		return fmt.Sprintf("%s pinned at a commit that failed verification", rr.Type) + calledFrom(rr.Caller)
+	}
 }
 
 func generateOwnerToDisplay(gitHubOwned bool) string {
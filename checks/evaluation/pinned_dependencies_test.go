@@ -231,6 +231,10 @@ func asPointer(s string) *string {
 	return &s
 }
 
+func asPinnedCommitIssuePointer(i checker.PinnedCommitIssue) *checker.PinnedCommitIssue {
+	return &i
+}
+
 func asBoolPointer(b bool) *bool {
 	return &b
 }
@@ -294,6 +298,58 @@ func Test_PinningDependencies(t *testing.T) {
 				NumberOfDebug: 0,
 			},
 		},
+		{
+			name: "pinned actions with commit issues",
+			dependencies: []checker.Dependency{
+				{
+					Location: &checker.File{
+						Snippet: "actions/checkout@a81bbbf8298c0fa03ea29cdc473d45769f953675",
+					},
+					Name:              asPointer("actions/checkout"),
+					VersionComment:    asPointer("v3.1.0"),
+					Type:              checker.DependencyUseTypeGHAction,
+					Pinned:            asBoolPointer(true),
+					PinnedCommitIssue: asPinnedCommitIssuePointer(checker.PinnedCommitMislabeled),
+				},
+				{
+					Location: &checker.File{
+						Snippet: "other/checkout@a81bbbf8298c0fa03ea29cdc473d45769f953675",
+					},
+					Name:              asPointer("other/checkout"),
+					Type:              checker.DependencyUseTypeGHAction,
+					Pinned:            asBoolPointer(true),
+					PinnedCommitIssue: asPinnedCommitIssuePointer(checker.PinnedCommitImpostor),
+				},
+			},
+			expected: scut.TestReturn{
+				Error:         nil,
+				Score:         2,
+				NumberOfWarn:  2,
+				NumberOfInfo:  2,
+				NumberOfDebug: 0,
+			},
+		},
+		{
+			name: "pinned action with unverified commit",
+			dependencies: []checker.Dependency{
+				{
+					Location: &checker.File{
+						Snippet: "other/checkout@a81bbbf8298c0fa03ea29cdc473d45769f953675",
+					},
+					Name:              asPointer("other/checkout"),
+					Type:              checker.DependencyUseTypeGHAction,
+					Pinned:            asBoolPointer(true),
+					PinnedCommitIssue: asPinnedCommitIssuePointer(checker.PinnedCommitUnverified),
+				},
+			},
+			expected: scut.TestReturn{
+				Error:         nil,
+				Score:         10,
+				NumberOfWarn:  0,
+				NumberOfInfo:  1,
+				NumberOfDebug: 1,
+			},
+		},
 		{
 			name: "all dependencies unpinned",
 			dependencies: []checker.Dependency{
@@ -796,6 +852,92 @@ func Test_PinningDependencies(t *testing.T) {
 				NumberOfDebug: 0,
 			},
 		},
+		{
+			name: "manifest container images with unresolved reference",
+			dependencies: []checker.Dependency{
+				{
+					Location: &checker.File{
+						Snippet: "image: nginx@sha256:4c0fdaa8b6341bfdeca5f18f7837462c80cff90527ee35ef185571e1c327beac",
+					},
+					Type:   checker.DependencyUseTypeManifestContainerImage,
+					Pinned: asBoolPointer(true),
+				},
+				{
+					Location: &checker.File{
+						Snippet: "image: {{ .Values.image }}",
+					},
+					Msg:  asPointer("unable to resolve templated image reference"),
+					Type: checker.DependencyUseTypeManifestContainerImage,
+				},
+			},
+			expected: scut.TestReturn{
+				Error:         nil,
+				Score:         10,
+				NumberOfWarn:  0,
+				NumberOfInfo:  1,
+				NumberOfDebug: 1,
+			},
+		},
+		{
+			name: "Terraform modules and providers share the ecosystem weight",
+			dependencies: []checker.Dependency{
+				{
+					Location: &checker.File{},
+					Name:     asPointer("terraform-aws-modules/vpc/aws"),
+					Type:     checker.DependencyUseTypeTerraformModule,
+					Pinned:   asBoolPointer(true),
+				},
+				{
+					Location: &checker.File{},
+					Name:     asPointer("hashicorp/aws"),
+					Type:     checker.DependencyUseTypeTerraformProvider,
+					Pinned:   asBoolPointer(false),
+				},
+				{
+					Location: &checker.File{},
+					Type:     checker.DependencyUseTypeGoCommand,
+					Pinned:   asBoolPointer(true),
+				},
+			},
+			expected: scut.TestReturn{
+				Error:         nil,
+				Score:         7,
+				NumberOfWarn:  1,
+				NumberOfInfo:  3,
+				NumberOfDebug: 0,
+			},
+		},
+		{
+			name: "Python lockfiles and requirements share the ecosystem weight",
+			dependencies: []checker.Dependency{
+				{
+					Location: &checker.File{Path: "pyproject.toml"},
+					Name:     asPointer("pyproject.toml"),
+					Type:     checker.DependencyUseTypePythonLockfile,
+					Pinned:   asBoolPointer(false),
+				},
+				{
+					Location: &checker.File{},
+					Name:     asPointer("requests"),
+					Type:     checker.DependencyUseTypePipRequirement,
+					Pinned:   asBoolPointer(true),
+				},
+				{
+					Location: &checker.File{},
+					Name:     asPointer("package-lock.json"),
+					PinnedAt: asPointer("package-lock.json"),
+					Type:     checker.DependencyUseTypeNpmLockfile,
+					Pinned:   asBoolPointer(true),
+				},
+			},
+			expected: scut.TestReturn{
+				Error:         nil,
+				Score:         7,
+				NumberOfWarn:  1,
+				NumberOfInfo:  3,
+				NumberOfDebug: 0,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -986,6 +1128,27 @@ func TestGenerateText(t *testing.T) {
 			},
 			expectedText: "third-party GitHubAction not pinned by hash",
 		},
+		{
+			name: "Manifest without lockfile",
+			dependency: &checker.Dependency{
+				Type: checker.DependencyUseTypeNpmLockfile,
+				Location: &checker.File{
+					Path: "web/package.json",
+				},
+			},
+			expectedText: "package.json has no npmLockfile",
+		},
+		{
+			name: "Lockfile without integrity hashes",
+			dependency: &checker.Dependency{
+				Type:     checker.DependencyUseTypeCargoLockfile,
+				PinnedAt: asPointer("Cargo.lock"),
+				Location: &checker.File{
+					Path: "Cargo.lock",
+				},
+			},
+			expectedText: "cargoLockfile without integrity hashes",
+		},
 	}
 
 	for _, tc := range tests {
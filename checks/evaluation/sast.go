@@ -0,0 +1,112 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"fmt"
+
+	"github.com/ossf/scorecard/v4/checker"
+	sce "github.com/ossf/scorecard/v4/errors"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/sastToolConfigured"
+	"github.com/ossf/scorecard/v4/probes/sastToolRunsOnAllCommits"
+)
+
+// SAST applies the score policy for the SAST check.
+func SAST(name string,
+	findings []finding.Finding,
+	dl checker.DetailLogger,
+) checker.CheckResult {
+	expectedProbes := []string{
+		sastToolConfigured.Probe,
+		sastToolRunsOnAllCommits.Probe,
+	}
+
+	if !finding.UniqueProbesEqual(findings, expectedProbes) {
+		e := sce.WithMessage(sce.ErrScorecardInternal, "invalid probe results")
+		return checker.CreateRuntimeErrorResult(name, e)
+	}
+
+	configured := false
+	totalMerged := 0
+	totalTested := 0
+	for i := range findings {
+		f := &findings[i]
+		switch f.Probe {
+		case sastToolConfigured.Probe:
+			if f.Outcome == finding.OutcomePositive {
+				configured = true
+				dl.Info(&checker.LogMessage{
+					Finding: f,
+				})
+			}
+		case sastToolRunsOnAllCommits.Probe:
+			switch f.Outcome {
+			case finding.OutcomePositive:
+				totalMerged++
+				totalTested++
+			case finding.OutcomeNegative:
+				totalMerged++
+			case finding.OutcomeNotApplicable:
+				dl.Warn(&checker.LogMessage{
+					Finding: f,
+				})
+				continue
+			}
+			// The pull requests are only logged in the details, the totals below sum them up.
+			dl.Debug(&checker.LogMessage{
+				Finding: f,
+			})
+		}
+	}
+
+	// No pull requests to look at: the configuration alone decides.
+	if totalMerged == 0 {
+		if configured {
+			return checker.CreateMaxScoreResult(name, "SAST tool detected")
+		}
+		dl.Warn(&checker.LogMessage{
+			Text: "no SAST tool detected",
+		})
+		return checker.CreateMinScoreResult(name, "no SAST tool detected")
+	}
+
+	if totalTested == totalMerged {
+		dl.Info(&checker.LogMessage{
+			Text: fmt.Sprintf("all commits (%v) are checked with a SAST tool", totalMerged),
+		})
+		return checker.CreateMaxScoreResult(name, "SAST tool is run on all commits")
+	}
+	dl.Warn(&checker.LogMessage{
+		Text: fmt.Sprintf("%v commits out of %v are checked with a SAST tool", totalTested, totalMerged),
+	})
+
+	sastScore := checker.CreateProportionalScore(totalTested, totalMerged)
+	if !configured {
+		return checker.CreateResultWithScore(name,
+			checker.NormalizeReason("SAST tool is not run on all commits", sastScore), sastScore)
+	}
+
+	// A configured tool which does not analyze the pull requests likely runs
+	// on a schedule or on the default branch: it weighs more than the pull requests.
+	// Warning: there is a hidden assumption that *any* sast tool is equally good.
+	const sastWeight = 3
+	const configWeight = 7
+	score := checker.AggregateScoresWithWeight(map[int]int{
+		sastScore:              sastWeight,
+		checker.MaxResultScore: configWeight,
+	})
+	return checker.CreateResultWithScore(name, "SAST tool detected but not run on all commits", score)
+}
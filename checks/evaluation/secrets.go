@@ -0,0 +1,73 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"fmt"
+
+	"github.com/ossf/scorecard/v4/checker"
+	sce "github.com/ossf/scorecard/v4/errors"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/hasHardcodedSecrets"
+	"github.com/ossf/scorecard/v4/probes/hasHighEntropySecrets"
+)
+
+// Secrets applies the score policy for the Secrets check.
+func Secrets(name string,
+	findings []finding.Finding,
+	dl checker.DetailLogger,
+) checker.CheckResult {
+	expectedProbes := []string{
+		hasHardcodedSecrets.Probe,
+		hasHighEntropySecrets.Probe,
+	}
+
+	if !finding.UniqueProbesEqual(findings, expectedProbes) {
+		e := sce.WithMessage(sce.ErrScorecardInternal, "invalid probe results")
+		return checker.CreateRuntimeErrorResult(name, e)
+	}
+
+	var secrets, heuristics int
+	for i := range findings {
+		f := &findings[i]
+		if f.Outcome != finding.OutcomeNegative {
+			continue
+		}
+		if f.Probe == hasHardcodedSecrets.Probe {
+			secrets++
+		} else {
+			heuristics++
+		}
+	}
+	checker.LogFindings(negativeFindings(findings), dl)
+
+	// A single secret with a well-known format is enough to compromise the project.
+	if secrets > 0 {
+		return checker.CreateMinScoreResult(name,
+			fmt.Sprintf("%d hard-coded secrets detected", secrets))
+	}
+
+	// The heuristic findings may be false positives: each of them
+	// only lowers the score by one point.
+	score := checker.MaxResultScore - heuristics
+	if score < checker.MinResultScore {
+		score = checker.MinResultScore
+	}
+	if heuristics == 0 {
+		return checker.CreateMaxScoreResult(name, "no hard-coded secrets detected")
+	}
+	return checker.CreateResultWithScore(name,
+		fmt.Sprintf("%d potential hard-coded secrets detected", heuristics), score)
+}
@@ -21,60 +21,84 @@ import (
 	"github.com/ossf/scorecard/v4/probes/securityPolicyContainsLinks"
 	"github.com/ossf/scorecard/v4/probes/securityPolicyContainsText"
 	"github.com/ossf/scorecard/v4/probes/securityPolicyContainsVulnerabilityDisclosure"
+	"github.com/ossf/scorecard/v4/probes/securityPolicyDefinesEmbargo"
+	"github.com/ossf/scorecard/v4/probes/securityPolicyDefinesResponseTime"
+	"github.com/ossf/scorecard/v4/probes/securityPolicyDefinesSupportedVersions"
+	"github.com/ossf/scorecard/v4/probes/securityPolicyHasPrivateReportingChannel"
 	"github.com/ossf/scorecard/v4/probes/securityPolicyPresent"
+	"github.com/ossf/scorecard/v4/probes/securityPolicyProvidesEncryptionKey"
+	"github.com/ossf/scorecard/v4/probes/securityTxtValid"
 )
 
+// securityPolicyRubric is the number of points each
+// rubric probe contributes to the score.
+var securityPolicyRubric = map[string]int{
+	securityPolicyHasPrivateReportingChannel.Probe: 3,
+	securityPolicyDefinesSupportedVersions.Probe:   2,
+	securityPolicyDefinesResponseTime.Probe:        2,
+	securityPolicyDefinesEmbargo.Probe:             2,
+	securityPolicyProvidesEncryptionKey.Probe:      1,
+}
+
+// securityTxtPenalty is the number of points an invalid or expired
+// security.txt file costs, since it may point reporters to stale contacts.
+const securityTxtPenalty = 1
+
 // SecurityPolicy applies the score policy for the Security-Policy check.
 func SecurityPolicy(name string, findings []finding.Finding, dl checker.DetailLogger) checker.CheckResult {
-	// We have 4 unique probes, each should have a finding.
 	expectedProbes := []string{
 		securityPolicyContainsVulnerabilityDisclosure.Probe,
 		securityPolicyContainsLinks.Probe,
 		securityPolicyContainsText.Probe,
 		securityPolicyPresent.Probe,
+		securityPolicyHasPrivateReportingChannel.Probe,
+		securityPolicyDefinesSupportedVersions.Probe,
+		securityPolicyDefinesResponseTime.Probe,
+		securityPolicyDefinesEmbargo.Probe,
+		securityPolicyProvidesEncryptionKey.Probe,
+		securityTxtValid.Probe,
 	}
 	if !finding.UniqueProbesEqual(findings, expectedProbes) {
 		e := sce.WithMessage(sce.ErrScorecardInternal, "invalid probe results")
 		return checker.CreateRuntimeErrorResult(name, e)
 	}
 
+	// The links, text and disclosure hints are superseded by the rubric:
+	// they are logged, but do not change the score.
 	score := 0
+	present := false
+	invalidSecurityTxt := false
 	m := make(map[string]bool)
 	for i := range findings {
 		f := &findings[i]
-		if f.Outcome == finding.OutcomePositive {
-			switch f.Probe {
-			case securityPolicyContainsVulnerabilityDisclosure.Probe:
-				score += scoreProbeOnce(f.Probe, m, 1)
-			case securityPolicyContainsLinks.Probe:
-				score += scoreProbeOnce(f.Probe, m, 6)
-			case securityPolicyContainsText.Probe:
-				score += scoreProbeOnce(f.Probe, m, 3)
-			case securityPolicyPresent.Probe:
-				m[f.Probe] = true
-			default:
-				e := sce.WithMessage(sce.ErrScorecardInternal, "unknown probe results")
-				return checker.CreateRuntimeErrorResult(name, e)
+		switch f.Probe {
+		case securityPolicyContainsVulnerabilityDisclosure.Probe,
+			securityPolicyContainsLinks.Probe,
+			securityPolicyContainsText.Probe:
+		case securityPolicyPresent.Probe:
+			present = present || f.Outcome == finding.OutcomePositive
+		case securityTxtValid.Probe:
+			invalidSecurityTxt = invalidSecurityTxt || f.Outcome == finding.OutcomeNegative
+		default:
+			if f.Outcome == finding.OutcomePositive {
+				score += scoreProbeOnce(f.Probe, m, securityPolicyRubric[f.Probe])
 			}
 		}
 	}
-	_, defined := m[securityPolicyPresent.Probe]
-	if !defined {
-		if score > 0 {
-			e := sce.WithMessage(sce.ErrScorecardInternal, "score calculation problem")
-			return checker.CreateRuntimeErrorResult(name, e)
-		}
 
-		// Log all findings.
-		checker.LogFindings(findings, dl)
+	// Log all findings.
+	checker.LogFindings(findings, dl)
+
+	if !present {
 		return checker.CreateMinScoreResult(name, "security policy file not detected")
 	}
 
-	// Log all findings.
-	// NOTE: if the score is checker.MaxResultScore, then all findings are positive.
-	// If the score is less than checker.MaxResultScore, some findings are negative,
-	// so we log both positive and negative findings.
-	checker.LogFindings(findings, dl)
+	if invalidSecurityTxt {
+		score -= securityTxtPenalty
+		if score < checker.MinResultScore {
+			score = checker.MinResultScore
+		}
+	}
 
 	return checker.CreateResultWithScore(name, "security policy file detected", score)
 }
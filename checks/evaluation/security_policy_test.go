@@ -83,104 +83,94 @@ func TestSecurityPolicy(t *testing.T) {
 		},
 		{
 			name: "file found only",
-			findings: []finding.Finding{
-				{
-					Probe:   "securityPolicyContainsVulnerabilityDisclosure",
-					Outcome: finding.OutcomeNegative,
-				},
-				{
-					Probe:   "securityPolicyContainsLinks",
-					Outcome: finding.OutcomeNegative,
-				},
-				{
-					Probe:   "securityPolicyContainsText",
-					Outcome: finding.OutcomeNegative,
-				},
-				{
-					Probe:   "securityPolicyPresent",
-					Outcome: finding.OutcomePositive,
-				},
-			},
+			findings: securityPolicyFindings(map[string]finding.Outcome{
+				"securityPolicyPresent": finding.OutcomePositive,
+			}),
 			result: scut.TestReturn{
-				Score:        checker.MinResultScore,
-				NumberOfInfo: 1,
-				NumberOfWarn: 3,
+				Score:         checker.MinResultScore,
+				NumberOfInfo:  1,
+				NumberOfWarn:  8,
+				NumberOfDebug: 1,
 			},
 		},
 		{
 			name: "file not found with positive probes",
-			findings: []finding.Finding{
-				{
-					Probe:   "securityPolicyContainsVulnerabilityDisclosure",
-					Outcome: finding.OutcomePositive,
-				},
-				{
-					Probe:   "securityPolicyContainsLinks",
-					Outcome: finding.OutcomePositive,
-				},
-				{
-					Probe:   "securityPolicyContainsText",
-					Outcome: finding.OutcomePositive,
-				},
-				{
-					Probe:   "securityPolicyPresent",
-					Outcome: finding.OutcomeNegative,
-				},
-			},
+			findings: securityPolicyFindings(map[string]finding.Outcome{
+				"securityPolicyContainsLinks":              finding.OutcomePositive,
+				"securityPolicyHasPrivateReportingChannel": finding.OutcomePositive,
+			}),
 			result: scut.TestReturn{
-				Score: checker.InconclusiveResultScore,
-				Error: sce.ErrScorecardInternal,
+				Score:         checker.MinResultScore,
+				NumberOfInfo:  2,
+				NumberOfWarn:  7,
+				NumberOfDebug: 1,
 			},
 		},
 		{
-			name: "file found with no disclosure and text",
-			findings: []finding.Finding{
-				{
-					Probe:   "securityPolicyContainsVulnerabilityDisclosure",
-					Outcome: finding.OutcomeNegative,
-				},
-				{
-					Probe:   "securityPolicyContainsLinks",
-					Outcome: finding.OutcomePositive,
-				},
-				{
-					Probe:   "securityPolicyContainsText",
-					Outcome: finding.OutcomeNegative,
-				},
-				{
-					Probe:   "securityPolicyPresent",
-					Outcome: finding.OutcomePositive,
-				},
+			name: "hit counts do not change the score",
+			findings: securityPolicyFindings(map[string]finding.Outcome{
+				"securityPolicyContainsVulnerabilityDisclosure": finding.OutcomePositive,
+				"securityPolicyContainsLinks":                   finding.OutcomePositive,
+				"securityPolicyContainsText":                    finding.OutcomePositive,
+				"securityPolicyPresent":                         finding.OutcomePositive,
+			}),
+			result: scut.TestReturn{
+				Score:         checker.MinResultScore,
+				NumberOfInfo:  4,
+				NumberOfWarn:  5,
+				NumberOfDebug: 1,
 			},
+		},
+		{
+			name: "file found with private reporting and supported versions",
+			findings: append(securityPolicyFindings(map[string]finding.Outcome{
+				"securityPolicyPresent":                    finding.OutcomePositive,
+				"securityPolicyHasPrivateReportingChannel": finding.OutcomePositive,
+				"securityPolicyDefinesSupportedVersions":   finding.OutcomePositive,
+			}), finding.Finding{
+				// Private vulnerability reporting is also enabled.
+				Probe:   "securityPolicyHasPrivateReportingChannel",
+				Outcome: finding.OutcomePositive,
+			}),
 			result: scut.TestReturn{
-				Score:        6,
-				NumberOfInfo: 2,
-				NumberOfWarn: 2,
+				Score:         5,
+				NumberOfInfo:  4,
+				NumberOfWarn:  6,
+				NumberOfDebug: 1,
 			},
 		},
 		{
 			name: "file found all positive",
-			findings: []finding.Finding{
-				{
-					Probe:   "securityPolicyContainsVulnerabilityDisclosure",
-					Outcome: finding.OutcomePositive,
-				},
-				{
-					Probe:   "securityPolicyContainsLinks",
-					Outcome: finding.OutcomePositive,
-				},
-				{
-					Probe:   "securityPolicyContainsText",
-					Outcome: finding.OutcomePositive,
-				},
-				{
-					Probe:   "securityPolicyPresent",
-					Outcome: finding.OutcomePositive,
-				},
-			},
+			findings: securityPolicyFindings(map[string]finding.Outcome{
+				"securityPolicyPresent":                    finding.OutcomePositive,
+				"securityPolicyHasPrivateReportingChannel": finding.OutcomePositive,
+				"securityPolicyDefinesSupportedVersions":   finding.OutcomePositive,
+				"securityPolicyDefinesResponseTime":        finding.OutcomePositive,
+				"securityPolicyDefinesEmbargo":             finding.OutcomePositive,
+				"securityPolicyProvidesEncryptionKey":      finding.OutcomePositive,
+				"securityTxtValid":                         finding.OutcomePositive,
+			}),
 			result: scut.TestReturn{
 				Score:        checker.MaxResultScore,
-				NumberOfInfo: 4,
+				NumberOfInfo: 7,
+				NumberOfWarn: 3,
+			},
+		},
+		{
+			name: "expired security.txt costs a point",
+			findings: securityPolicyFindings(map[string]finding.Outcome{
+				"securityPolicyPresent":                    finding.OutcomePositive,
+				"securityPolicyHasPrivateReportingChannel": finding.OutcomePositive,
+				"securityPolicyDefinesSupportedVersions":   finding.OutcomePositive,
+				"securityPolicyDefinesResponseTime":        finding.OutcomePositive,
+				"securityPolicyDefinesEmbargo":             finding.OutcomePositive,
+				"securityPolicyProvidesEncryptionKey":      finding.OutcomePositive,
+				"securityTxtValid":                         finding.OutcomeNegative,
+			}),
+			result: scut.TestReturn{
+				Score:        9,
+				NumberOfInfo: 6,
+				NumberOfWarn: 4,
 			},
 		},
 	}
@@ -197,3 +187,37 @@ func TestSecurityPolicy(t *testing.T) {
 		})
 	}
 }
+
+// securityPolicyFindings returns one finding per probe of the Security-Policy
+// check, with the given outcomes and a negative outcome otherwise. Without an
+// outcome, the security.txt probe finds no file.
+func securityPolicyFindings(outcomes map[string]finding.Outcome) []finding.Finding {
+	probes := []string{
+		"securityPolicyContainsVulnerabilityDisclosure",
+		"securityPolicyContainsLinks",
+		"securityPolicyContainsText",
+		"securityPolicyPresent",
+		"securityPolicyHasPrivateReportingChannel",
+		"securityPolicyDefinesSupportedVersions",
+		"securityPolicyDefinesResponseTime",
+		"securityPolicyDefinesEmbargo",
+		"securityPolicyProvidesEncryptionKey",
+		"securityTxtValid",
+	}
+	findings := make([]finding.Finding, 0, len(probes))
+	for _, probe := range probes {
+		outcome, ok := outcomes[probe]
+		switch {
+		case ok:
+		case probe == "securityTxtValid":
+			outcome = finding.OutcomeNotApplicable
+		default:
+			outcome = finding.OutcomeNegative
+		}
+		findings = append(findings, finding.Finding{
+			Probe:   probe,
+			Outcome: outcome,
+		})
+	}
+	return findings
+}
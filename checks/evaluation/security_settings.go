@@ -0,0 +1,80 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"fmt"
+
+	"github.com/ossf/scorecard/v4/checker"
+	sce "github.com/ossf/scorecard/v4/errors"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/dependabotSecurityUpdatesEnabled"
+	"github.com/ossf/scorecard/v4/probes/privateVulnerabilityReportingEnabled"
+	"github.com/ossf/scorecard/v4/probes/secretPushProtectionEnabled"
+	"github.com/ossf/scorecard/v4/probes/secretScanningEnabled"
+	"github.com/ossf/scorecard/v4/probes/workflowTokenReadOnlyByDefault"
+	"github.com/ossf/scorecard/v4/probes/workflowsCannotApprovePullRequests"
+)
+
+// SecuritySettings applies the score policy for the Security-Settings check.
+func SecuritySettings(name string,
+	findings []finding.Finding,
+	dl checker.DetailLogger,
+) checker.CheckResult {
+	expectedProbes := []string{
+		secretScanningEnabled.Probe,
+		secretPushProtectionEnabled.Probe,
+		dependabotSecurityUpdatesEnabled.Probe,
+		privateVulnerabilityReportingEnabled.Probe,
+		workflowTokenReadOnlyByDefault.Probe,
+		workflowsCannotApprovePullRequests.Probe,
+	}
+
+	if !finding.UniqueProbesEqual(findings, expectedProbes) {
+		e := sce.WithMessage(sce.ErrScorecardInternal, "invalid probe results")
+		return checker.CreateRuntimeErrorResult(name, e)
+	}
+
+	// Settings which could not be retrieved do not count towards the score.
+	enabled, available := 0, 0
+	for i := range findings {
+		f := &findings[i]
+		switch f.Outcome {
+		case finding.OutcomePositive:
+			enabled++
+			available++
+			dl.Info(&checker.LogMessage{
+				Text: f.Message,
+			})
+		case finding.OutcomeNegative:
+			available++
+			dl.Warn(&checker.LogMessage{
+				Text: f.Message,
+			})
+		default:
+			dl.Debug(&checker.LogMessage{
+				Text: f.Message,
+			})
+		}
+	}
+
+	if available == 0 {
+		return checker.CreateInconclusiveResult(name,
+			"security settings could not be retrieved: the token may require admin access to the repository")
+	}
+
+	return checker.CreateProportionalScoreResult(name,
+		fmt.Sprintf("%d out of %d security settings enabled", enabled, available), enabled, available)
+}
@@ -0,0 +1,121 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"testing"
+
+	"github.com/ossf/scorecard/v4/checker"
+	sce "github.com/ossf/scorecard/v4/errors"
+	"github.com/ossf/scorecard/v4/finding"
+	scut "github.com/ossf/scorecard/v4/utests"
+)
+
+func securitySettingsFindings(outcomes ...finding.Outcome) []finding.Finding {
+	probes := []string{
+		"secretScanningEnabled",
+		"secretPushProtectionEnabled",
+		"dependabotSecurityUpdatesEnabled",
+		"privateVulnerabilityReportingEnabled",
+		"workflowTokenReadOnlyByDefault",
+		"workflowsCannotApprovePullRequests",
+	}
+	findings := make([]finding.Finding, 0, len(probes))
+	for i, probe := range probes {
+		findings = append(findings, finding.Finding{
+			Probe:   probe,
+			Outcome: outcomes[i],
+		})
+	}
+	return findings
+}
+
+func TestSecuritySettings(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		findings []finding.Finding
+		result   scut.TestReturn
+	}{
+		{
+			name: "all settings enabled",
+			findings: securitySettingsFindings(
+				finding.OutcomePositive, finding.OutcomePositive, finding.OutcomePositive,
+				finding.OutcomePositive, finding.OutcomePositive, finding.OutcomePositive,
+			),
+			result: scut.TestReturn{
+				Score:        checker.MaxResultScore,
+				NumberOfInfo: 6,
+			},
+		},
+		{
+			name: "all settings disabled",
+			findings: securitySettingsFindings(
+				finding.OutcomeNegative, finding.OutcomeNegative, finding.OutcomeNegative,
+				finding.OutcomeNegative, finding.OutcomeNegative, finding.OutcomeNegative,
+			),
+			result: scut.TestReturn{
+				Score:        checker.MinResultScore,
+				NumberOfWarn: 6,
+			},
+		},
+		{
+			name: "unavailable settings are not scored",
+			findings: securitySettingsFindings(
+				finding.OutcomeNotAvailable, finding.OutcomePositive, finding.OutcomeNotAvailable,
+				finding.OutcomeNotAvailable, finding.OutcomeNegative, finding.OutcomePositive,
+			),
+			result: scut.TestReturn{
+				Score:         6,
+				NumberOfInfo:  2,
+				NumberOfWarn:  1,
+				NumberOfDebug: 3,
+			},
+		},
+		{
+			name: "token without admin access",
+			findings: securitySettingsFindings(
+				finding.OutcomeNotAvailable, finding.OutcomeNotAvailable, finding.OutcomeNotAvailable,
+				finding.OutcomeNotAvailable, finding.OutcomeNotAvailable, finding.OutcomeNotAvailable,
+			),
+			result: scut.TestReturn{
+				Score:         checker.InconclusiveResultScore,
+				NumberOfDebug: 6,
+			},
+		},
+		{
+			name: "missing probe",
+			findings: securitySettingsFindings(
+				finding.OutcomePositive, finding.OutcomePositive, finding.OutcomePositive,
+				finding.OutcomePositive, finding.OutcomePositive, finding.OutcomePositive,
+			)[1:],
+			result: scut.TestReturn{
+				Score: checker.InconclusiveResultScore,
+				Error: sce.ErrScorecardInternal,
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Parallel testing scoping hack.
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			dl := scut.TestDetailLogger{}
+			got := SecuritySettings(tt.name, tt.findings, &dl)
+			if !scut.ValidateTestReturn(t, tt.name, &tt.result, &got, &dl) {
+				t.Errorf("got %v, expected %v", got, tt.result)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"fmt"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/clients"
+	sce "github.com/ossf/scorecard/v4/errors"
+)
+
+// signatureCount counts the signed objects of a kind, e.g., commits.
+type signatureCount struct {
+	total, verified, signedByForge int
+}
+
+// score is the proportion of signed objects. The objects signed by the forge
+// on behalf of the user only count for half: the forge vouches for the
+// account which made the change, not for the key of the developer.
+func (c signatureCount) score() int {
+	developerSigned := c.verified - c.signedByForge
+	return checker.CreateProportionalScore(2*developerSigned+c.signedByForge, 2*c.total)
+}
+
+// SignedCommits applies the score policy for the Signed-Commits check.
+func SignedCommits(name string, dl checker.DetailLogger, r *checker.SignedCommitsData) checker.CheckResult {
+	if r == nil {
+		e := sce.WithMessage(sce.ErrScorecardInternal, "empty raw data")
+		return checker.CreateRuntimeErrorResult(name, e)
+	}
+
+	if len(r.Commits) == 0 && len(r.Tags) == 0 {
+		return checker.CreateInconclusiveResult(name, "no commits or release tags found")
+	}
+
+	var commits, tags signatureCount
+	for i := range r.Commits {
+		commit := &r.Commits[i]
+		logSignature(dl, &commits, fmt.Sprintf("commit %s", commit.SHA), commit.Signature)
+	}
+	for i := range r.Tags {
+		tag := &r.Tags[i]
+		logSignature(dl, &tags, fmt.Sprintf("tag %s", tag.Name), tag.Signature)
+	}
+
+	// Commits and tags contribute equally to the score, regardless of their number.
+	var scores []int
+	for _, count := range []signatureCount{commits, tags} {
+		if count.total > 0 {
+			scores = append(scores, count.score())
+		}
+	}
+	score := checker.AggregateScores(scores...)
+
+	reason := fmt.Sprintf("%d out of %d commits (%d signed by the forge) and %d out of %d release tags are signed",
+		commits.verified, commits.total, commits.signedByForge, tags.verified, tags.total)
+	return checker.CreateResultWithScore(name, reason, score)
+}
+
+func logSignature(dl checker.DetailLogger, count *signatureCount, object string, sig *clients.Signature) {
+	count.total++
+	switch {
+	case sig == nil:
+		dl.Warn(&checker.LogMessage{
+			Text: fmt.Sprintf("%s is not signed", object),
+		})
+	case !sig.Verified:
+		dl.Warn(&checker.LogMessage{
+			Text: fmt.Sprintf("%s has an unverified %s signature: %s", object, sig.Type, sig.Reason),
+		})
+	case sig.SignedByForge:
+		count.verified++
+		count.signedByForge++
+		dl.Info(&checker.LogMessage{
+			Text: fmt.Sprintf("%s is signed by the forge", object),
+		})
+	default:
+		count.verified++
+		dl.Info(&checker.LogMessage{
+			Text: fmt.Sprintf("%s has a verified %s signature", object, sig.Type),
+		})
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"testing"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/clients"
+	sce "github.com/ossf/scorecard/v4/errors"
+	scut "github.com/ossf/scorecard/v4/utests"
+)
+
+func TestSignedCommits(t *testing.T) {
+	t.Parallel()
+	verified := &clients.Signature{Type: clients.SignatureTypeGPG, Verified: true}
+	webFlow := &clients.Signature{Type: clients.SignatureTypeGPG, Verified: true, SignedByForge: true}
+	unverified := &clients.Signature{Type: clients.SignatureTypeSSH, Reason: "unknown_key"}
+	tests := []struct {
+		name string
+		raw  *checker.SignedCommitsData
+		want scut.TestReturn
+	}{
+		{
+			name: "nil raw data",
+			want: scut.TestReturn{
+				Score: checker.InconclusiveResultScore,
+				Error: sce.ErrScorecardInternal,
+			},
+		},
+		{
+			name: "no commits nor tags",
+			raw:  &checker.SignedCommitsData{},
+			want: scut.TestReturn{
+				Score: checker.InconclusiveResultScore,
+			},
+		},
+		{
+			name: "all commits signed by developers",
+			raw: &checker.SignedCommitsData{
+				Commits: []clients.Commit{
+					{SHA: "a", Signature: verified},
+					{SHA: "b", Signature: verified},
+				},
+			},
+			want: scut.TestReturn{
+				Score:        checker.MaxResultScore,
+				NumberOfInfo: 2,
+			},
+		},
+		{
+			name: "commits signed by the forge count for half",
+			raw: &checker.SignedCommitsData{
+				Commits: []clients.Commit{
+					{SHA: "a", Signature: verified},
+					{SHA: "b", Signature: webFlow},
+				},
+			},
+			want: scut.TestReturn{
+				Score:        7,
+				NumberOfInfo: 2,
+			},
+		},
+		{
+			name: "unsigned and unverified commits",
+			raw: &checker.SignedCommitsData{
+				Commits: []clients.Commit{
+					{SHA: "a", Signature: verified},
+					{SHA: "b"},
+					{SHA: "c", Signature: unverified},
+					{SHA: "d", Signature: webFlow},
+				},
+			},
+			want: scut.TestReturn{
+				Score:        3,
+				NumberOfInfo: 2,
+				NumberOfWarn: 2,
+			},
+		},
+		{
+			name: "commits and tags weigh equally",
+			raw: &checker.SignedCommitsData{
+				Commits: []clients.Commit{
+					{SHA: "a", Signature: verified},
+					{SHA: "b", Signature: verified},
+					{SHA: "c", Signature: verified},
+					{SHA: "d", Signature: verified},
+				},
+				Tags: []clients.Tag{
+					{Name: "v1.0.0"},
+					{Name: "v1.1.0", Signature: verified},
+				},
+			},
+			want: scut.TestReturn{
+				Score:        7,
+				NumberOfInfo: 5,
+				NumberOfWarn: 1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			dl := scut.TestDetailLogger{}
+			got := SignedCommits(tt.name, &dl, tt.raw)
+			if !scut.ValidateTestReturn(t, tt.name, &tt.want, &got, &dl) {
+				t.Errorf("got %v, expected %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -16,12 +16,34 @@ package evaluation
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/clients"
 	sce "github.com/ossf/scorecard/v4/errors"
 	"github.com/ossf/scorecard/v4/finding"
 )
 
+// sensitiveWebhookEvents are the events that disclose vulnerabilities,
+// leaked secrets, confidential discussions or access changes that are
+// not public yet.
+var sensitiveWebhookEvents = map[string]bool{
+	clients.WebhookEventAll: true,
+	// GitHub.
+	"code_scanning_alert":            true,
+	"dependabot_alert":               true,
+	"deploy_key":                     true,
+	"member":                         true,
+	"repository_advisory":            true,
+	"repository_vulnerability_alert": true,
+	"secret_scanning_alert":          true,
+	"secret_scanning_alert_location": true,
+	"security_advisory":              true,
+	// GitLab.
+	"confidential_issues": true,
+	"confidential_note":   true,
+}
+
 // Webhooks applies the score policy for the Webhooks check.
 func Webhooks(name string, dl checker.DetailLogger,
 	r *checker.WebhooksData,
@@ -35,27 +57,73 @@ func Webhooks(name string, dl checker.DetailLogger,
 		return checker.CreateMaxScoreResult(name, "no webhooks defined")
 	}
 
-	hasNoSecretCount := 0
-	for _, hook := range r.Webhooks {
-		if !hook.UsesAuthSecret {
-			dl.Warn(&checker.LogMessage{
+	// Each active hook is scored on HTTPS, SSL verification, secret
+	// and least-privilege event subscriptions.
+	met, total, active := 0, 0, 0
+	for i := range r.Webhooks {
+		hook := &r.Webhooks[i]
+		if !hook.Active {
+			dl.Info(&checker.LogMessage{
 				Path: hook.Path,
 				Type: finding.FileTypeURL,
-				Text: "Webhook with no secret configured",
+				Text: "inactive webhook ignored",
 			})
-			hasNoSecretCount++
+			continue
 		}
+		active++
+		m, t := evaluateWebhook(dl, hook)
+		met += m
+		total += t
 	}
 
-	if hasNoSecretCount == 0 {
-		return checker.CreateMaxScoreResult(name, fmt.Sprintf("all %d hook(s) have a secret configured", len(r.Webhooks)))
+	if active == 0 {
+		return checker.CreateMaxScoreResult(name, "no active webhooks defined")
 	}
 
-	if len(r.Webhooks) == hasNoSecretCount {
-		return checker.CreateMinScoreResult(name, fmt.Sprintf("%d hook(s) do not have a secret configured", len(r.Webhooks)))
+	if met == total {
+		return checker.CreateMaxScoreResult(name,
+			fmt.Sprintf("all %d active hook(s) deliver events securely", active))
 	}
 
 	return checker.CreateProportionalScoreResult(name,
-		fmt.Sprintf("%d/%d hook(s) with no secrets configured detected",
-			hasNoSecretCount, len(r.Webhooks)), hasNoSecretCount, len(r.Webhooks))
+		fmt.Sprintf("%d/%d delivery security settings met by %d active hook(s)", met, total, active),
+		met, total)
+}
+
+// evaluateWebhook logs the delivery security settings of an active hook,
+// and returns the number of settings met and the number of settings evaluated.
+func evaluateWebhook(dl checker.DetailLogger, hook *clients.Webhook) (met, total int) {
+	check := func(ok bool, text string) {
+		total++
+		if ok {
+			met++
+			return
+		}
+		dl.Warn(&checker.LogMessage{
+			Path: hook.Path,
+			Type: finding.FileTypeURL,
+			Text: text,
+		})
+	}
+
+	check(hook.Scheme == "https", "Webhook delivers events without HTTPS")
+	check(hook.SSLVerification, "Webhook with SSL verification disabled")
+	if hook.AuthSecretUnknown {
+		dl.Info(&checker.LogMessage{
+			Path: hook.Path,
+			Type: finding.FileTypeURL,
+			Text: "forge does not report whether the webhook has a secret configured",
+		})
+	} else {
+		check(hook.UsesAuthSecret, "Webhook with no secret configured")
+	}
+	var sensitive []string
+	for _, event := range hook.Events {
+		if sensitiveWebhookEvents[event] {
+			sensitive = append(sensitive, event)
+		}
+	}
+	check(len(sensitive) == 0,
+		fmt.Sprintf("Webhook subscribed to sensitive events: %s", strings.Join(sensitive, ", ")))
+	return met, total
 }
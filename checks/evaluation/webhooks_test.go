@@ -64,9 +64,12 @@ func TestWebhooks(t *testing.T) {
 				r: &checker.WebhooksData{
 					Webhooks: []clients.Webhook{
 						{
-							Path:           "https://github.com/owner/repo/settings/hooks/1234",
-							ID:             1234,
-							UsesAuthSecret: true,
+							Path:            "https://github.com/owner/repo/settings/hooks/1234",
+							ID:              1234,
+							Scheme:          "https",
+							SSLVerification: true,
+							Active:          true,
+							UsesAuthSecret:  true,
 						},
 					},
 				},
@@ -83,15 +86,104 @@ func TestWebhooks(t *testing.T) {
 				r: &checker.WebhooksData{
 					Webhooks: []clients.Webhook{
 						{
-							Path:           "https://github.com/owner/repo/settings/hooks/1234",
-							ID:             1234,
-							UsesAuthSecret: false,
+							Path:            "https://github.com/owner/repo/settings/hooks/1234",
+							ID:              1234,
+							Scheme:          "https",
+							SSLVerification: true,
+							Active:          true,
+							UsesAuthSecret:  false,
 						},
 					},
 				},
 			},
 			want: checker.CheckResult{
-				Score: 0,
+				Score: 7,
+			},
+		},
+		{
+			name: "1 webhook with no HTTPS, SSL verification or secret",
+			args: args{
+				name: "1 webhook with no HTTPS, SSL verification or secret",
+				dl:   &scut.TestDetailLogger{},
+				r: &checker.WebhooksData{
+					Webhooks: []clients.Webhook{
+						{
+							Path:   "example.com",
+							ID:     1234,
+							Scheme: "http",
+							Events: []string{"push"},
+							Active: true,
+						},
+					},
+				},
+			},
+			want: checker.CheckResult{
+				Score: 2,
+			},
+		},
+		{
+			name: "1 webhook subscribed to all events",
+			args: args{
+				name: "1 webhook subscribed to all events",
+				dl:   &scut.TestDetailLogger{},
+				r: &checker.WebhooksData{
+					Webhooks: []clients.Webhook{
+						{
+							Path:            "example.com",
+							ID:              1234,
+							Scheme:          "https",
+							Events:          []string{"*"},
+							SSLVerification: true,
+							Active:          true,
+							UsesAuthSecret:  true,
+						},
+					},
+				},
+			},
+			want: checker.CheckResult{
+				Score: 7,
+			},
+		},
+		{
+			name: "inactive webhooks are ignored",
+			args: args{
+				name: "inactive webhooks are ignored",
+				dl:   &scut.TestDetailLogger{},
+				r: &checker.WebhooksData{
+					Webhooks: []clients.Webhook{
+						{
+							Path:   "example.com",
+							ID:     1234,
+							Scheme: "http",
+						},
+					},
+				},
+			},
+			want: checker.CheckResult{
+				Score: checker.MaxResultScore,
+			},
+		},
+		{
+			name: "webhook with an unknown secret",
+			args: args{
+				name: "webhook with an unknown secret",
+				dl:   &scut.TestDetailLogger{},
+				r: &checker.WebhooksData{
+					Webhooks: []clients.Webhook{
+						{
+							Path:              "example.com",
+							ID:                1,
+							Scheme:            "https",
+							Events:            []string{"push", "confidential_issues"},
+							AuthSecretUnknown: true,
+							SSLVerification:   true,
+							Active:            true,
+						},
+					},
+				},
+			},
+			want: checker.CheckResult{
+				Score: 6,
 			},
 		},
 		{
@@ -102,35 +194,50 @@ func TestWebhooks(t *testing.T) {
 				r: &checker.WebhooksData{
 					Webhooks: []clients.Webhook{
 						{
-							Path:           "https://github.com/owner/repo/settings/hooks/1234",
-							ID:             1234,
-							UsesAuthSecret: false,
+							Path:            "https://github.com/owner/repo/settings/hooks/1234",
+							ID:              1234,
+							Scheme:          "https",
+							SSLVerification: true,
+							Active:          true,
+							UsesAuthSecret:  false,
 						},
 						{
-							Path:           "https://github.com/owner/repo/settings/hooks/1111",
-							ID:             1111,
-							UsesAuthSecret: true,
+							Path:            "https://github.com/owner/repo/settings/hooks/1111",
+							ID:              1111,
+							Scheme:          "https",
+							SSLVerification: true,
+							Active:          true,
+							UsesAuthSecret:  true,
 						},
 						{
-							Path:           "https://github.com/owner/repo/settings/hooks/4444",
-							ID:             4444,
-							UsesAuthSecret: true,
+							Path:            "https://github.com/owner/repo/settings/hooks/4444",
+							ID:              4444,
+							Scheme:          "https",
+							SSLVerification: true,
+							Active:          true,
+							UsesAuthSecret:  true,
 						},
 						{
-							Path:           "https://github.com/owner/repo/settings/hooks/3333",
-							ID:             3333,
-							UsesAuthSecret: false,
+							Path:            "https://github.com/owner/repo/settings/hooks/3333",
+							ID:              3333,
+							Scheme:          "https",
+							SSLVerification: true,
+							Active:          true,
+							UsesAuthSecret:  false,
 						},
 						{
-							Path:           "https://github.com/owner/repo/settings/hooks/2222",
-							ID:             2222,
-							UsesAuthSecret: false,
+							Path:            "https://github.com/owner/repo/settings/hooks/2222",
+							ID:              2222,
+							Scheme:          "https",
+							SSLVerification: true,
+							Active:          true,
+							UsesAuthSecret:  false,
 						},
 					},
 				},
 			},
 			want: checker.CheckResult{
-				Score: 6,
+				Score: 8,
 			},
 		},
 	}
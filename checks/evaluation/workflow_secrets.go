@@ -0,0 +1,91 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"fmt"
+
+	"github.com/ossf/scorecard/v4/checker"
+	sce "github.com/ossf/scorecard/v4/errors"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/workflowSecretsNotExposedToForks"
+	"github.com/ossf/scorecard/v4/probes/workflowSecretsNotInheritedByThirdParty"
+	"github.com/ossf/scorecard/v4/probes/workflowSecretsNotLogged"
+	"github.com/ossf/scorecard/v4/probes/workflowSecretsNotPassedToUnpinnedThirdParty"
+)
+
+const (
+	// exposedSecretPenalty is the penalty for the secrets readable by third parties:
+	// inherited by third-party workflows, printed to the logs, or used by jobs triggered by forks.
+	exposedSecretPenalty = 4
+	// unpinnedSecretReceiverPenalty is the penalty for the secrets passed to third-party
+	// actions or workflows which could be replaced without the project noticing.
+	unpinnedSecretReceiverPenalty = 2
+)
+
+// WorkflowSecrets applies the score policy for the Workflow-Secrets check.
+func WorkflowSecrets(name string,
+	findings []finding.Finding,
+	dl checker.DetailLogger,
+) checker.CheckResult {
+	expectedProbes := []string{
+		workflowSecretsNotInheritedByThirdParty.Probe,
+		workflowSecretsNotPassedToUnpinnedThirdParty.Probe,
+		workflowSecretsNotLogged.Probe,
+		workflowSecretsNotExposedToForks.Probe,
+	}
+
+	if !finding.UniqueProbesEqual(findings, expectedProbes) {
+		e := sce.WithMessage(sce.ErrScorecardInternal, "invalid probe results")
+		return checker.CreateRuntimeErrorResult(name, e)
+	}
+
+	if findings[0].Outcome == finding.OutcomeNotApplicable {
+		return checker.CreateInconclusiveResult(name, "no workflows found")
+	}
+
+	var exposed, unpinned int
+	var logged []finding.Finding
+	for i := range findings {
+		f := &findings[i]
+		switch f.Outcome {
+		case finding.OutcomeNegative:
+			if f.Probe == workflowSecretsNotPassedToUnpinnedThirdParty.Probe {
+				unpinned++
+			} else {
+				exposed++
+			}
+		case finding.OutcomePositive:
+			// Only log the secrets received by first-party actions and workflows.
+			if f.Location == nil {
+				continue
+			}
+		default:
+			continue
+		}
+		logged = append(logged, *f)
+	}
+	checker.LogFindings(logged, dl)
+
+	if exposed+unpinned == 0 {
+		return checker.CreateMaxScoreResult(name, "no risky use of workflow secrets detected")
+	}
+	score := checker.MaxResultScore - exposed*exposedSecretPenalty - unpinned*unpinnedSecretReceiverPenalty
+	if score < checker.MinResultScore {
+		score = checker.MinResultScore
+	}
+	return checker.CreateResultWithScore(name,
+		fmt.Sprintf("%d risky uses of workflow secrets detected", exposed+unpinned), score)
+}
@@ -0,0 +1,127 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evaluation
+
+import (
+	"testing"
+
+	"github.com/ossf/scorecard/v4/checker"
+	sce "github.com/ossf/scorecard/v4/errors"
+	"github.com/ossf/scorecard/v4/finding"
+	scut "github.com/ossf/scorecard/v4/utests"
+)
+
+func TestWorkflowSecrets(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		findings []finding.Finding
+		result   scut.TestReturn
+	}{
+		{
+			name: "no workflows",
+			findings: []finding.Finding{
+				{Probe: "workflowSecretsNotInheritedByThirdParty", Outcome: finding.OutcomeNotApplicable},
+				{Probe: "workflowSecretsNotPassedToUnpinnedThirdParty", Outcome: finding.OutcomeNotApplicable},
+				{Probe: "workflowSecretsNotLogged", Outcome: finding.OutcomeNotApplicable},
+				{Probe: "workflowSecretsNotExposedToForks", Outcome: finding.OutcomeNotApplicable},
+			},
+			result: scut.TestReturn{
+				Score: checker.InconclusiveResultScore,
+			},
+		},
+		{
+			name: "no exposures",
+			findings: []finding.Finding{
+				{Probe: "workflowSecretsNotInheritedByThirdParty", Outcome: finding.OutcomePositive},
+				{Probe: "workflowSecretsNotPassedToUnpinnedThirdParty", Outcome: finding.OutcomePositive},
+				{Probe: "workflowSecretsNotLogged", Outcome: finding.OutcomePositive},
+				{Probe: "workflowSecretsNotExposedToForks", Outcome: finding.OutcomePositive},
+			},
+			result: scut.TestReturn{
+				Score: checker.MaxResultScore,
+			},
+		},
+		{
+			name: "first-party receivers only",
+			findings: []finding.Finding{
+				{
+					Probe:    "workflowSecretsNotInheritedByThirdParty",
+					Outcome:  finding.OutcomePositive,
+					Location: &finding.Location{Path: ".github/workflows/release.yml"},
+				},
+				{
+					Probe:    "workflowSecretsNotPassedToUnpinnedThirdParty",
+					Outcome:  finding.OutcomePositive,
+					Location: &finding.Location{Path: ".github/workflows/release.yml"},
+				},
+				{Probe: "workflowSecretsNotLogged", Outcome: finding.OutcomePositive},
+				{Probe: "workflowSecretsNotExposedToForks", Outcome: finding.OutcomePositive},
+			},
+			result: scut.TestReturn{
+				Score:        checker.MaxResultScore,
+				NumberOfInfo: 2,
+			},
+		},
+		{
+			name: "unpinned third-party receivers",
+			findings: []finding.Finding{
+				{Probe: "workflowSecretsNotInheritedByThirdParty", Outcome: finding.OutcomePositive},
+				{Probe: "workflowSecretsNotPassedToUnpinnedThirdParty", Outcome: finding.OutcomeNegative},
+				{Probe: "workflowSecretsNotPassedToUnpinnedThirdParty", Outcome: finding.OutcomeNegative},
+				{Probe: "workflowSecretsNotLogged", Outcome: finding.OutcomePositive},
+				{Probe: "workflowSecretsNotExposedToForks", Outcome: finding.OutcomePositive},
+			},
+			result: scut.TestReturn{
+				Score:        6,
+				NumberOfWarn: 2,
+			},
+		},
+		{
+			name: "exposed secrets",
+			findings: []finding.Finding{
+				{Probe: "workflowSecretsNotInheritedByThirdParty", Outcome: finding.OutcomeNegative},
+				{Probe: "workflowSecretsNotPassedToUnpinnedThirdParty", Outcome: finding.OutcomeNegative},
+				{Probe: "workflowSecretsNotLogged", Outcome: finding.OutcomeNegative},
+				{Probe: "workflowSecretsNotExposedToForks", Outcome: finding.OutcomePositive},
+			},
+			result: scut.TestReturn{
+				Score:        checker.MinResultScore,
+				NumberOfWarn: 3,
+			},
+		},
+		{
+			name: "missing probe",
+			findings: []finding.Finding{
+				{Probe: "workflowSecretsNotLogged", Outcome: finding.OutcomePositive},
+			},
+			result: scut.TestReturn{
+				Score: checker.InconclusiveResultScore,
+				Error: sce.ErrScorecardInternal,
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Parallel testing scoping hack.
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			dl := scut.TestDetailLogger{}
+			got := WorkflowSecrets(tt.name, tt.findings, &dl)
+			if !scut.ValidateTestReturn(t, tt.name, &tt.result, &got, &dl) {
+				t.Errorf("got %v, expected %v", got, tt.result)
+			}
+		})
+	}
+}
@@ -0,0 +1,213 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"bufio"
+	"bytes"
+	"regexp"
+	"strings"
+)
+
+// codeownersSectionRegex matches the GitLab section headers, e.g. `[Docs]`,
+// `^[Optional docs]` or `[Docs][2] @docs-team`.
+var codeownersSectionRegex = regexp.MustCompile(`^\^?\[([^\]]+)\](?:\[\d+\])?(.*)$`)
+
+// CodeownersRule is a rule of a CODEOWNERS file.
+type CodeownersRule struct {
+	regex *regexp.Regexp
+	// Pattern is the path pattern of the rule.
+	Pattern string
+	// Section is the name of the GitLab section of the rule, empty outside of sections.
+	Section string
+	// Owners are the owners of the files matching the pattern. A rule without owners
+	// removes the owners of the files matching it.
+	Owners []string
+	// Line is the line number of the rule.
+	Line uint
+	// DefaultOwners is true if the owners are the default owners of the section.
+	DefaultOwners bool
+}
+
+// Codeowners is a parsed CODEOWNERS file.
+type Codeowners struct {
+	Rules []CodeownersRule
+	// SectionLines maps the names of the sections to the line number of their header.
+	SectionLines map[string]uint
+}
+
+// ParseCodeowners parses a CODEOWNERS file, in either the GitHub syntax,
+// https://docs.github.com/en/repositories/managing-your-repositorys-settings-and-features/customizing-your-repository/about-code-owners#codeowners-syntax,
+// or the GitLab syntax, which adds sections with default owners,
+// https://docs.gitlab.com/ee/user/project/codeowners/reference.html.
+// Lines which cannot be parsed are ignored.
+func ParseCodeowners(content []byte) *Codeowners {
+	co := &Codeowners{SectionLines: map[string]uint{}}
+	section := ""
+	var defaultOwners []string
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	var line uint
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		if m := codeownersSectionRegex.FindStringSubmatch(text); m != nil {
+			// Section names are case-insensitive, and sections with the same name are merged.
+			section = strings.ToLower(strings.TrimSpace(m[1]))
+			if _, ok := co.SectionLines[section]; !ok {
+				co.SectionLines[section] = line
+			}
+			defaultOwners = splitCodeownersLine(m[2])
+			continue
+		}
+
+		tokens := splitCodeownersLine(text)
+		if len(tokens) == 0 {
+			continue
+		}
+		regex, err := codeownersPatternRegex(tokens[0])
+		if err != nil {
+			continue
+		}
+		rule := CodeownersRule{
+			regex:   regex,
+			Pattern: tokens[0],
+			Section: section,
+			Owners:  tokens[1:],
+			Line:    line,
+		}
+		if len(rule.Owners) == 0 && section != "" {
+			rule.Owners = defaultOwners
+			rule.DefaultOwners = true
+		}
+		co.Rules = append(co.Rules, rule)
+	}
+	return co
+}
+
+// splitCodeownersLine splits a line on whitespace, honoring backslash escapes,
+// and drops the trailing comment.
+func splitCodeownersLine(line string) []string {
+	var tokens []string
+	var current strings.Builder
+	inToken, escaped := false, false
+	for _, r := range line {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			escaped = true
+			inToken = true
+		case r == ' ' || r == '\t':
+			if inToken {
+				tokens = append(tokens, current.String())
+				current.Reset()
+				inToken = false
+			}
+		case r == '#' && !inToken:
+			return tokens
+		default:
+			current.WriteRune(r)
+			inToken = true
+		}
+	}
+	if inToken {
+		tokens = append(tokens, current.String())
+	}
+	return tokens
+}
+
+// codeownersPatternRegex converts a CODEOWNERS pattern, which follows the gitignore
+// rules, to a regular expression matching the paths of the files it covers.
+func codeownersPatternRegex(pattern string) (*regexp.Regexp, error) {
+	dirOnly := strings.HasSuffix(pattern, "/")
+	p := strings.TrimSuffix(pattern, "/")
+	// Patterns with a slash, other than a trailing one, are relative to the root.
+	anchored := strings.Contains(p, "/")
+	p = strings.TrimPrefix(p, "/")
+
+	var sb strings.Builder
+	if anchored {
+		sb.WriteString("^")
+	} else {
+		sb.WriteString("^(?:.*/)?")
+	}
+	for i := 0; i < len(p); i++ {
+		switch {
+		case strings.HasPrefix(p[i:], "**/"):
+			sb.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(p[i:], "**"):
+			sb.WriteString(".*")
+			i++
+		case p[i] == '*':
+			sb.WriteString("[^/]*")
+		case p[i] == '?':
+			sb.WriteString("[^/]")
+		default:
+			sb.WriteString(regexp.QuoteMeta(p[i : i+1]))
+		}
+	}
+	// A pattern matching a directory covers all the files below it, except
+	// for `dir/*`, which only covers the files directly in the directory.
+	switch {
+	case dirOnly:
+		sb.WriteString("/.*$")
+	case strings.HasSuffix(p, "/*"):
+		sb.WriteString("$")
+	default:
+		sb.WriteString("(?:/.*)?$")
+	}
+	//nolint:wrapcheck
+	return regexp.Compile(sb.String())
+}
+
+// Matches returns true if the rule covers the file at pathfn.
+func (r *CodeownersRule) Matches(pathfn string) bool {
+	return r.regex != nil && r.regex.MatchString(pathfn)
+}
+
+// Owners returns the owners of the file at pathfn. In each section, the last rule
+// matching the file applies, and the owners of all the sections are combined.
+func (co *Codeowners) Owners(pathfn string) []string {
+	last := map[string]*CodeownersRule{}
+	var sections []string
+	for i := range co.Rules {
+		r := &co.Rules[i]
+		if !r.Matches(pathfn) {
+			continue
+		}
+		if _, ok := last[r.Section]; !ok {
+			sections = append(sections, r.Section)
+		}
+		last[r.Section] = r
+	}
+
+	var owners []string
+	seen := map[string]bool{}
+	for _, s := range sections {
+		for _, o := range last[s].Owners {
+			if k := strings.ToLower(o); !seen[k] {
+				seen[k] = true
+				owners = append(owners, o)
+			}
+		}
+	}
+	return owners
+}
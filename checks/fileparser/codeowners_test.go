@@ -0,0 +1,75 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCodeownersOwners(t *testing.T) {
+	t.Parallel()
+
+	content := `# Default owners.
+*       @ossf/maintainers
+
+/docs/*           @docs-team # only the files directly in docs
+*.go              @go-team
+/build/           @build-team
+**/testdata/**    @test-team
+/My\ File.txt     @someone
+/vendor/
+
+[Release][2] @release-team
+.goreleaser.yml
+/CHANGELOG.md @changelog dev@example.com
+
+^[Optional Security]
+/SECURITY.md @security-team
+`
+	co := ParseCodeowners([]byte(content))
+
+	tests := []struct {
+		path string
+		want []string
+	}{
+		{path: "README.md", want: []string{"@ossf/maintainers"}},
+		{path: "docs/index.md", want: []string{"@docs-team"}},
+		{path: "docs/api/index.md", want: []string{"@ossf/maintainers"}},
+		{path: "pkg/scorecard.go", want: []string{"@go-team"}},
+		{path: "build/Dockerfile", want: []string{"@build-team"}},
+		{path: "build/scripts/release.sh", want: []string{"@build-team"}},
+		{path: "pkg/testdata/file.go", want: []string{"@test-team"}},
+		{path: "My File.txt", want: []string{"@someone"}},
+		{path: "vendor/lib/lib.go", want: nil},
+		{path: ".goreleaser.yml", want: []string{"@ossf/maintainers", "@release-team"}},
+		{path: "CHANGELOG.md", want: []string{"@ossf/maintainers", "@changelog", "dev@example.com"}},
+		{path: "SECURITY.md", want: []string{"@ossf/maintainers", "@security-team"}},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.path, func(t *testing.T) {
+			t.Parallel()
+			if diff := cmp.Diff(tt.want, co.Owners(tt.path)); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	if diff := cmp.Diff(map[string]uint{"release": 11, "optional security": 15}, co.SectionLines); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -579,7 +579,229 @@ func IsPackagingWorkflow(workflow *actionlint.Workflow, fp string) (JobMatchResu
 			},
 			LogText: "candidate publishing workflow using semantic-release",
 		},
+		{
+			Steps: []*JobMatcherStep{
+				{
+					Uses: "cycjimmy/semantic-release-action",
+				},
+			},
+			LogText: "candidate publishing workflow using semantic-release",
+		},
+		{
+			// Release PRs and releases, commonly followed by the publication of the packages.
+			Steps: []*JobMatcherStep{
+				{
+					Uses: "googleapis/release-please-action",
+				},
+			},
+			LogText: "candidate publishing workflow using release-please",
+		},
+		{
+			Steps: []*JobMatcherStep{
+				{
+					Uses: "google-github-actions/release-please-action",
+				},
+			},
+			LogText: "candidate publishing workflow using release-please",
+		},
+		{
+			// Ruby packages with the RubyGems action.
+			Steps: []*JobMatcherStep{
+				{
+					Uses: "rubygems/release-gem",
+				},
+			},
+			LogText: "candidate ruby publishing workflow using release-gem",
+		},
+		{
+			// Elixir and Erlang packages. https://hex.pm/docs/publish
+			Steps: []*JobMatcherStep{
+				{
+					Run: `(mix\s+hex\.publish|rebar3\s+hex\s+publish)`,
+				},
+			},
+			LogText: "candidate elixir publishing workflow using hex",
+		},
+		{
+			// PHP packages. Packagist is notified of new releases through its API.
+			Steps: []*JobMatcherStep{
+				{
+					Run: `packagist\.org/api/update-package`,
+				},
+			},
+			LogText: "candidate php publishing workflow using packagist",
+		},
+		{
+			// Helm charts.
+			Steps: []*JobMatcherStep{
+				{
+					Run: `helm.*push`,
+				},
+			},
+			LogText: "candidate helm chart publishing workflow",
+		},
+		{
+			Steps: []*JobMatcherStep{
+				{
+					Uses: "helm/chart-releaser-action",
+				},
+			},
+			LogText: "candidate helm chart publishing workflow using chart-releaser",
+		},
+		{
+			// OCI artifacts.
+			Steps: []*JobMatcherStep{
+				{
+					Run: `(oras|crane)\s+push`,
+				},
+			},
+			LogText: "candidate oci publishing workflow",
+		},
 	}
 
 	return AnyJobsMatch(workflow, jobMatchers, fp, "not a publishing workflow")
 }
+
+var (
+	secretReferenceRegex = regexp.MustCompile(`\$\{\{\s*secrets\.([A-Za-z0-9_\-]+)\s*\}\}`)
+	// registryCredentialRegex matches the names of secrets, inputs and environment
+	// variables which commonly hold the credentials of a package registry.
+	registryCredentialRegex = regexp.MustCompile(
+		`(?i)npm|node_auth|pypi|twine|cargo|crates|rubygems|gem_host|nuget|maven|ossrh|sonatype|gradle|docker|registry|publish`)
+	npmPublishRegex = regexp.MustCompile(`npm.*publish`)
+)
+
+// GetPublishingAuth returns the authentication used by a packaging workflow
+// to publish its packages, and whether the workflow generates provenance for them.
+func GetPublishingAuth(workflow *actionlint.Workflow) (checker.PublishingAuth, bool) {
+	idToken := hasIDTokenWritePermission(workflow.Permissions)
+	longLived := envHasRegistryCredential(workflow.Env)
+	var trustedPublisher, provenance bool
+	for _, job := range workflow.Jobs {
+		if job == nil {
+			continue
+		}
+		idToken = idToken || hasIDTokenWritePermission(job.Permissions)
+		longLived = longLived || envHasRegistryCredential(job.Env)
+
+		// Reusable workflows.
+		if job.WorkflowCall != nil {
+			if uses := job.WorkflowCall.Uses; uses != nil &&
+				strings.HasPrefix(uses.Value, "slsa-framework/slsa-github-generator/") {
+				provenance = true
+			}
+			for _, s := range job.WorkflowCall.Secrets {
+				if s != nil && s.Name != nil && s.Value != nil &&
+					isRegistryCredential(s.Name.Value, s.Value.Value) {
+					longLived = true
+				}
+			}
+		}
+
+		for _, step := range job.Steps {
+			if step == nil {
+				continue
+			}
+			longLived = longLived || envHasRegistryCredential(step.Env)
+			if step.Env != nil {
+				if v, ok := step.Env.Vars["npm_config_provenance"]; ok && v.Value != nil &&
+					strings.EqualFold(v.Value.Value, "true") {
+					provenance = true
+				}
+			}
+
+			if run := getRun(step); run != nil {
+				if isRegistryCredential("", run.Value) {
+					longLived = true
+				}
+				if npmPublishRegex.MatchString(run.Value) {
+					// npm supports trusted publishing since version 11.5.1.
+					trustedPublisher = true
+					if strings.Contains(run.Value, "--provenance") {
+						provenance = true
+					}
+				}
+				continue
+			}
+
+			uses := GetUses(step)
+			if uses == nil {
+				continue
+			}
+			with := getWith(step)
+			for _, input := range with {
+				if input != nil && input.Name != nil && input.Value != nil &&
+					isRegistryCredential(input.Name.Value, input.Value.Value) {
+					longLived = true
+				}
+			}
+			switch {
+			case strings.HasPrefix(uses.Value, "pypa/gh-action-pypi-publish@"):
+				if password, ok := with["password"]; ok && password != nil {
+					longLived = true
+					break
+				}
+				trustedPublisher = true
+				// Attestations are generated by default when using trusted publishing.
+				if a, ok := with["attestations"]; !ok || a == nil || a.Value == nil || a.Value.Value != "false" {
+					provenance = true
+				}
+			case strings.HasPrefix(uses.Value, "rust-lang/crates-io-auth-action@"):
+				trustedPublisher = true
+			case strings.HasPrefix(uses.Value, "actions/attest-build-provenance@"),
+				strings.HasPrefix(uses.Value, "slsa-framework/slsa-github-generator/"):
+				provenance = true
+			}
+		}
+	}
+
+	switch {
+	case longLived:
+		return checker.PublishingAuthToken, provenance
+	case idToken && trustedPublisher:
+		return checker.PublishingAuthTrusted, provenance
+	default:
+		return checker.PublishingAuthUnknown, provenance
+	}
+}
+
+// hasIDTokenWritePermission returns true if the permissions allow
+// the workflow to request an OIDC token.
+func hasIDTokenWritePermission(perms *actionlint.Permissions) bool {
+	if perms == nil {
+		return false
+	}
+	if perms.All != nil {
+		return perms.All.Value == "write-all"
+	}
+	scope, ok := perms.Scopes["id-token"]
+	return ok && scope != nil && scope.Value != nil && scope.Value.Value == "write"
+}
+
+func envHasRegistryCredential(env *actionlint.Env) bool {
+	if env == nil {
+		return false
+	}
+	for _, v := range env.Vars {
+		if v != nil && v.Name != nil && v.Value != nil &&
+			isRegistryCredential(v.Name.Value, v.Value.Value) {
+			return true
+		}
+	}
+	return false
+}
+
+// isRegistryCredential returns true if the value references a secret of the
+// repository, and the name of the secret or of its key is that of a registry credential.
+func isRegistryCredential(key, value string) bool {
+	for _, m := range secretReferenceRegex.FindAllStringSubmatch(value, -1) {
+		if strings.EqualFold(m[1], "GITHUB_TOKEN") {
+			continue
+		}
+		if registryCredentialRegex.MatchString(m[1]) ||
+			(key != "" && registryCredentialRegex.MatchString(key)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,420 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/rhysd/actionlint"
+	"gopkg.in/yaml.v3"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/clients"
+	"github.com/ossf/scorecard/v4/finding"
+)
+
+// GitHub limits the nesting of reusable workflows to 4 levels, and
+// composite actions to 10 levels.
+const maxLocalCalleeDepth = 10
+
+var inputsExpressionRegex = regexp.MustCompile(`\$\{\{\s*inputs\.([\w-]+)\s*\}\}`)
+
+// LocalCallee is a composite action or a reusable workflow of the repository,
+// called by one of its workflows.
+type LocalCallee struct {
+	// Workflow is the callee in the context of its caller: it is triggered by
+	// the events of the calling workflow, and the references to the inputs passed by
+	// the caller are replaced by their values.
+	// A composite action is represented by a workflow with a single job running its steps.
+	Workflow *actionlint.Workflow
+	// Inputs maps the lowercase names of the inputs passed by the caller to their values.
+	Inputs map[string]string
+	// Secrets maps the lowercase names of the secrets passed to a reusable workflow to their values.
+	Secrets map[string]string
+	// Path is the path of the callee, e.g. `.github/actions/setup/action.yml`.
+	Path string
+	// RootPath is the path of the workflow at the root of the call chain.
+	RootPath string
+	// Caller is the location of the `uses` calling the callee.
+	Caller checker.File
+	// InheritSecrets is true if the caller passes all its secrets with `secrets: inherit`.
+	InheritSecrets bool
+	// IsCompositeAction is true for composite actions, and false for reusable workflows.
+	IsCompositeAction bool
+}
+
+// OnLocalCalleeDo is called on each call to a local composite action or reusable workflow.
+type OnLocalCalleeDo func(callee *LocalCallee) error
+
+type localCalleeResolver struct {
+	repoClient clients.RepoClient
+	onCallee   OnLocalCalleeDo
+	root       *actionlint.Workflow
+	rootPath   string
+}
+
+// OnLocalCalleesDo resolves the composite actions and reusable workflows of the repository
+// called by its GitHub workflows, including nested calls, and runs onCallee on each call.
+func OnLocalCalleesDo(repoClient clients.RepoClient, onCallee OnLocalCalleeDo) error {
+	return OnMatchingFileContentDo(repoClient, PathMatcher{
+		Pattern:       ".github/workflows/*",
+		CaseSensitive: false,
+	}, func(pathfn string, content []byte, args ...interface{}) (bool, error) {
+		if !IsWorkflowFile(pathfn) || !CheckFileContainsCommands(content, "#") {
+			return true, nil
+		}
+		workflow, errs := actionlint.Parse(content)
+		if len(errs) > 0 && workflow == nil {
+			// Invalid workflows are reported by the checks analyzing them.
+			return true, nil
+		}
+		if err := OnWorkflowLocalCalleesDo(repoClient, pathfn, workflow, onCallee); err != nil {
+			return false, err
+		}
+		return true, nil
+	})
+}
+
+// OnWorkflowLocalCalleesDo resolves the composite actions and reusable workflows of the repository
+// called by the workflow at pathfn, including nested calls, and runs onCallee on each call.
+// Callees that cannot be read or parsed are ignored: they are either missing or
+// not composite actions, e.g. Docker container actions.
+func OnWorkflowLocalCalleesDo(repoClient clients.RepoClient, pathfn string, workflow *actionlint.Workflow,
+	onCallee OnLocalCalleeDo,
+) error {
+	if repoClient == nil || workflow == nil {
+		return nil
+	}
+	r := localCalleeResolver{
+		repoClient: repoClient,
+		onCallee:   onCallee,
+		root:       workflow,
+		rootPath:   pathfn,
+	}
+	for _, job := range sortedJobs(workflow.Jobs) {
+		if err := r.resolveJob(pathfn, job, nil, []string{pathfn}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsLocalUses returns true if `uses` references an action or a workflow of the same repository,
+// https://docs.github.com/en/actions/learn-github-actions/finding-and-customizing-actions#referencing-an-action-in-the-same-repository-where-a-workflow-file-uses-the-action.
+func IsLocalUses(uses string) bool {
+	return strings.HasPrefix(uses, "./")
+}
+
+func (r *localCalleeResolver) resolveJob(callerPath string, job *actionlint.Job,
+	inputs map[string]string, stack []string,
+) error {
+	if job == nil {
+		return nil
+	}
+	if job.WorkflowCall != nil && job.WorkflowCall.Uses != nil && IsLocalUses(job.WorkflowCall.Uses.Value) {
+		return r.resolveWorkflow(callerPath, job, inputs, stack)
+	}
+	for _, step := range job.Steps {
+		uses := GetUses(step)
+		if uses == nil || !IsLocalUses(uses.Value) {
+			continue
+		}
+		if err := r.resolveAction(callerPath, job, step, inputs, stack); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolveWorkflow resolves a reusable workflow called by job,
+// https://docs.github.com/en/actions/using-workflows/reusing-workflows.
+func (r *localCalleeResolver) resolveWorkflow(callerPath string, job *actionlint.Job,
+	inputs map[string]string, stack []string,
+) error {
+	call := job.WorkflowCall
+	calleePath := path.Clean(strings.TrimPrefix(call.Uses.Value, "./"))
+	if !canCall(calleePath, stack) {
+		return nil
+	}
+	content, err := r.repoClient.GetFileContent(calleePath)
+	if err != nil {
+		//nolint:nilerr
+		return nil
+	}
+	workflow, errs := actionlint.Parse(content)
+	if len(errs) > 0 && workflow == nil {
+		return nil
+	}
+
+	callee := &LocalCallee{
+		Path:           calleePath,
+		RootPath:       r.rootPath,
+		Caller:         callerLocation(callerPath, call.Uses),
+		Inputs:         map[string]string{},
+		Secrets:        map[string]string{},
+		InheritSecrets: call.InheritSecrets,
+	}
+	for name, input := range call.Inputs {
+		if input != nil && input.Value != nil {
+			callee.Inputs[name] = expandInputs(input.Value.Value, inputs)
+		}
+	}
+	for name, secret := range call.Secrets {
+		if secret != nil && secret.Value != nil {
+			callee.Secrets[name] = secret.Value.Value
+		}
+	}
+	for _, j := range workflow.Jobs {
+		expandJobInputs(j, callee.Inputs)
+	}
+	workflow.On = r.root.On
+	callee.Workflow = workflow
+	if err := r.onCallee(callee); err != nil {
+		return err
+	}
+
+	stack = append(stack, calleePath)
+	for _, j := range sortedJobs(workflow.Jobs) {
+		if err := r.resolveJob(calleePath, j, callee.Inputs, stack); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolveAction resolves a composite action used by a step of job,
+// https://docs.github.com/en/actions/creating-actions/creating-a-composite-action.
+func (r *localCalleeResolver) resolveAction(callerPath string, job *actionlint.Job, step *actionlint.Step,
+	inputs map[string]string, stack []string,
+) error {
+	uses := GetUses(step)
+	dir := path.Clean(strings.TrimPrefix(uses.Value, "./"))
+	var calleePath string
+	var steps []*actionlint.Step
+	for _, name := range []string{"action.yml", "action.yaml"} {
+		p := path.Join(dir, name)
+		content, err := r.repoClient.GetFileContent(p)
+		if err != nil {
+			continue
+		}
+		s, ok := parseCompositeActionSteps(content)
+		if !ok {
+			return nil
+		}
+		calleePath, steps = p, s
+		break
+	}
+	if calleePath == "" || !canCall(calleePath, stack) {
+		return nil
+	}
+
+	callee := &LocalCallee{
+		Path:              calleePath,
+		RootPath:          r.rootPath,
+		Caller:            callerLocation(callerPath, uses),
+		Inputs:            map[string]string{},
+		IsCompositeAction: true,
+	}
+	for name, input := range getWith(step) {
+		if input != nil && input.Value != nil {
+			callee.Inputs[name] = expandInputs(input.Value.Value, inputs)
+		}
+	}
+	// The steps of a composite action run in the job of the step calling it.
+	compositeJob := &actionlint.Job{
+		ID:       job.ID,
+		Name:     job.Name,
+		RunsOn:   job.RunsOn,
+		Defaults: job.Defaults,
+		Steps:    steps,
+	}
+	expandJobInputs(compositeJob, callee.Inputs)
+	jobID := "composite"
+	if job.ID != nil {
+		jobID = job.ID.Value
+	}
+	callee.Workflow = &actionlint.Workflow{
+		On:   r.root.On,
+		Jobs: map[string]*actionlint.Job{jobID: compositeJob},
+	}
+	if err := r.onCallee(callee); err != nil {
+		return err
+	}
+
+	return r.resolveJob(calleePath, compositeJob, callee.Inputs, append(stack, calleePath))
+}
+
+// sortedJobs returns the jobs sorted by ID, so that callees are resolved in a stable order.
+func sortedJobs(jobs map[string]*actionlint.Job) []*actionlint.Job {
+	ids := make([]string, 0, len(jobs))
+	for id := range jobs {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	ret := make([]*actionlint.Job, 0, len(ids))
+	for _, id := range ids {
+		ret = append(ret, jobs[id])
+	}
+	return ret
+}
+
+// canCall returns false if calling calleePath would create a cycle, or
+// exceed the maximum nesting depth.
+func canCall(calleePath string, stack []string) bool {
+	if len(stack) > maxLocalCalleeDepth {
+		return false
+	}
+	for _, p := range stack {
+		if p == calleePath {
+			return false
+		}
+	}
+	return true
+}
+
+func callerLocation(callerPath string, uses *actionlint.String) checker.File {
+	return checker.File{
+		Path:      callerPath,
+		Type:      finding.FileTypeSource,
+		Offset:    GetLineNumber(uses.Pos),
+		EndOffset: GetLineNumber(uses.Pos),
+		Snippet:   uses.Value,
+	}
+}
+
+// expandInputs replaces the `${{ inputs.<name> }}` expressions of s with the
+// values passed by the caller. Inputs not passed by the caller are left unchanged.
+func expandInputs(s string, inputs map[string]string) string {
+	if len(inputs) == 0 {
+		return s
+	}
+	return inputsExpressionRegex.ReplaceAllStringFunc(s, func(e string) string {
+		name := inputsExpressionRegex.FindStringSubmatch(e)[1]
+		if v, ok := inputs[strings.ToLower(name)]; ok {
+			return v
+		}
+		return e
+	})
+}
+
+// expandJobInputs replaces references to inputs in the scripts and
+// action inputs of the job's steps.
+func expandJobInputs(job *actionlint.Job, inputs map[string]string) {
+	if job == nil || len(inputs) == 0 {
+		return
+	}
+	for _, step := range job.Steps {
+		if step == nil {
+			continue
+		}
+		switch e := step.Exec.(type) {
+		case *actionlint.ExecRun:
+			if e.Run != nil {
+				e.Run.Value = expandInputs(e.Run.Value, inputs)
+			}
+		case *actionlint.ExecAction:
+			for _, input := range e.Inputs {
+				if input != nil && input.Value != nil {
+					input.Value.Value = expandInputs(input.Value.Value, inputs)
+				}
+			}
+		}
+	}
+	if job.WorkflowCall != nil {
+		for _, input := range job.WorkflowCall.Inputs {
+			if input != nil && input.Value != nil {
+				input.Value.Value = expandInputs(input.Value.Value, inputs)
+			}
+		}
+	}
+}
+
+// parseCompositeActionSteps returns the steps of a composite action's metadata file. Returns false if
+// the file cannot be parsed, or does not define a composite action,
+// https://docs.github.com/en/actions/creating-actions/metadata-syntax-for-github-actions#runs-for-composite-actions.
+func parseCompositeActionSteps(content []byte) ([]*actionlint.Step, bool) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(content, &doc); err != nil || len(doc.Content) == 0 {
+		return nil, false
+	}
+	runs := YAMLMappingValue(doc.Content[0], "runs")
+	if runs == nil {
+		return nil, false
+	}
+	using := YAMLMappingValue(runs, "using")
+	if using == nil || !strings.EqualFold(using.Value, "composite") {
+		return nil, false
+	}
+	stepsNode := YAMLMappingValue(runs, "steps")
+	if stepsNode == nil || stepsNode.Kind != yaml.SequenceNode {
+		return nil, true
+	}
+
+	steps := make([]*actionlint.Step, 0, len(stepsNode.Content))
+	for _, n := range stepsNode.Content {
+		if n.Kind != yaml.MappingNode {
+			continue
+		}
+		step := &actionlint.Step{
+			ID:   yamlString(YAMLMappingValue(n, "id")),
+			If:   yamlString(YAMLMappingValue(n, "if")),
+			Name: yamlString(YAMLMappingValue(n, "name")),
+			Pos:  yamlPos(n),
+		}
+		if uses := YAMLMappingValue(n, "uses"); uses != nil {
+			e := &actionlint.ExecAction{
+				Uses:   yamlString(uses),
+				Inputs: map[string]*actionlint.Input{},
+			}
+			if with := YAMLMappingValue(n, "with"); with != nil && with.Kind == yaml.MappingNode {
+				for i := 0; i+1 < len(with.Content); i += 2 {
+					k, v := with.Content[i], with.Content[i+1]
+					e.Inputs[strings.ToLower(k.Value)] = &actionlint.Input{
+						Name:  yamlString(k),
+						Value: yamlString(v),
+					}
+				}
+			}
+			step.Exec = e
+		} else if run := YAMLMappingValue(n, "run"); run != nil {
+			step.Exec = &actionlint.ExecRun{
+				Run:              yamlString(run),
+				Shell:            yamlString(YAMLMappingValue(n, "shell")),
+				WorkingDirectory: yamlString(YAMLMappingValue(n, "working-directory")),
+				RunPos:           yamlPos(run),
+			}
+		}
+		steps = append(steps, step)
+	}
+	return steps, true
+}
+
+func yamlString(n *yaml.Node) *actionlint.String {
+	if n == nil || n.Kind != yaml.ScalarNode {
+		return nil
+	}
+	return &actionlint.String{
+		Value:  n.Value,
+		Quoted: n.Style&(yaml.DoubleQuotedStyle|yaml.SingleQuotedStyle) != 0,
+		Pos:    yamlPos(n),
+	}
+}
+
+func yamlPos(n *yaml.Node) *actionlint.Pos {
+	return &actionlint.Pos{Line: n.Line, Col: n.Column}
+}
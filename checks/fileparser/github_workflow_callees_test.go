@@ -0,0 +1,110 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	stdos "os"
+	"testing"
+
+	"github.com/rhysd/actionlint"
+)
+
+func TestParseCompositeActionSteps(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		filename  string
+		wantOK    bool
+		wantSteps int
+	}{
+		{
+			name:      "composite action",
+			filename:  "../testdata/.github/actions/unpinned-composite/action.yml",
+			wantOK:    true,
+			wantSteps: 3,
+		},
+		{
+			name:     "workflow",
+			filename: "../testdata/.github/workflows/github-workflow-local-composite-action.yaml",
+			wantOK:   false,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			content, err := stdos.ReadFile(tt.filename)
+			if err != nil {
+				t.Fatalf("cannot read file: %v", err)
+			}
+			steps, ok := parseCompositeActionSteps(content)
+			if ok != tt.wantOK {
+				t.Fatalf("parseCompositeActionSteps() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if len(steps) != tt.wantSteps {
+				t.Fatalf("parseCompositeActionSteps() got %d steps, want %d", len(steps), tt.wantSteps)
+			}
+			if !ok {
+				return
+			}
+			if uses := GetUses(steps[0]); uses == nil || uses.Value != "actions/setup-node@v3" || uses.Pos.Line != 19 {
+				t.Errorf("unexpected uses: %+v", uses)
+			}
+			run, isRun := steps[2].Exec.(*actionlint.ExecRun)
+			if !isRun || run.Shell == nil || run.Shell.Value != "bash" || run.Run.Pos.Line != 21 {
+				t.Errorf("unexpected run step: %+v", steps[2].Exec)
+			}
+		})
+	}
+}
+
+func TestExpandInputs(t *testing.T) {
+	t.Parallel()
+
+	inputs := map[string]string{
+		"title": "${{ github.event.issue.title }}",
+	}
+	tests := []struct {
+		name string
+		s    string
+		want string
+	}{
+		{
+			name: "passed input",
+			s:    `echo "${{ inputs.title }}"`,
+			want: `echo "${{ github.event.issue.title }}"`,
+		},
+		{
+			name: "case-insensitive input",
+			s:    `echo "${{inputs.Title}}"`,
+			want: `echo "${{ github.event.issue.title }}"`,
+		},
+		{
+			name: "input not passed",
+			s:    `echo "${{ inputs.body }}"`,
+			want: `echo "${{ inputs.body }}"`,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := expandInputs(tt.s, inputs); got != tt.want {
+				t.Errorf("expandInputs() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
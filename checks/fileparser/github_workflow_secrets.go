@@ -0,0 +1,242 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/rhysd/actionlint"
+)
+
+// SecretUseKind is the way a job uses a secret.
+type SecretUseKind string
+
+const (
+	// SecretUseActionInput is a secret passed to an action with `with:`.
+	SecretUseActionInput SecretUseKind = "actionInput"
+	// SecretUseActionEnv is a secret set in the environment of an action,
+	// either by its step, or by the job or the workflow.
+	SecretUseActionEnv SecretUseKind = "actionEnv"
+	// SecretUseRunEnv is a secret set in the environment of a `run` step,
+	// either by the step, or by the job or the workflow.
+	SecretUseRunEnv SecretUseKind = "runEnv"
+	// SecretUseRunScript is a secret referenced in the script of a `run` step.
+	SecretUseRunScript SecretUseKind = "runScript"
+	// SecretUseWorkflowCall is a secret passed to a reusable workflow with `secrets:`.
+	SecretUseWorkflowCall SecretUseKind = "workflowCall"
+	// SecretUseInherit is a reusable workflow receiving all the secrets with `secrets: inherit`.
+	SecretUseInherit SecretUseKind = "inherit"
+)
+
+var (
+	expressionRegex     = regexp.MustCompile(`\$\{\{(.*?)\}\}`)
+	secretPropertyRegex = regexp.MustCompile(`(?i)\bsecrets\.([A-Za-z0-9_\-]+)`)
+	allSecretsRegex     = regexp.MustCompile(`(?i)\btojson\(\s*secrets\s*\)`)
+)
+
+// SecretUse is a use of a secret by a job.
+type SecretUse struct {
+	// Pos is the position of the value referencing the secret, or of
+	// the `uses` of the reusable workflow for `secrets: inherit`.
+	Pos *actionlint.Pos
+	// Step is the step using the secret, nil for reusable workflow calls.
+	Step *actionlint.Step
+	// Receiver is the `uses` of the action or reusable workflow receiving
+	// the secret, empty for `run` steps.
+	Receiver string
+	// Key is the name of the input, environment variable or reusable workflow
+	// secret holding the secret, empty for the secrets referenced in scripts.
+	Key string
+	// Secret is the name of the secret. It is empty when all the secrets are used,
+	// e.g., with `secrets: inherit` or `${{ toJSON(secrets) }}`.
+	Secret string
+	Kind   SecretUseKind
+}
+
+// JobSecretUsage is the secrets used by a job.
+type JobSecretUsage struct {
+	Job  *actionlint.Job
+	Uses []SecretUse
+}
+
+// GetSecretUsage returns the secrets used by each job of the workflow, sorted by job ID.
+// The environment variables set by the workflow and the job are propagated to the
+// steps which do not override them.
+func GetSecretUsage(workflow *actionlint.Workflow) []JobSecretUsage {
+	var ret []JobSecretUsage
+	for _, job := range sortedJobs(workflow.Jobs) {
+		if job == nil {
+			continue
+		}
+		usage := JobSecretUsage{Job: job}
+		if job.WorkflowCall != nil {
+			usage.Uses = workflowCallSecretUses(job.WorkflowCall)
+		}
+		for _, step := range job.Steps {
+			if step == nil {
+				continue
+			}
+			usage.Uses = append(usage.Uses, stepSecretUses(workflow, job, step)...)
+		}
+		if len(usage.Uses) > 0 {
+			ret = append(ret, usage)
+		}
+	}
+	return ret
+}
+
+func workflowCallSecretUses(call *actionlint.WorkflowCall) []SecretUse {
+	if call.Uses == nil {
+		return nil
+	}
+	if call.InheritSecrets {
+		return []SecretUse{{
+			Pos:      call.Uses.Pos,
+			Receiver: call.Uses.Value,
+			Kind:     SecretUseInherit,
+		}}
+	}
+	var uses []SecretUse
+	for _, name := range sortedKeys(call.Secrets) {
+		s := call.Secrets[name]
+		if s == nil || s.Name == nil || s.Value == nil {
+			continue
+		}
+		for _, secret := range referencedSecrets(s.Value.Value) {
+			uses = append(uses, SecretUse{
+				Pos:      s.Value.Pos,
+				Receiver: call.Uses.Value,
+				Key:      s.Name.Value,
+				Secret:   secret,
+				Kind:     SecretUseWorkflowCall,
+			})
+		}
+	}
+	return uses
+}
+
+func stepSecretUses(workflow *actionlint.Workflow, job *actionlint.Job, step *actionlint.Step) []SecretUse {
+	var uses []SecretUse
+	receiver := ""
+	envKind := SecretUseRunEnv
+	if u := GetUses(step); u != nil {
+		receiver = u.Value
+		envKind = SecretUseActionEnv
+		with := getWith(step)
+		for _, name := range sortedKeys(with) {
+			input := with[name]
+			if input == nil || input.Name == nil || input.Value == nil {
+				continue
+			}
+			for _, secret := range referencedSecrets(input.Value.Value) {
+				uses = append(uses, SecretUse{
+					Pos:      input.Value.Pos,
+					Step:     step,
+					Receiver: receiver,
+					Key:      input.Name.Value,
+					Secret:   secret,
+					Kind:     SecretUseActionInput,
+				})
+			}
+		}
+	}
+
+	env := stepEnv(workflow, job, step)
+	for _, name := range sortedKeys(env) {
+		v := env[name]
+		for _, secret := range referencedSecrets(v.Value.Value) {
+			uses = append(uses, SecretUse{
+				Pos:      v.Value.Pos,
+				Step:     step,
+				Receiver: receiver,
+				Key:      v.Name.Value,
+				Secret:   secret,
+				Kind:     envKind,
+			})
+		}
+	}
+
+	if run := getRun(step); run != nil {
+		for i, line := range strings.Split(run.Value, "\n") {
+			for _, secret := range referencedSecrets(line) {
+				uses = append(uses, SecretUse{
+					Pos:    ScriptLinePos(run, i),
+					Step:   step,
+					Secret: secret,
+					Kind:   SecretUseRunScript,
+				})
+			}
+		}
+	}
+	return uses
+}
+
+// stepEnv returns the environment variables of a step, including the ones
+// set by its job and workflow, keyed by their lowercase names.
+func stepEnv(workflow *actionlint.Workflow, job *actionlint.Job, step *actionlint.Step) map[string]*actionlint.EnvVar {
+	env := make(map[string]*actionlint.EnvVar)
+	for _, e := range []*actionlint.Env{workflow.Env, job.Env, step.Env} {
+		if e == nil {
+			continue
+		}
+		for name, v := range e.Vars {
+			if v == nil || v.Name == nil || v.Value == nil {
+				continue
+			}
+			env[name] = v
+		}
+	}
+	return env
+}
+
+// ScriptLinePos returns the position of the i-th line of a `run` script.
+// The lines of multi-line scripts start after the line of the `run` key.
+func ScriptLinePos(run *actionlint.String, i int) *actionlint.Pos {
+	if run.Pos == nil {
+		return nil
+	}
+	pos := *run.Pos
+	if strings.Contains(run.Value, "\n") {
+		pos.Line += i + 1
+		pos.Col = 1
+	}
+	return &pos
+}
+
+// referencedSecrets returns the names of the secrets referenced by the expressions
+// of a value. An empty name means that all the secrets are referenced.
+func referencedSecrets(value string) []string {
+	var ret []string
+	for _, expr := range expressionRegex.FindAllStringSubmatch(value, -1) {
+		if allSecretsRegex.MatchString(expr[1]) {
+			ret = append(ret, "")
+		}
+		for _, m := range secretPropertyRegex.FindAllStringSubmatch(expr[1], -1) {
+			ret = append(ret, m[1])
+		}
+	}
+	return ret
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,95 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/rhysd/actionlint"
+)
+
+func TestGetSecretUsage(t *testing.T) {
+	t.Parallel()
+
+	content := `on: push
+env:
+  TOKEN: ${{ secrets.WORKFLOW_TOKEN }}
+  NAME: scorecard
+jobs:
+  call:
+    uses: org/repo/.github/workflows/reusable.yml@v1
+    secrets:
+      key: ${{ secrets.KEY }}
+  inherit:
+    uses: ./.github/workflows/reusable.yml
+    secrets: inherit
+  build:
+    runs-on: ubuntu-latest
+    env:
+      TOKEN: ${{ secrets.JOB_TOKEN }}
+    steps:
+      - uses: org/action@v1
+        with:
+          api-key: ${{ secrets.API_KEY }}
+          all: ${{ toJSON(secrets) }}
+      - run: |
+          make
+          curl -H "Authorization: ${{ secrets.A }} ${{ secrets.B }}" https://example.com
+        env:
+          NAME: ${{ secrets.NAME }}
+`
+	workflow, errs := actionlint.Parse([]byte(content))
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+
+	type use struct {
+		Job      string
+		Kind     SecretUseKind
+		Receiver string
+		Key      string
+		Secret   string
+		Line     int
+	}
+	want := []use{
+		{Job: "build", Kind: SecretUseActionInput, Receiver: "org/action@v1", Key: "all", Secret: "", Line: 21},
+		{Job: "build", Kind: SecretUseActionInput, Receiver: "org/action@v1", Key: "api-key", Secret: "API_KEY", Line: 20},
+		{Job: "build", Kind: SecretUseActionEnv, Receiver: "org/action@v1", Key: "TOKEN", Secret: "JOB_TOKEN", Line: 16},
+		{Job: "build", Kind: SecretUseRunEnv, Key: "NAME", Secret: "NAME", Line: 26},
+		{Job: "build", Kind: SecretUseRunEnv, Key: "TOKEN", Secret: "JOB_TOKEN", Line: 16},
+		{Job: "build", Kind: SecretUseRunScript, Secret: "A", Line: 24},
+		{Job: "build", Kind: SecretUseRunScript, Secret: "B", Line: 24},
+		{Job: "call", Kind: SecretUseWorkflowCall, Receiver: "org/repo/.github/workflows/reusable.yml@v1",
+			Key: "key", Secret: "KEY", Line: 9},
+		{Job: "inherit", Kind: SecretUseInherit, Receiver: "./.github/workflows/reusable.yml", Line: 11},
+	}
+	var got []use
+	for _, usage := range GetSecretUsage(workflow) {
+		for _, u := range usage.Uses {
+			got = append(got, use{
+				Job:      usage.Job.ID.Value,
+				Kind:     u.Kind,
+				Receiver: u.Receiver,
+				Key:      u.Key,
+				Secret:   u.Secret,
+				Line:     u.Pos.Line,
+			})
+		}
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
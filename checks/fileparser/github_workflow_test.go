@@ -22,6 +22,8 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/rhysd/actionlint"
+
+	"github.com/ossf/scorecard/v4/checker"
 )
 
 func TestGitHubWorkflowShell(t *testing.T) {
@@ -1003,6 +1005,31 @@ func TestIsPackagingWorkflow(t *testing.T) {
 			filename: "../testdata/.github/workflows/github-workflow-packaging-semantic-release.yaml",
 			expected: true,
 		},
+		{
+			name:     "hex publish",
+			filename: "../testdata/.github/workflows/github-workflow-packaging-hex.yaml",
+			expected: true,
+		},
+		{
+			name:     "packagist update",
+			filename: "../testdata/.github/workflows/github-workflow-packaging-packagist.yaml",
+			expected: true,
+		},
+		{
+			name:     "helm chart-releaser publish",
+			filename: "../testdata/.github/workflows/github-workflow-packaging-helm.yaml",
+			expected: true,
+		},
+		{
+			name:     "oras push publish",
+			filename: "../testdata/.github/workflows/github-workflow-packaging-oci.yaml",
+			expected: true,
+		},
+		{
+			name:     "release-please publish",
+			filename: "../testdata/.github/workflows/github-workflow-packaging-release-please.yaml",
+			expected: true,
+		},
 	}
 	for _, tt := range tests {
 		tt := tt // Re-initializing variable so it is not changed while executing the closure below
@@ -1025,3 +1052,75 @@ func TestIsPackagingWorkflow(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPublishingAuth(t *testing.T) {
+	t.Parallel()
+
+	//nolint:govet
+	tests := []struct {
+		name       string
+		filename   string
+		auth       checker.PublishingAuth
+		provenance bool
+	}{
+		{
+			name:     "npm publish with token",
+			filename: "../testdata/.github/workflows/github-workflow-packaging-npm.yaml",
+			auth:     checker.PublishingAuthToken,
+		},
+		{
+			name:       "npm trusted publishing with provenance",
+			filename:   "../testdata/.github/workflows/github-workflow-packaging-npm-provenance.yaml",
+			auth:       checker.PublishingAuthTrusted,
+			provenance: true,
+		},
+		{
+			name:     "pypi publish with password",
+			filename: "../testdata/.github/workflows/github-workflow-packaging-pypi.yaml",
+			auth:     checker.PublishingAuthToken,
+		},
+		{
+			name:       "pypi trusted publishing",
+			filename:   "../testdata/.github/workflows/github-workflow-packaging-pypi-trusted.yaml",
+			auth:       checker.PublishingAuthTrusted,
+			provenance: true,
+		},
+		{
+			name:     "cargo publish",
+			filename: "../testdata/.github/workflows/github-workflow-packaging-cargo.yaml",
+			auth:     checker.PublishingAuthUnknown,
+		},
+		{
+			name:     "cargo trusted publishing",
+			filename: "../testdata/.github/workflows/github-workflow-packaging-cargo-trusted.yaml",
+			auth:     checker.PublishingAuthTrusted,
+		},
+		{
+			name:     "docker action publish",
+			filename: "../testdata/.github/workflows/github-workflow-packaging-docker-action.yaml",
+			auth:     checker.PublishingAuthUnknown,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			content, err := stdos.ReadFile(tt.filename)
+			if err != nil {
+				t.Errorf("cannot read file: %v", err)
+			}
+			workflow, errs := actionlint.Parse(content)
+			if len(errs) > 0 && workflow == nil {
+				t.Errorf("cannot parse file: %v", errs)
+			}
+
+			auth, provenance := GetPublishingAuth(workflow)
+			if auth != tt.auth {
+				t.Errorf("GetPublishingAuth() auth = %v, expected %v", auth, tt.auth)
+			}
+			if provenance != tt.provenance {
+				t.Errorf("GetPublishingAuth() provenance = %v, expected %v", provenance, tt.provenance)
+			}
+		})
+	}
+}
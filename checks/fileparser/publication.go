@@ -0,0 +1,133 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+)
+
+// publicationTool is a command or an action publishing packages to a registry.
+type publicationTool struct {
+	regex    *regexp.Regexp
+	name     string
+	registry checker.PackageRegistry
+}
+
+func newPublicationTool(registry checker.PackageRegistry, name, expr string) publicationTool {
+	return publicationTool{
+		regex:    regexp.MustCompile(expr),
+		name:     name,
+		registry: registry,
+	}
+}
+
+// publicationTools are matched against the lines of the pipeline files. Actions are
+// matched by their reference, and commands by their name and subcommand.
+var publicationTools = []publicationTool{
+	newPublicationTool(checker.PackageRegistryNpm, "npm publish", `\b(?:npm|pnpm|yarn)\s+(?:\S+\s+)*?publish\b`),
+	newPublicationTool(checker.PackageRegistryNpm, "JS-DevTools/npm-publish", `\bJS-DevTools/npm-publish@`),
+
+	newPublicationTool(checker.PackageRegistryPyPI, "pypa/gh-action-pypi-publish", `\bpypa/gh-action-pypi-publish@`),
+	newPublicationTool(checker.PackageRegistryPyPI, "python-semantic-release",
+		`\b(?:relekang|python-semantic-release)/python-semantic-release@`),
+	newPublicationTool(checker.PackageRegistryPyPI, "twine upload", `\btwine\s+upload\b`),
+	newPublicationTool(checker.PackageRegistryPyPI, "poetry publish", `\bpoetry\s+publish\b`),
+	newPublicationTool(checker.PackageRegistryPyPI, "uv publish", `\buv\s+publish\b`),
+	newPublicationTool(checker.PackageRegistryPyPI, "flit publish", `\bflit\s+publish\b`),
+	newPublicationTool(checker.PackageRegistryPyPI, "hatch publish", `\bhatch\s+publish\b`),
+
+	newPublicationTool(checker.PackageRegistryMaven, "mvn deploy", `\bmvnw?\b.*\bdeploy\b`),
+	newPublicationTool(checker.PackageRegistryMaven, "gradle publish", `\bgradlew?\b.*\bpublish`),
+
+	newPublicationTool(checker.PackageRegistryCratesIO, "cargo publish", `\bcargo\b.*\bpublish\b`),
+	newPublicationTool(checker.PackageRegistryCratesIO, "katyo/publish-crates", `\bkatyo/publish-crates@`),
+
+	newPublicationTool(checker.PackageRegistryNuGet, "nuget push", `\bnuget\b.*\bpush\b`),
+
+	newPublicationTool(checker.PackageRegistryRubyGems, "gem push", `\bgem\s+push\b`),
+	newPublicationTool(checker.PackageRegistryRubyGems, "rubygems/release-gem", `\brubygems/release-gem@`),
+
+	newPublicationTool(checker.PackageRegistryHex, "mix hex.publish", `\bmix\s+hex\.publish\b`),
+	newPublicationTool(checker.PackageRegistryHex, "rebar3 hex publish", `\brebar3\s+hex\s+publish\b`),
+
+	// Packagist reads the packages from the repository, and is only notified of new releases.
+	newPublicationTool(checker.PackageRegistryPackagist, "packagist update-package",
+		`\bpackagist\.org/api/update-package\b`),
+
+	newPublicationTool(checker.PackageRegistryHelm, "helm push", `\bhelm\s+(?:cm-)?push\b`),
+	newPublicationTool(checker.PackageRegistryHelm, "helm/chart-releaser-action", `\bhelm/chart-releaser-action@`),
+
+	newPublicationTool(checker.PackageRegistryOCI, "docker push", `\bdocker\s+(?:image\s+)?push\b`),
+	newPublicationTool(checker.PackageRegistryOCI, "docker buildx build --push",
+		`\bdocker\s+buildx\s+build\b.*\s--push\b`),
+	newPublicationTool(checker.PackageRegistryOCI, "docker/build-push-action", `\bdocker/build-push-action@`),
+	newPublicationTool(checker.PackageRegistryOCI, "podman push", `\bpodman\s+push\b`),
+	newPublicationTool(checker.PackageRegistryOCI, "buildah push", `\bbuildah\s+push\b`),
+	newPublicationTool(checker.PackageRegistryOCI, "redhat-actions/push-to-registry",
+		`\bredhat-actions/push-to-registry@`),
+	newPublicationTool(checker.PackageRegistryOCI, "oras push", `\boras\s+push\b`),
+	newPublicationTool(checker.PackageRegistryOCI, "crane push", `\bcrane\s+push\b`),
+	newPublicationTool(checker.PackageRegistryOCI, "skopeo copy", `\bskopeo\s+copy\b`),
+	newPublicationTool(checker.PackageRegistryOCI, "ko", `\bko\s+(?:build|publish|resolve|apply)\b`),
+	newPublicationTool(checker.PackageRegistryOCI, "setup-ko", `\b(?:ko-build|imjasonh)/setup-ko@`),
+
+	newPublicationTool(checker.PackageRegistryReleases, "goreleaser",
+		`\bgoreleaser/goreleaser-action@|\bgoreleaser\s+release\b`),
+	newPublicationTool(checker.PackageRegistryReleases, "release-please",
+		`\b(?:googleapis|google-github-actions)/release-please-action@|`+
+			`(?:^|[\s"'])release-please\s+(?:release-pr|github-release)\b`),
+	newPublicationTool(checker.PackageRegistryReleases, "semantic-release",
+		`\bcycjimmy/semantic-release-action@|\b(?:npx|pnpm|yarn|bunx)\s+(?:\S+\s+)*?semantic-release\b`),
+}
+
+// artifactSigningRegex matches the commands and actions signing published artifacts.
+var artifactSigningRegex = regexp.MustCompile(
+	`\bcosign\s+sign(?:-blob)?\b|\bsigstore/cosign-installer@|\bsigstore/gh-action-sigstore-python@|\bnotation\s+sign\b`)
+
+// GetPublicationTargets returns the registries a pipeline file publishes packages to,
+// with the line of the first command or action publishing to each of them.
+// A tool is reported once per file.
+func GetPublicationTargets(fp string, content []byte) []checker.PublicationTarget {
+	signed := artifactSigningRegex.Match(content)
+	seen := map[string]bool{}
+	var targets []checker.PublicationTarget
+	for i, line := range strings.Split(string(content), "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "#") {
+			continue
+		}
+		for _, tool := range publicationTools {
+			if seen[tool.name] || !tool.regex.MatchString(line) {
+				continue
+			}
+			seen[tool.name] = true
+			targets = append(targets, checker.PublicationTarget{
+				File: checker.File{
+					Path:    fp,
+					Type:    finding.FileTypeSource,
+					Offset:  uint(i + 1),
+					Snippet: strings.TrimSpace(line),
+				},
+				Registry: tool.registry,
+				Tool:     tool.name,
+				Signed:   signed,
+			})
+		}
+	}
+	return targets
+}
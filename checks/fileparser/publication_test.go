@@ -0,0 +1,170 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	stdos "os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/ossf/scorecard/v4/checker"
+)
+
+func TestGetPublicationTargets(t *testing.T) {
+	t.Parallel()
+
+	type target struct {
+		registry checker.PackageRegistry
+		tool     string
+		line     uint
+	}
+	//nolint:govet
+	tests := []struct {
+		name    string
+		content string
+		targets []target
+		signed  bool
+	}{
+		{
+			name:    "no publication",
+			content: "script:\n  - go test ./...\n  - npm install\n",
+		},
+		{
+			name:    "commented out",
+			content: "script:\n  # - cargo publish\n",
+		},
+		{
+			name:    "npm and releases",
+			content: "script:\n  - npm ci\n  - npm publish --provenance\n  - npx semantic-release\n",
+			targets: []target{
+				{registry: checker.PackageRegistryNpm, tool: "npm publish", line: 3},
+				{registry: checker.PackageRegistryReleases, tool: "semantic-release", line: 4},
+			},
+		},
+		{
+			name: "one target per tool",
+			content: "crates:\n  script:\n    - cargo publish -p core\n    - cargo publish -p cli\n" +
+				"gems:\n  script:\n    - gem push pkg/*.gem\n",
+			targets: []target{
+				{registry: checker.PackageRegistryCratesIO, tool: "cargo publish", line: 3},
+				{registry: checker.PackageRegistryRubyGems, tool: "gem push", line: 7},
+			},
+		},
+		{
+			name: "ecosystems",
+			content: "script:\n  - dotnet nuget push bin/*.nupkg\n  - mix hex.publish --yes\n" +
+				"  - helm push chart.tgz oci://ghcr.io/owner\n  - uv publish\n",
+			targets: []target{
+				{registry: checker.PackageRegistryNuGet, tool: "nuget push", line: 2},
+				{registry: checker.PackageRegistryHex, tool: "mix hex.publish", line: 3},
+				{registry: checker.PackageRegistryHelm, tool: "helm push", line: 4},
+				{registry: checker.PackageRegistryPyPI, tool: "uv publish", line: 5},
+			},
+		},
+		{
+			name: "python-semantic-release is not semantic-release",
+			content: "steps:\n  - uses: python-semantic-release/python-semantic-release@v8\n" +
+				"  - uses: googleapis/release-please-action@v4\n",
+			targets: []target{
+				{registry: checker.PackageRegistryPyPI, tool: "python-semantic-release", line: 2},
+				{registry: checker.PackageRegistryReleases, tool: "release-please", line: 3},
+			},
+		},
+		{
+			name: "signed oci push",
+			content: "script:\n  - docker buildx build --platform linux/amd64 --push -t $IMAGE .\n" +
+				"  - cosign sign --yes $IMAGE\n",
+			targets: []target{
+				{registry: checker.PackageRegistryOCI, tool: "docker buildx build --push", line: 2},
+			},
+			signed: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var got []target
+			for _, pt := range GetPublicationTargets("ci.yml", []byte(tt.content)) {
+				if pt.Signed != tt.signed {
+					t.Errorf("%v: signed = %v, expected %v", pt.Tool, pt.Signed, tt.signed)
+				}
+				got = append(got, target{registry: pt.Registry, tool: pt.Tool, line: pt.File.Offset})
+			}
+			if diff := cmp.Diff(tt.targets, got, cmp.AllowUnexported(target{}), cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetPublicationTargetsWorkflows(t *testing.T) {
+	t.Parallel()
+
+	//nolint:govet
+	tests := []struct {
+		filename string
+		registry checker.PackageRegistry
+		tool     string
+		signed   bool
+	}{
+		{
+			filename: "../testdata/.github/workflows/github-workflow-packaging-hex.yaml",
+			registry: checker.PackageRegistryHex,
+			tool:     "mix hex.publish",
+		},
+		{
+			filename: "../testdata/.github/workflows/github-workflow-packaging-packagist.yaml",
+			registry: checker.PackageRegistryPackagist,
+			tool:     "packagist update-package",
+		},
+		{
+			filename: "../testdata/.github/workflows/github-workflow-packaging-helm.yaml",
+			registry: checker.PackageRegistryHelm,
+			tool:     "helm/chart-releaser-action",
+		},
+		{
+			filename: "../testdata/.github/workflows/github-workflow-packaging-oci.yaml",
+			registry: checker.PackageRegistryOCI,
+			tool:     "oras push",
+			signed:   true,
+		},
+		{
+			filename: "../testdata/.github/workflows/github-workflow-packaging-go.yaml",
+			registry: checker.PackageRegistryReleases,
+			tool:     "goreleaser",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.filename, func(t *testing.T) {
+			t.Parallel()
+			content, err := stdos.ReadFile(tt.filename)
+			if err != nil {
+				t.Fatalf("cannot read file: %v", err)
+			}
+			targets := GetPublicationTargets(tt.filename, content)
+			if len(targets) != 1 {
+				t.Fatalf("GetPublicationTargets() returned %d targets, expected 1: %v", len(targets), targets)
+			}
+			if targets[0].Registry != tt.registry || targets[0].Tool != tt.tool || targets[0].Signed != tt.signed {
+				t.Errorf("GetPublicationTargets() = (%v, %v, %v), expected (%v, %v, %v)",
+					targets[0].Registry, targets[0].Tool, targets[0].Signed, tt.registry, tt.tool, tt.signed)
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+)
+
+// REUSEAnnotation declares the license of the files matching its patterns,
+// in a `.reuse/dep5` or `REUSE.toml` file, see https://reuse.software/spec/.
+type REUSEAnnotation struct {
+	regexes []*regexp.Regexp
+	// Patterns are the path patterns of the annotation.
+	Patterns []string
+	// License is the SPDX license expression of the files.
+	License string
+	// Line is the line number of the annotation, if known.
+	Line uint
+}
+
+// ParseREUSEDep5 parses a `.reuse/dep5` file, which follows the Debian
+// machine-readable copyright format,
+// https://www.debian.org/doc/packaging-manuals/copyright-format/1.0/.
+// Only the paragraphs with both a Files and a License field are returned.
+func ParseREUSEDep5(content []byte) []REUSEAnnotation {
+	var annotations []REUSEAnnotation
+	var current REUSEAnnotation
+	var field string
+	flush := func() {
+		if len(current.Patterns) > 0 && current.License != "" {
+			for _, p := range current.Patterns {
+				current.regexes = append(current.regexes, dep5PatternRegex(p))
+			}
+			annotations = append(annotations, current)
+		}
+		current, field = REUSEAnnotation{}, ""
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	var line uint
+	for scanner.Scan() {
+		line++
+		text := scanner.Text()
+		if strings.TrimSpace(text) == "" {
+			flush()
+			continue
+		}
+		// Continuation lines start with a space or a tab.
+		if text[0] == ' ' || text[0] == '\t' {
+			if field == "files" {
+				current.Patterns = append(current.Patterns, strings.Fields(text)...)
+			}
+			continue
+		}
+		name, value, ok := strings.Cut(text, ":")
+		if !ok {
+			continue
+		}
+		field = strings.ToLower(strings.TrimSpace(name))
+		switch field {
+		case "files":
+			current.Patterns = append(current.Patterns, strings.Fields(value)...)
+			current.Line = line
+		case "license":
+			// The first line of the field is the license expression, the
+			// following lines, if any, are the text of the license.
+			current.License = strings.TrimSpace(value)
+		}
+	}
+	flush()
+	return annotations
+}
+
+type reuseToml struct {
+	Annotations []struct {
+		Path    interface{} `toml:"path"`
+		License interface{} `toml:"SPDX-License-Identifier"`
+	} `toml:"annotations"`
+}
+
+// ParseREUSEToml parses a `REUSE.toml` file. Only the annotations
+// with both a path and an SPDX-License-Identifier are returned.
+func ParseREUSEToml(content []byte) ([]REUSEAnnotation, error) {
+	var rt reuseToml
+	if err := toml.Unmarshal(content, &rt); err != nil {
+		return nil, fmt.Errorf("toml.Unmarshal: %w", err)
+	}
+
+	var annotations []REUSEAnnotation
+	for _, a := range rt.Annotations {
+		patterns := tomlStrings(a.Path)
+		licenses := tomlStrings(a.License)
+		if len(patterns) == 0 || len(licenses) == 0 {
+			continue
+		}
+		annotation := REUSEAnnotation{
+			Patterns: patterns,
+			// Several identifiers apply to the files together.
+			License: strings.Join(licenses, " AND "),
+		}
+		for _, p := range patterns {
+			annotation.regexes = append(annotation.regexes, reuseTomlPatternRegex(p))
+		}
+		annotations = append(annotations, annotation)
+	}
+	return annotations, nil
+}
+
+// tomlStrings returns the value of a field which is either a string or an array of strings.
+func tomlStrings(v interface{}) []string {
+	switch value := v.(type) {
+	case string:
+		return []string{value}
+	case []interface{}:
+		var s []string
+		for _, e := range value {
+			if str, ok := e.(string); ok {
+				s = append(s, str)
+			}
+		}
+		return s
+	default:
+		return nil
+	}
+}
+
+// dep5PatternRegex converts a pattern of a Files field, where `*` matches
+// any characters, including slashes, and `?` matches a single character.
+func dep5PatternRegex(pattern string) *regexp.Regexp {
+	var sb strings.Builder
+	sb.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '*':
+			sb.WriteString(".*")
+		case '?':
+			sb.WriteString(".")
+		default:
+			sb.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		}
+	}
+	sb.WriteString("$")
+	return regexp.MustCompile(sb.String())
+}
+
+// reuseTomlPatternRegex converts a path of a REUSE.toml annotation, where `*`
+// matches any characters but slashes, and `**` matches any characters.
+func reuseTomlPatternRegex(pattern string) *regexp.Regexp {
+	var sb strings.Builder
+	sb.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		switch {
+		case pattern[i] == '\\' && i+1 < len(pattern):
+			i++
+			sb.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		case strings.HasPrefix(pattern[i:], "**"):
+			sb.WriteString(".*")
+			i++
+		case pattern[i] == '*':
+			sb.WriteString("[^/]*")
+		default:
+			sb.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		}
+	}
+	sb.WriteString("$")
+	return regexp.MustCompile(sb.String())
+}
+
+// Matches returns true if the annotation covers the file at pathfn.
+func (a *REUSEAnnotation) Matches(pathfn string) bool {
+	for _, r := range a.regexes {
+		if r.MatchString(pathfn) {
+			return true
+		}
+	}
+	return false
+}
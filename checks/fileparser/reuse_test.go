@@ -0,0 +1,122 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestParseREUSEDep5(t *testing.T) {
+	t.Parallel()
+
+	content := `Format: https://www.debian.org/doc/packaging-manuals/copyright-format/1.0/
+Upstream-Name: scorecard
+Source: https://github.com/ossf/scorecard
+
+Files: docs/*
+ images/*.png
+Copyright: 2023 OpenSSF Scorecard Authors
+License: CC-BY-4.0
+
+Files: third_party/lib/?.c
+Copyright: 2020 Someone
+License: MIT OR Apache-2.0
+ Some license text.
+
+Files: missing/license/*
+Copyright: 2020 Someone
+`
+	annotations := ParseREUSEDep5([]byte(content))
+	want := []REUSEAnnotation{
+		{Patterns: []string{"docs/*", "images/*.png"}, License: "CC-BY-4.0", Line: 5},
+		{Patterns: []string{"third_party/lib/?.c"}, License: "MIT OR Apache-2.0", Line: 10},
+	}
+	if diff := cmp.Diff(want, annotations, cmpopts.IgnoreUnexported(REUSEAnnotation{})); diff != "" {
+		t.Fatalf("ParseREUSEDep5() mismatch (-want +got):\n%s", diff)
+	}
+
+	tests := []struct {
+		path  string
+		index int
+		want  bool
+	}{
+		{path: "docs/index.md", index: 0, want: true},
+		{path: "docs/api/index.md", index: 0, want: true},
+		{path: "images/logo.png", index: 0, want: true},
+		{path: "images/logo.svg", index: 0, want: false},
+		{path: "third_party/lib/a.c", index: 1, want: true},
+		{path: "third_party/lib/ab.c", index: 1, want: false},
+	}
+	for _, tt := range tests {
+		if got := annotations[tt.index].Matches(tt.path); got != tt.want {
+			t.Errorf("annotation %d Matches(%q) = %v, want %v", tt.index, tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestParseREUSEToml(t *testing.T) {
+	t.Parallel()
+
+	content := `version = 1
+
+[[annotations]]
+path = ["docs/*.md", "images/**"]
+precedence = "aggregate"
+SPDX-FileCopyrightText = "2023 OpenSSF Scorecard Authors"
+SPDX-License-Identifier = "CC-BY-4.0"
+
+[[annotations]]
+path = "src/**/*.c"
+SPDX-License-Identifier = ["MIT", "Apache-2.0"]
+
+[[annotations]]
+path = "no-license/**"
+`
+	annotations, err := ParseREUSEToml([]byte(content))
+	if err != nil {
+		t.Fatalf("ParseREUSEToml: %v", err)
+	}
+	want := []REUSEAnnotation{
+		{Patterns: []string{"docs/*.md", "images/**"}, License: "CC-BY-4.0"},
+		{Patterns: []string{"src/**/*.c"}, License: "MIT AND Apache-2.0"},
+	}
+	if diff := cmp.Diff(want, annotations, cmpopts.IgnoreUnexported(REUSEAnnotation{})); diff != "" {
+		t.Fatalf("ParseREUSEToml() mismatch (-want +got):\n%s", diff)
+	}
+
+	tests := []struct {
+		path  string
+		index int
+		want  bool
+	}{
+		{path: "docs/index.md", index: 0, want: true},
+		{path: "docs/api/index.md", index: 0, want: false},
+		{path: "images/icons/logo.png", index: 0, want: true},
+		{path: "src/lib/a.c", index: 1, want: true},
+		{path: "src/a.h", index: 1, want: false},
+	}
+	for _, tt := range tests {
+		if got := annotations[tt.index].Matches(tt.path); got != tt.want {
+			t.Errorf("annotation %d Matches(%q) = %v, want %v", tt.index, tt.path, got, tt.want)
+		}
+	}
+
+	if _, err := ParseREUSEToml([]byte("[[annotations]\n")); err == nil {
+		t.Errorf("ParseREUSEToml: expected an error for an invalid file")
+	}
+}
@@ -0,0 +1,227 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var errInvalidSPDXExpression = errors.New("invalid SPDX license expression")
+
+// spdxIDRegex matches the license and exception identifiers, including
+// the `LicenseRef-` and `DocumentRef-...:LicenseRef-` references.
+var spdxIDRegex = regexp.MustCompile(`^(?:DocumentRef-[A-Za-z0-9.\-]+:)?[A-Za-z0-9.\-]+$`)
+
+// SPDX license expression operators.
+const (
+	SPDXOperatorAnd = "AND"
+	SPDXOperatorOr  = "OR"
+)
+
+// SPDXExpression is a parsed SPDX license expression, see
+// https://spdx.github.io/spdx-spec/v2.3/SPDX-license-expressions/.
+// It is either a compound expression, with an Op and its Left and Right
+// operands, or a single license with an optional exception.
+type SPDXExpression struct {
+	Left, Right *SPDXExpression
+	// Op is SPDXOperatorAnd or SPDXOperatorOr, and empty for a single license.
+	Op string
+	// License is the license identifier, e.g., `Apache-2.0` or `LicenseRef-Proprietary`.
+	License string
+	// Exception is the identifier following `WITH`, e.g., `Classpath-exception-2.0`.
+	Exception string
+	// OrLater is true for the `+` suffix, e.g., `GPL-2.0+`.
+	OrLater bool
+}
+
+// ParseSPDXExpression parses an SPDX license expression. The operators are
+// recognized in either upper or lower case, and AND binds tighter than OR.
+func ParseSPDXExpression(expression string) (*SPDXExpression, error) {
+	p := spdxParser{tokens: tokenizeSPDXExpression(expression)}
+	if len(p.tokens) == 0 {
+		return nil, fmt.Errorf("%w: empty expression", errInvalidSPDXExpression)
+	}
+	e, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos != len(p.tokens) {
+		return nil, fmt.Errorf("%w: unexpected %q", errInvalidSPDXExpression, p.tokens[p.pos])
+	}
+	return e, nil
+}
+
+// Licenses returns the license identifiers of the expression, in order.
+func (e *SPDXExpression) Licenses() []string {
+	if e.Op == "" {
+		return []string{e.License}
+	}
+	return append(e.Left.Licenses(), e.Right.Licenses()...)
+}
+
+// SatisfiedBy returns true if the licenses for which allowed returns true
+// are sufficient to comply with the expression: all the operands of an AND,
+// and one of the operands of an OR.
+func (e *SPDXExpression) SatisfiedBy(allowed func(license string) bool) bool {
+	switch e.Op {
+	case SPDXOperatorAnd:
+		return e.Left.SatisfiedBy(allowed) && e.Right.SatisfiedBy(allowed)
+	case SPDXOperatorOr:
+		return e.Left.SatisfiedBy(allowed) || e.Right.SatisfiedBy(allowed)
+	default:
+		return allowed(e.License)
+	}
+}
+
+// String returns the expression in its canonical form, with upper case
+// operators and the parentheses needed to preserve its meaning.
+func (e *SPDXExpression) String() string {
+	if e.Op == "" {
+		s := e.License
+		if e.OrLater {
+			s += "+"
+		}
+		if e.Exception != "" {
+			s += " WITH " + e.Exception
+		}
+		return s
+	}
+	return e.operandString(e.Left) + " " + e.Op + " " + e.operandString(e.Right)
+}
+
+func (e *SPDXExpression) operandString(operand *SPDXExpression) string {
+	if e.Op == SPDXOperatorAnd && operand.Op == SPDXOperatorOr {
+		return "(" + operand.String() + ")"
+	}
+	return operand.String()
+}
+
+// NormalizeSPDXLicenseID strips the `-only` and `-or-later` suffixes of
+// an SPDX license identifier, which are not part of the identifiers of
+// the deprecated license list, e.g., `GPL-2.0-or-later` becomes `GPL-2.0`.
+func NormalizeSPDXLicenseID(id string) string {
+	id = strings.TrimSuffix(id, "+")
+	for _, suffix := range []string{"-only", "-or-later"} {
+		if len(id) > len(suffix) && strings.EqualFold(id[len(id)-len(suffix):], suffix) {
+			return id[:len(id)-len(suffix)]
+		}
+	}
+	return id
+}
+
+func tokenizeSPDXExpression(expression string) []string {
+	expression = strings.NewReplacer("(", " ( ", ")", " ) ").Replace(expression)
+	return strings.Fields(expression)
+}
+
+type spdxParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *spdxParser) peekOperator(op string) bool {
+	return p.pos < len(p.tokens) &&
+		(p.tokens[p.pos] == op || p.tokens[p.pos] == strings.ToLower(op))
+}
+
+// parseOr parses `and-expression [OR and-expression]...`.
+func (p *spdxParser) parseOr() (*SPDXExpression, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekOperator(SPDXOperatorOr) {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &SPDXExpression{Op: SPDXOperatorOr, Left: left, Right: right}
+	}
+	return left, nil
+}
+
+// parseAnd parses `simple-expression [AND simple-expression]...`.
+func (p *spdxParser) parseAnd() (*SPDXExpression, error) {
+	left, err := p.parseSimple()
+	if err != nil {
+		return nil, err
+	}
+	for p.peekOperator(SPDXOperatorAnd) {
+		p.pos++
+		right, err := p.parseSimple()
+		if err != nil {
+			return nil, err
+		}
+		left = &SPDXExpression{Op: SPDXOperatorAnd, Left: left, Right: right}
+	}
+	return left, nil
+}
+
+// parseSimple parses a parenthesized expression, or
+// a license with an optional `+` and `WITH exception`.
+func (p *spdxParser) parseSimple() (*SPDXExpression, error) {
+	if p.pos == len(p.tokens) {
+		return nil, fmt.Errorf("%w: unexpected end of expression", errInvalidSPDXExpression)
+	}
+	token := p.tokens[p.pos]
+	p.pos++
+	if token == "(" {
+		e, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.pos == len(p.tokens) || p.tokens[p.pos] != ")" {
+			return nil, fmt.Errorf("%w: missing closing parenthesis", errInvalidSPDXExpression)
+		}
+		p.pos++
+		return e, nil
+	}
+
+	e := &SPDXExpression{}
+	if strings.HasSuffix(token, "+") {
+		e.OrLater = true
+		token = strings.TrimSuffix(token, "+")
+	}
+	if !isSPDXID(token) {
+		return nil, fmt.Errorf("%w: unexpected %q", errInvalidSPDXExpression, p.tokens[p.pos-1])
+	}
+	e.License = token
+
+	if p.peekOperator("WITH") {
+		p.pos++
+		if p.pos == len(p.tokens) || !isSPDXID(p.tokens[p.pos]) {
+			return nil, fmt.Errorf("%w: missing exception after WITH", errInvalidSPDXExpression)
+		}
+		e.Exception = p.tokens[p.pos]
+		p.pos++
+	}
+	return e, nil
+}
+
+func isSPDXID(token string) bool {
+	if !spdxIDRegex.MatchString(token) {
+		return false
+	}
+	for _, op := range []string{SPDXOperatorAnd, SPDXOperatorOr, "WITH"} {
+		if token == op || token == strings.ToLower(op) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,153 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseSPDXExpression(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		expression string
+		want       string
+		licenses   []string
+		err        error
+	}{
+		{
+			name:       "single license",
+			expression: "Apache-2.0",
+			want:       "Apache-2.0",
+			licenses:   []string{"Apache-2.0"},
+		},
+		{
+			name:       "or later",
+			expression: "GPL-2.0+",
+			want:       "GPL-2.0+",
+			licenses:   []string{"GPL-2.0"},
+		},
+		{
+			name:       "AND binds tighter than OR",
+			expression: "MIT OR Apache-2.0 AND BSD-3-Clause",
+			want:       "MIT OR Apache-2.0 AND BSD-3-Clause",
+			licenses:   []string{"MIT", "Apache-2.0", "BSD-3-Clause"},
+		},
+		{
+			name:       "parentheses",
+			expression: "(MIT OR Apache-2.0) AND BSD-3-Clause",
+			want:       "(MIT OR Apache-2.0) AND BSD-3-Clause",
+			licenses:   []string{"MIT", "Apache-2.0", "BSD-3-Clause"},
+		},
+		{
+			name:       "lower case operators and exception",
+			expression: "gpl-2.0-only with Classpath-exception-2.0 or MIT",
+			want:       "gpl-2.0-only WITH Classpath-exception-2.0 OR MIT",
+			licenses:   []string{"gpl-2.0-only", "MIT"},
+		},
+		{
+			name:       "license references",
+			expression: "LicenseRef-Proprietary OR DocumentRef-spdx-tool:LicenseRef-MIT-Style",
+			want:       "LicenseRef-Proprietary OR DocumentRef-spdx-tool:LicenseRef-MIT-Style",
+			licenses:   []string{"LicenseRef-Proprietary", "DocumentRef-spdx-tool:LicenseRef-MIT-Style"},
+		},
+		{
+			name:       "empty",
+			expression: " ",
+			err:        errInvalidSPDXExpression,
+		},
+		{
+			name:       "missing operand",
+			expression: "MIT AND",
+			err:        errInvalidSPDXExpression,
+		},
+		{
+			name:       "missing parenthesis",
+			expression: "(MIT OR Apache-2.0",
+			err:        errInvalidSPDXExpression,
+		},
+		{
+			name:       "mixed case operator",
+			expression: "MIT Or Apache-2.0",
+			err:        errInvalidSPDXExpression,
+		},
+		{
+			name:       "missing exception",
+			expression: "GPL-2.0-only WITH",
+			err:        errInvalidSPDXExpression,
+		},
+		{
+			name:       "invalid character",
+			expression: "MIT/X11",
+			err:        errInvalidSPDXExpression,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			e, err := ParseSPDXExpression(tt.expression)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("ParseSPDXExpression() error = %v, want %v", err, tt.err)
+			}
+			if err != nil {
+				return
+			}
+			if got := e.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+			if diff := cmp.Diff(tt.licenses, e.Licenses()); diff != "" {
+				t.Errorf("Licenses() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSPDXExpressionSatisfiedBy(t *testing.T) {
+	t.Parallel()
+
+	allowed := func(license string) bool {
+		return NormalizeSPDXLicenseID(license) == "Apache-2.0" || NormalizeSPDXLicenseID(license) == "GPL-2.0"
+	}
+	tests := []struct {
+		expression string
+		want       bool
+	}{
+		{expression: "Apache-2.0", want: true},
+		{expression: "MIT", want: false},
+		{expression: "MIT OR Apache-2.0", want: true},
+		{expression: "MIT AND Apache-2.0", want: false},
+		{expression: "(MIT OR Apache-2.0) AND GPL-2.0-or-later", want: true},
+		{expression: "GPL-2.0-only WITH Classpath-exception-2.0", want: true},
+		{expression: "GPL-2.0+", want: true},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.expression, func(t *testing.T) {
+			t.Parallel()
+			e, err := ParseSPDXExpression(tt.expression)
+			if err != nil {
+				t.Fatalf("ParseSPDXExpression: %v", err)
+			}
+			if got := e.SatisfiedBy(allowed); got != tt.want {
+				t.Errorf("SatisfiedBy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fileparser
+
+import (
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestYAMLMappingValue(t *testing.T) {
+	t.Parallel()
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte("image: alpine\nports: [80]\n"), &doc); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	root := doc.Content[0]
+	//nolint
+	tests := []struct {
+		name string
+		node *yaml.Node
+		key  string
+		want string
+		nil  bool
+	}{
+		{name: "scalar value", node: root, key: "image", want: "alpine"},
+		{name: "missing key", node: root, key: "command", nil: true},
+		{name: "not a mapping", node: YAMLMappingValue(root, "ports"), key: "image", nil: true},
+		{name: "nil node", node: nil, key: "image", nil: true},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := YAMLMappingValue(tt.node, tt.key)
+			if tt.nil {
+				if got != nil {
+					t.Errorf("expected nil, got %v", got.Value)
+				}
+				return
+			}
+			if got == nil || got.Value != tt.want {
+				t.Errorf("expected %q, got %v", tt.want, got)
+			}
+		})
+	}
+}
@@ -41,10 +41,11 @@ func TestLicenseFileSubdirectory(t *testing.T) {
 			name:        "With LICENSE",
 			inputFolder: "testdata/licensedir/withlicense",
 			expected: scut.TestReturn{
-				Error:        nil,
-				Score:        9, // Does not have approved format
-				NumberOfInfo: 1,
-				NumberOfWarn: 1,
+				Error:         nil,
+				Score:         9, // Does not have approved format
+				NumberOfInfo:  1,
+				NumberOfWarn:  1,
+				NumberOfDebug: 3,
 			},
 			err: nil,
 		},
@@ -52,10 +53,26 @@ func TestLicenseFileSubdirectory(t *testing.T) {
 			name:        "Without LICENSE",
 			inputFolder: "testdata/licensedir/withoutlicense",
 			expected: scut.TestReturn{
-				Error:        nil,
-				Score:        checker.MinResultScore,
-				NumberOfWarn: 0,
-				NumberOfInfo: 2,
+				Error:         nil,
+				Score:         checker.MinResultScore,
+				NumberOfWarn:  0,
+				NumberOfInfo:  2,
+				NumberOfDebug: 3,
+			},
+			err: nil,
+		},
+		{
+			name:        "With REUSE license declarations",
+			inputFolder: "testdata/licensedir/withreuse",
+			expected: scut.TestReturn{
+				Error: nil,
+				Score: checker.MaxResultScore,
+				// license file location and FSF or OSI license,
+				// and valid license expressions.
+				NumberOfInfo: 3,
+				// a source file without a license declaration,
+				// and a conflicting license declaration.
+				NumberOfWarn: 2,
 			},
 			err: nil,
 		},
@@ -368,6 +368,7 @@ func Test_Maintained(t *testing.T) {
 
 							return tt.createdat, nil
 						})
+						mockRepo.EXPECT().ListPullRequests().Return(nil, nil)
 					}
 				}
 			}
@@ -17,11 +17,13 @@ package checks
 import (
 	"github.com/ossf/scorecard/v4/checker"
 	"github.com/ossf/scorecard/v4/checks/evaluation"
+	"github.com/ossf/scorecard/v4/checks/raw"
 	"github.com/ossf/scorecard/v4/checks/raw/github"
 	"github.com/ossf/scorecard/v4/checks/raw/gitlab"
 	"github.com/ossf/scorecard/v4/clients/githubrepo"
 	"github.com/ossf/scorecard/v4/clients/gitlabrepo"
 	sce "github.com/ossf/scorecard/v4/errors"
+	pmc "github.com/ossf/scorecard/v4/internal/packagemanager"
 	"github.com/ossf/scorecard/v4/probes"
 	"github.com/ossf/scorecard/v4/probes/zrunner"
 )
@@ -29,6 +31,10 @@ import (
 // CheckPackaging is the registered name for Packaging.
 const CheckPackaging = "Packaging"
 
+// packageManagerClient queries the registries the packages are published to.
+// Tests replace it to avoid network calls.
+var packageManagerClient pmc.Client = &pmc.PackageManagerClient{}
+
 //nolint:gochecknoinits
 func init() {
 	if err := registerCheck(CheckPackaging, Packaging, nil); err != nil {
@@ -51,6 +57,9 @@ func Packaging(c *checker.CheckRequest) checker.CheckResult {
 		_ = v
 	}
 
+	if err == nil {
+		err = raw.VerifyPublicationTargets(c, &rawData, packageManagerClient)
+	}
 	if err != nil {
 		e := sce.WithMessage(sce.ErrScorecardInternal, err.Error())
 		return checker.CreateRuntimeErrorResult(CheckPackaging, e)
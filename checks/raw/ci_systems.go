@@ -0,0 +1,314 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/checks/fileparser"
+	"github.com/ossf/scorecard/v4/clients"
+	"github.com/ossf/scorecard/v4/finding"
+)
+
+// ciSystem recognizes the jobs reported by a CI system and its configuration files.
+// Add a CI system by adding an entry to ciSystems.
+type ciSystem struct {
+	// contexts matches the contexts of the commit statuses of the CI system.
+	// The matched part is removed from the context to find the name of the job.
+	contexts *regexp.Regexp
+	// targetURLs matches the links of the commit statuses to the CI system.
+	targetURLs *regexp.Regexp
+	// configFiles matches the paths of the configuration files of the CI system.
+	configFiles *regexp.Regexp
+	name        checker.CISystem
+	// apps are the slugs of the apps reporting the check runs of the CI system.
+	apps []string
+}
+
+var ciSystems = []ciSystem{
+	{
+		name:        checker.CIGitHubActions,
+		apps:        []string{"github-actions"},
+		targetURLs:  regexp.MustCompile(`github\.com/[^/]+/[^/]+/actions/runs/`),
+		configFiles: regexp.MustCompile(`^\.github/workflows/[^/]+\.ya?ml$`),
+	},
+	{
+		name:        checker.CIGitLab,
+		apps:        []string{"gitlab-ci"},
+		contexts:    regexp.MustCompile(`^ci/gitlab/\S*\s*`),
+		targetURLs:  regexp.MustCompile(`/-/(?:pipelines|jobs)/\d+`),
+		configFiles: regexp.MustCompile(`^\.gitlab-ci\.ya?ml$`),
+	},
+	{
+		name:        checker.CIJenkins,
+		contexts:    regexp.MustCompile(`jenkins`),
+		targetURLs:  regexp.MustCompile(`/job/[^/]+/`),
+		configFiles: regexp.MustCompile(`(?:^|/)Jenkinsfile$`),
+	},
+	{
+		name:        checker.CIBuildkite,
+		apps:        []string{"buildkite"},
+		contexts:    regexp.MustCompile(`^buildkite/`),
+		targetURLs:  regexp.MustCompile(`buildkite\.com/`),
+		configFiles: regexp.MustCompile(`^\.buildkite/pipeline[^/]*\.ya?ml$`),
+	},
+	{
+		name:        checker.CICircleCI,
+		apps:        []string{"circleci-checks"},
+		contexts:    regexp.MustCompile(`^ci/circleci:?\s*`),
+		targetURLs:  regexp.MustCompile(`circleci\.com/`),
+		configFiles: regexp.MustCompile(`^\.circleci/config\.ya?ml$`),
+	},
+	{
+		name:        checker.CITravis,
+		apps:        []string{"travis-ci"},
+		contexts:    regexp.MustCompile(`travis-ci`),
+		targetURLs:  regexp.MustCompile(`travis-ci\.(?:com|org)/`),
+		configFiles: regexp.MustCompile(`^\.travis\.ya?ml$`),
+	},
+	{
+		name:        checker.CIAzurePipelines,
+		apps:        []string{"azure-pipelines"},
+		contexts:    regexp.MustCompile(`azure-pipelines`),
+		targetURLs:  regexp.MustCompile(`dev\.azure\.com/|\.visualstudio\.com/`),
+		configFiles: regexp.MustCompile(`(?:^|/)azure-pipelines[^/]*\.ya?ml$`),
+	},
+	{
+		name:        checker.CIAppVeyor,
+		apps:        []string{"appveyor"},
+		contexts:    regexp.MustCompile(`appveyor`),
+		targetURLs:  regexp.MustCompile(`ci\.appveyor\.com/`),
+		configFiles: regexp.MustCompile(`^\.?appveyor\.ya?ml$`),
+	},
+	{
+		name:        checker.CICirrus,
+		apps:        []string{"cirrus-ci"},
+		contexts:    regexp.MustCompile(`cirrus ci`),
+		targetURLs:  regexp.MustCompile(`cirrus-ci\.com/`),
+		configFiles: regexp.MustCompile(`^\.cirrus\.(?:ya?ml|star)$`),
+	},
+	{
+		name:        checker.CISemaphore,
+		apps:        []string{"semaphoreci"},
+		contexts:    regexp.MustCompile(`semaphoreci`),
+		targetURLs:  regexp.MustCompile(`semaphoreci\.com/`),
+		configFiles: regexp.MustCompile(`^\.semaphore/[^/]+\.ya?ml$`),
+	},
+	{
+		name:        checker.CIDrone,
+		contexts:    regexp.MustCompile(`continuous-integration/drone`),
+		configFiles: regexp.MustCompile(`^\.drone\.(?:ya?ml|jsonnet|star)$`),
+	},
+	{
+		name:     checker.CIPackit,
+		apps:     []string{"packit-as-a-service"},
+		contexts: regexp.MustCompile(`packit`),
+	},
+	{
+		name:        checker.CIProw,
+		targetURLs:  regexp.MustCompile(`prow\.[^/]+/view/`),
+		configFiles: regexp.MustCompile(`^\.prow(?:\.ya?ml|/)`),
+	},
+	{
+		// Apps and checks which have always been accepted as CI tests.
+		name:     checker.CIOther,
+		apps:     []string{"mergeable", "flutter-dashboard"},
+		contexts: regexp.MustCompile(`mergeable|flutter-dashboard`),
+	},
+}
+
+// genericCIContextRegex matches the contexts which do not name the CI system,
+// e.g. `continuous-integration` or `ci/build`.
+var genericCIContextRegex = regexp.MustCompile(`^(?:continuous-integration|ci|build|default|pipeline|pr-head|pr-merge)(?:/|$)`)
+
+// The kinds of the jobs are inferred from the words of their names, in this order:
+// e.g. `build-and-test` runs tests.
+var (
+	ciTestJobRegex = regexp.MustCompile(
+		`\b(?:tests?|testing|unit-?tests?|e2e|specs?|integration|unit|coverage|pytest|jest|ctest|tox|nox|smoke|` +
+			`conformance|regression)\b`)
+	ciLintJobRegex = regexp.MustCompile(
+		`\b(?:\w*lint\w*|fmt|gofmt|format|formatting|style|vet|spell|spellcheck|typos|prettier|rubocop|flake8|` +
+			`clippy|pre-commit|dco|cla|license|licenses|codeql|sonar\w*|analy[sz]e|analysis)\b`)
+	ciBuildJobRegex = regexp.MustCompile(
+		`\b(?:build|builds|compile|package|packaging|docker|image|images|publish|release|deploy|docs?|pages|` +
+			`preview|artifacts?)\b`)
+)
+
+// ciTestCommandRegex matches the test jobs and commands of a CI configuration.
+var ciTestCommandRegex = regexp.MustCompile(
+	`(?i)\b(?:tests?|e2e|pytest|tox|nox|jest|mocha|rspec|phpunit|ctest|nextest)\b|` +
+		`\bmake\s+check\b|\bmvnw?\b[^\n]*\b(?:verify|install|package)\b|\bgradlew?\b[^\n]*\b(?:build|check)\b`)
+
+func ciJobKind(name string) checker.CIJobKind {
+	// `continuous-integration` names the CI, not an integration test.
+	name = strings.ReplaceAll(strings.ToLower(name), "continuous-integration", "")
+	// `_` is a word character: `test_linux` has the word `test`.
+	name = strings.ReplaceAll(name, "_", "-")
+	switch {
+	case ciTestJobRegex.MatchString(name):
+		return checker.CIJobTest
+	case ciLintJobRegex.MatchString(name):
+		return checker.CIJobLint
+	case ciBuildJobRegex.MatchString(name):
+		return checker.CIJobBuild
+	default:
+		return checker.CIJobUnknown
+	}
+}
+
+func ciSystemForApp(slug string) *ciSystem {
+	for i := range ciSystems {
+		for _, app := range ciSystems[i].apps {
+			if strings.EqualFold(app, slug) {
+				return &ciSystems[i]
+			}
+		}
+	}
+	return nil
+}
+
+// ciSystemForStatus returns the CI system reporting the status,
+// and the name of the job without the name of the CI system.
+func ciSystemForStatus(status *clients.Status) (*ciSystem, string) {
+	context := strings.ToLower(status.Context)
+	for i := range ciSystems {
+		system := &ciSystems[i]
+		if system.contexts != nil && system.contexts.MatchString(context) {
+			return system, system.contexts.ReplaceAllString(context, "")
+		}
+	}
+	for i := range ciSystems {
+		system := &ciSystems[i]
+		if system.targetURLs != nil &&
+			(system.targetURLs.MatchString(status.TargetURL) || system.targetURLs.MatchString(status.URL)) {
+			return system, context
+		}
+	}
+	return nil, context
+}
+
+// ciJobs recognizes the CI jobs in the check runs and statuses of a commit.
+// The statuses with a generic context are attributed to fallback, the only CI
+// system configured in the repository apart from GitHub Actions, if any.
+func ciJobs(crs []clients.CheckRun, statuses []clients.Status, fallback *ciSystem) []checker.CIJob {
+	jobs := []checker.CIJob{}
+	for i := range crs {
+		cr := &crs[i]
+		name := cr.Name
+		system := ciSystemForApp(cr.App.Slug)
+		if system == nil {
+			// Keep the apps which have always been accepted as CI tests, e.g. `e2e`.
+			if ciJobKind(cr.App.Slug) != checker.CIJobTest && ciJobKind(name) != checker.CIJobTest {
+				continue
+			}
+			system = &ciSystem{name: checker.CIOther}
+			if name == "" {
+				name = cr.App.Slug
+			}
+		}
+		jobs = append(jobs, checker.CIJob{
+			System:  system.name,
+			Name:    name,
+			URL:     cr.URL,
+			Kind:    ciJobKind(name),
+			Success: cr.Status == "completed" && cr.Conclusion == "success",
+		})
+	}
+	for i := range statuses {
+		status := &statuses[i]
+		system, name := ciSystemForStatus(status)
+		if system == nil {
+			switch {
+			case fallback != nil && genericCIContextRegex.MatchString(name):
+				system = fallback
+			case ciJobKind(name) == checker.CIJobTest:
+				system = &ciSystem{name: checker.CIOther}
+			default:
+				continue
+			}
+		}
+		jobs = append(jobs, checker.CIJob{
+			System:  system.name,
+			Name:    status.Context,
+			URL:     status.URL,
+			Kind:    ciJobKind(name),
+			Success: status.State == "success",
+		})
+	}
+	return jobs
+}
+
+// ciFallbackSystem returns the only CI system configured in the repository
+// apart from GitHub Actions, which reports its jobs as check runs.
+func ciFallbackSystem(configs []checker.CIConfig) *ciSystem {
+	var fallback *ciSystem
+	for i := range configs {
+		if configs[i].System == checker.CIGitHubActions {
+			continue
+		}
+		system := ciSystemByName(configs[i].System)
+		if fallback != nil && fallback != system {
+			return nil
+		}
+		fallback = system
+	}
+	return fallback
+}
+
+func ciSystemByName(name checker.CISystem) *ciSystem {
+	for i := range ciSystems {
+		if ciSystems[i].name == name {
+			return &ciSystems[i]
+		}
+	}
+	return nil
+}
+
+// Check file content.
+var validateCIConfig fileparser.DoWhileTrueOnFileContent = func(pathfn string,
+	content []byte,
+	args ...interface{},
+) (bool, error) {
+	if len(args) != 1 {
+		return false, fmt.Errorf(
+			"validateCIConfig requires exactly 1 arguments: %w", errInvalidArgLength)
+	}
+	configs, ok := args[0].(*[]checker.CIConfig)
+	if !ok {
+		return false, fmt.Errorf(
+			"validateCIConfig expects arg[0] of type *[]checker.CIConfig: %w", errInvalidArgType)
+	}
+	for i := range ciSystems {
+		system := &ciSystems[i]
+		if system.configFiles == nil || !system.configFiles.MatchString(pathfn) {
+			continue
+		}
+		*configs = append(*configs, checker.CIConfig{
+			System: system.name,
+			File: checker.File{
+				Path:   pathfn,
+				Type:   finding.FileTypeSource,
+				Offset: checker.OffsetDefault,
+			},
+			RunsTests: ciTestCommandRegex.Match(content),
+		})
+		break
+	}
+	return true, nil
+}
@@ -0,0 +1,337 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/clients"
+)
+
+func TestCIJobsCheckRuns(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		slug   string
+		system checker.CISystem
+		kind   checker.CIJobKind
+	}{
+		{name: "appveyor", slug: "appveyor", system: checker.CIAppVeyor, kind: checker.CIJobUnknown},
+		{name: "circleci", slug: "circleci-checks", system: checker.CICircleCI, kind: checker.CIJobUnknown},
+		{name: "e2e", slug: "e2e", system: checker.CIOther, kind: checker.CIJobTest},
+		{name: "github-actions", slug: "github-actions", system: checker.CIGitHubActions, kind: checker.CIJobUnknown},
+		{name: "gitlab-ci", slug: "gitlab-ci", system: checker.CIGitLab, kind: checker.CIJobUnknown},
+		{name: "mergeable", slug: "mergeable", system: checker.CIOther, kind: checker.CIJobUnknown},
+		{name: "packit-as-a-service", slug: "packit-as-a-service", system: checker.CIPackit, kind: checker.CIJobUnknown},
+		{name: "semaphoreci", slug: "semaphoreci", system: checker.CISemaphore, kind: checker.CIJobUnknown},
+		{name: "test", slug: "test", system: checker.CIOther, kind: checker.CIJobTest},
+		{name: "travis-ci", slug: "travis-ci", system: checker.CITravis, kind: checker.CIJobUnknown},
+		{name: "azure-pipelines", slug: "azure-pipelines", system: checker.CIAzurePipelines, kind: checker.CIJobUnknown},
+		{name: "non-existing", slug: "non-existing"},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			crs := []clients.CheckRun{
+				{Status: "completed", Conclusion: "success", URL: "url", App: clients.CheckRunApp{Slug: tt.slug}},
+			}
+			jobs := ciJobs(crs, nil, nil)
+			if tt.system == "" {
+				if len(jobs) != 0 {
+					t.Errorf("unexpected jobs: %v", jobs)
+				}
+				return
+			}
+			if len(jobs) != 1 {
+				t.Fatalf("expected 1 job, got %v", jobs)
+			}
+			if jobs[0].System != tt.system || jobs[0].Kind != tt.kind || !jobs[0].Success {
+				t.Errorf("got %v, want system %s and kind %s", jobs[0], tt.system, tt.kind)
+			}
+		})
+	}
+}
+
+func TestCIJobs(t *testing.T) {
+	t.Parallel()
+	//nolint:govet
+	tests := []struct {
+		name      string
+		checkRuns []clients.CheckRun
+		statuses  []clients.Status
+		fallback  checker.CISystem
+		want      []checker.CIJob
+	}{
+		{
+			name: "no checks",
+			want: []checker.CIJob{},
+		},
+		{
+			name: "check runs not completed or not successful",
+			checkRuns: []clients.CheckRun{
+				{Status: "notcompleted", Conclusion: "success", App: clients.CheckRunApp{Slug: "github-actions"}},
+				{Status: "completed", Conclusion: "failure", App: clients.CheckRunApp{Slug: "github-actions"}},
+			},
+			want: []checker.CIJob{
+				{System: checker.CIGitHubActions, Kind: checker.CIJobUnknown},
+				{System: checker.CIGitHubActions, Kind: checker.CIJobUnknown},
+			},
+		},
+		{
+			name: "GitLab merge request pipeline jobs",
+			checkRuns: []clients.CheckRun{
+				{Status: "completed", Conclusion: "success", Name: "unit-tests", URL: "u1", App: clients.CheckRunApp{Slug: "gitlab-ci"}},
+				{Status: "completed", Conclusion: "success", Name: "golangci-lint", URL: "u2", App: clients.CheckRunApp{Slug: "gitlab-ci"}},
+				{Status: "completed", Conclusion: "success", Name: "docker-image", URL: "u3", App: clients.CheckRunApp{Slug: "gitlab-ci"}},
+			},
+			want: []checker.CIJob{
+				{System: checker.CIGitLab, Name: "unit-tests", URL: "u1", Kind: checker.CIJobTest, Success: true},
+				{System: checker.CIGitLab, Name: "golangci-lint", URL: "u2", Kind: checker.CIJobLint, Success: true},
+				{System: checker.CIGitLab, Name: "docker-image", URL: "u3", Kind: checker.CIJobBuild, Success: true},
+			},
+		},
+		{
+			name: "statuses of known CI systems",
+			statuses: []clients.Status{
+				{State: "success", Context: "ci/circleci: lint"},
+				{State: "success", Context: "ci/circleci: test-linux"},
+				{State: "success", Context: "buildkite/my-pipeline"},
+				{State: "failure", Context: "continuous-integration/travis-ci/pr"},
+				{State: "success", Context: "continuous-integration/jenkins/pr-merge"},
+				{State: "success", Context: "Cirrus CI"},
+			},
+			want: []checker.CIJob{
+				{System: checker.CICircleCI, Name: "ci/circleci: lint", Kind: checker.CIJobLint, Success: true},
+				{System: checker.CICircleCI, Name: "ci/circleci: test-linux", Kind: checker.CIJobTest, Success: true},
+				{System: checker.CIBuildkite, Name: "buildkite/my-pipeline", Kind: checker.CIJobUnknown, Success: true},
+				{System: checker.CITravis, Name: "continuous-integration/travis-ci/pr", Kind: checker.CIJobUnknown},
+				{System: checker.CIJenkins, Name: "continuous-integration/jenkins/pr-merge", Kind: checker.CIJobUnknown, Success: true},
+				{System: checker.CICirrus, Name: "Cirrus CI", Kind: checker.CIJobUnknown, Success: true},
+			},
+		},
+		{
+			name: "statuses recognized by their target URL",
+			statuses: []clients.Status{
+				{State: "success", Context: "unit", TargetURL: "https://gitlab.com/foo/bar/-/jobs/42"},
+				{State: "success", Context: "pipeline", TargetURL: "https://buildkite.com/foo/bar/builds/1"},
+				{State: "success", Context: "deploy/netlify", TargetURL: "https://app.netlify.com/sites/foo"},
+			},
+			want: []checker.CIJob{
+				{System: checker.CIGitLab, Name: "unit", Kind: checker.CIJobTest, Success: true},
+				{System: checker.CIBuildkite, Name: "pipeline", Kind: checker.CIJobUnknown, Success: true},
+			},
+		},
+		{
+			name: "generic contexts without configured CI system",
+			statuses: []clients.Status{
+				{State: "success", Context: "continuous-integration"},
+				{State: "success", Context: "CI-Tests"},
+			},
+			want: []checker.CIJob{
+				{System: checker.CIOther, Name: "CI-Tests", Kind: checker.CIJobTest, Success: true},
+			},
+		},
+		{
+			name: "generic contexts attributed to the configured CI system",
+			statuses: []clients.Status{
+				{State: "success", Context: "continuous-integration"},
+				{State: "success", Context: "license/cla"},
+			},
+			fallback: checker.CIJenkins,
+			want: []checker.CIJob{
+				{System: checker.CIJenkins, Name: "continuous-integration", Kind: checker.CIJobUnknown, Success: true},
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var fallback *ciSystem
+			if tt.fallback != "" {
+				fallback = ciSystemByName(tt.fallback)
+			}
+			got := ciJobs(tt.checkRuns, tt.statuses, fallback)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCIFallbackSystem(t *testing.T) {
+	t.Parallel()
+	//nolint:govet
+	tests := []struct {
+		name    string
+		systems []checker.CISystem
+		want    checker.CISystem
+	}{
+		{
+			name: "no configuration",
+		},
+		{
+			name:    "GitHub Actions only",
+			systems: []checker.CISystem{checker.CIGitHubActions, checker.CIGitHubActions},
+		},
+		{
+			name:    "single CI system",
+			systems: []checker.CISystem{checker.CIGitHubActions, checker.CIJenkins, checker.CIJenkins},
+			want:    checker.CIJenkins,
+		},
+		{
+			name:    "several CI systems",
+			systems: []checker.CISystem{checker.CIJenkins, checker.CITravis},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var configs []checker.CIConfig
+			for _, system := range tt.systems {
+				configs = append(configs, checker.CIConfig{System: system})
+			}
+			got := ciFallbackSystem(configs)
+			if tt.want == "" {
+				if got != nil {
+					t.Errorf("unexpected fallback %s", got.name)
+				}
+				return
+			}
+			if got == nil || got.name != tt.want {
+				t.Errorf("got %v, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCIJobKind(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		want checker.CIJobKind
+	}{
+		{name: "build-and-test", want: checker.CIJobTest},
+		{name: "test_linux", want: checker.CIJobTest},
+		{name: "Unit Tests (ubuntu-latest)", want: checker.CIJobTest},
+		{name: "golangci-lint", want: checker.CIJobLint},
+		{name: "CI / build", want: checker.CIJobBuild},
+		{name: "build (ubuntu-latest)", want: checker.CIJobBuild},
+		{name: "latest", want: checker.CIJobUnknown},
+		{name: "continuous-integration/jenkins/pr-merge", want: checker.CIJobUnknown},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := ciJobKind(tt.name); got != tt.want {
+				t.Errorf("ciJobKind(%q) = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateCIConfig(t *testing.T) {
+	t.Parallel()
+	//nolint:govet
+	tests := []struct {
+		name      string
+		path      string
+		content   string
+		system    checker.CISystem
+		runsTests bool
+	}{
+		{
+			name:      "GitHub workflow running tests",
+			path:      ".github/workflows/ci.yml",
+			content:   "jobs:\n  build:\n    steps:\n      - run: go test ./...\n",
+			system:    checker.CIGitHubActions,
+			runsTests: true,
+		},
+		{
+			name:    "GitHub workflow running linters",
+			path:    ".github/workflows/lint.yaml",
+			content: "jobs:\n  lint:\n    steps:\n      - uses: actions/checkout@v4\n      - run: make lint\n",
+			system:  checker.CIGitHubActions,
+		},
+		{
+			name:      "GitLab CI with test stage",
+			path:      ".gitlab-ci.yml",
+			content:   "stages:\n  - build\n  - test\n",
+			system:    checker.CIGitLab,
+			runsTests: true,
+		},
+		{
+			name:      "Jenkinsfile running maven",
+			path:      "ci/Jenkinsfile",
+			content:   "pipeline {\n  stages {\n    stage('Build') {\n      steps { sh 'mvn -B verify' }\n    }\n  }\n}\n",
+			system:    checker.CIJenkins,
+			runsTests: true,
+		},
+		{
+			name:      "Buildkite pipeline",
+			path:      ".buildkite/pipeline.yml",
+			content:   "steps:\n  - command: make check\n",
+			system:    checker.CIBuildkite,
+			runsTests: true,
+		},
+		{
+			name:      "CircleCI config",
+			path:      ".circleci/config.yml",
+			content:   "jobs:\n  build:\n    steps:\n      - run: npm test\n",
+			system:    checker.CICircleCI,
+			runsTests: true,
+		},
+		{
+			name:      "Travis config",
+			path:      ".travis.yml",
+			content:   "language: python\nscript: tox\n",
+			system:    checker.CITravis,
+			runsTests: true,
+		},
+		{
+			name:    "not a CI configuration",
+			path:    "docs/ci.yml",
+			content: "test: true\n",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var configs []checker.CIConfig
+			if _, err := validateCIConfig(tt.path, []byte(tt.content), &configs); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.system == "" {
+				if len(configs) != 0 {
+					t.Errorf("unexpected configs: %v", configs)
+				}
+				return
+			}
+			if len(configs) != 1 {
+				t.Fatalf("expected 1 config, got %v", configs)
+			}
+			if configs[0].System != tt.system || configs[0].RunsTests != tt.runsTests || configs[0].File.Path != tt.path {
+				t.Errorf("got %v, want system %s and runsTests %t", configs[0], tt.system, tt.runsTests)
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"fmt"
 
 	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/checks/fileparser"
 	"github.com/ossf/scorecard/v4/clients"
 	sce "github.com/ossf/scorecard/v4/errors"
 )
@@ -69,6 +70,22 @@ func CITests(c clients.RepoClient) (checker.CITestData, error) {
 		commitStatuses[pr.HeadSHA] = append(commitStatuses[pr.HeadSHA], statuses...)
 	}
 
+	if len(runs) == 0 {
+		return checker.CITestData{CIInfo: []checker.RevisionCIInfo{}}, nil
+	}
+
+	// CI configuration files, to attribute the statuses with a generic context
+	// and to tell whether the CI systems run tests.
+	configs := []checker.CIConfig{}
+	err = fileparser.OnMatchingFileContentDo(c, fileparser.PathMatcher{
+		Pattern:       "*",
+		CaseSensitive: false,
+	}, validateCIConfig, &configs)
+	if err != nil {
+		return checker.CITestData{}, err
+	}
+	fallback := ciFallbackSystem(configs)
+
 	// Collate
 	infos := []checker.RevisionCIInfo{}
 	for headsha := range runs {
@@ -78,9 +95,10 @@ func CITests(c clients.RepoClient) (checker.CITestData, error) {
 			HeadSHA:           headsha,
 			CheckRuns:         crs,
 			Statuses:          statuses,
+			Jobs:              ciJobs(crs, statuses, fallback),
 			PullRequestNumber: prNos[headsha],
 		})
 	}
 
-	return checker.CITestData{CIInfo: infos}, nil
+	return checker.CITestData{CIInfo: infos, Configs: configs}, nil
 }
@@ -0,0 +1,194 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/checks/fileparser"
+	"github.com/ossf/scorecard/v4/clients"
+	"github.com/ossf/scorecard/v4/finding"
+)
+
+// codeownersPaths are the locations of the CODEOWNERS files, in the order
+// they are looked up: GitHub and GitLab only use the first one found.
+var codeownersPaths = []string{
+	".github/CODEOWNERS",
+	"CODEOWNERS",
+	"docs/CODEOWNERS",
+	".gitlab/CODEOWNERS",
+}
+
+// buildFiles are the build scripts and configurations at the root of the repository.
+var buildFiles = map[string]bool{
+	"build.gradle":        true,
+	"build.gradle.kts":    true,
+	"build.sbt":           true,
+	"build.sh":            true,
+	"BUILD":               true,
+	"BUILD.bazel":         true,
+	"Cargo.toml":          true,
+	"CMakeLists.txt":      true,
+	"Dockerfile":          true,
+	"GNUmakefile":         true,
+	"go.mod":              true,
+	"Makefile":            true,
+	"MODULE.bazel":        true,
+	"package.json":        true,
+	"pom.xml":             true,
+	"pyproject.toml":      true,
+	"Rakefile":            true,
+	"settings.gradle":     true,
+	"settings.gradle.kts": true,
+	"setup.cfg":           true,
+	"setup.py":            true,
+	"WORKSPACE":           true,
+}
+
+var releaseConfigRegex = regexp.MustCompile(
+	`^(?:\.goreleaser\.ya?ml|\.releaserc(?:\.\w+)?|release\.config\.c?js|` +
+		`release-please-config\.json|\.release-please-manifest\.json|\.github/release\.ya?ml)$`)
+
+// Codeowners retrieves the raw data for the Code-Owners check.
+func Codeowners(c clients.RepoClient) (checker.CodeownersData, error) {
+	files, err := c.ListFiles(func(string) (bool, error) { return true, nil })
+	if err != nil {
+		return checker.CodeownersData{}, fmt.Errorf("error listing files: %w", err)
+	}
+	data := checker.CodeownersData{NumFiles: len(files)}
+
+	codeownersPath := findCodeownersFile(files)
+	if codeownersPath == "" {
+		return data, nil
+	}
+	content, err := c.GetFileContent(codeownersPath)
+	if err != nil {
+		return checker.CodeownersData{}, fmt.Errorf("error reading %s: %w", codeownersPath, err)
+	}
+	data.File = &checker.File{
+		Path: codeownersPath,
+		Type: finding.FileTypeText,
+	}
+	co := fileparser.ParseCodeowners(content)
+
+	owners, err := codeownersAccess(c, codeownersPath, co)
+	if err != nil {
+		return checker.CodeownersData{}, err
+	}
+	data.Owners = owners
+	invalid := make(map[string]bool)
+	for i := range owners {
+		if owners[i].Access == clients.CodeOwnerAccessNotFound || owners[i].Access == clients.CodeOwnerAccessRead {
+			invalid[strings.ToLower(owners[i].Name)] = true
+		}
+	}
+
+	for _, f := range files {
+		// The platforms ignore the owners who do not exist or cannot write to the repository.
+		var fileOwners []string
+		for _, o := range co.Owners(f) {
+			if !invalid[strings.ToLower(o)] {
+				fileOwners = append(fileOwners, o)
+			}
+		}
+		if len(fileOwners) > 0 {
+			data.NumOwnedFiles++
+		}
+		if t, ok := sensitivePathType(f, codeownersPath); ok {
+			data.SensitivePaths = append(data.SensitivePaths, checker.CodeownersPath{
+				Path:   f,
+				Type:   t,
+				Owners: fileOwners,
+			})
+		}
+	}
+	return data, nil
+}
+
+func findCodeownersFile(files []string) string {
+	present := make(map[string]bool, len(files))
+	for _, f := range files {
+		present[f] = true
+	}
+	for _, p := range codeownersPaths {
+		if present[p] {
+			return p
+		}
+	}
+	return ""
+}
+
+// codeownersAccess returns the owners listed in the file, with their access to the repository.
+func codeownersAccess(c clients.RepoClient, codeownersPath string,
+	co *fileparser.Codeowners,
+) ([]checker.CodeOwner, error) {
+	var owners []checker.CodeOwner
+	seen := make(map[string]bool)
+	for _, r := range co.Rules {
+		line := r.Line
+		if r.DefaultOwners {
+			line = co.SectionLines[r.Section]
+		}
+		for _, name := range r.Owners {
+			if k := strings.ToLower(name); seen[k] {
+				continue
+			} else {
+				seen[k] = true
+			}
+			access, err := c.GetCodeOwnerAccess(name)
+			switch {
+			case errors.Is(err, clients.ErrUnsupportedFeature):
+				access = clients.CodeOwnerAccessUnknown
+			case err != nil:
+				return nil, fmt.Errorf("error getting the access of %s: %w", name, err)
+			}
+			owners = append(owners, checker.CodeOwner{
+				Name:   name,
+				Access: access,
+				File: checker.File{
+					Path:      codeownersPath,
+					Type:      finding.FileTypeText,
+					Offset:    line,
+					EndOffset: line,
+					Snippet:   name,
+				},
+			})
+		}
+	}
+	return owners, nil
+}
+
+// sensitivePathType returns the type of the files whose changes can compromise
+// the project or its releases, and therefore need owners.
+func sensitivePathType(pathfn, codeownersPath string) (checker.CodeownersPathType, bool) {
+	switch {
+	case pathfn == codeownersPath:
+		return checker.CodeownersPathCodeowners, true
+	case fileparser.IsWorkflowFile(pathfn), pathfn == ".gitlab-ci.yml",
+		strings.HasPrefix(pathfn, ".github/actions/"):
+		return checker.CodeownersPathWorkflow, true
+	case releaseConfigRegex.MatchString(pathfn):
+		return checker.CodeownersPathRelease, true
+	case path.Dir(pathfn) == "." && buildFiles[pathfn]:
+		return checker.CodeownersPathBuild, true
+	default:
+		return "", false
+	}
+}
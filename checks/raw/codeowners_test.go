@@ -0,0 +1,113 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/clients"
+	mockrepo "github.com/ossf/scorecard/v4/clients/mockclients"
+)
+
+func TestCodeowners(t *testing.T) {
+	t.Parallel()
+
+	files := []string{
+		".github/CODEOWNERS",
+		".github/workflows/ci.yml",
+		".goreleaser.yml",
+		"Makefile",
+		"docs/Makefile",
+		"main.go",
+		"pkg/scorecard.go",
+		"README.md",
+	}
+	codeowners := `*.go @go-team @deleted-user
+/.github/workflows/ @ossf/readers
+/Makefile @maintainer dev@example.com
+`
+	access := map[string]clients.CodeOwnerAccess{
+		"@go-team":      clients.CodeOwnerAccessWrite,
+		"@deleted-user": clients.CodeOwnerAccessNotFound,
+		"@ossf/readers": clients.CodeOwnerAccessRead,
+		"@maintainer":   clients.CodeOwnerAccessWrite,
+	}
+
+	ctrl := gomock.NewController(t)
+	mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+	mockRepoClient.EXPECT().ListFiles(gomock.Any()).Return(files, nil)
+	mockRepoClient.EXPECT().GetFileContent(".github/CODEOWNERS").Return([]byte(codeowners), nil)
+	mockRepoClient.EXPECT().GetCodeOwnerAccess(gomock.Any()).DoAndReturn(
+		func(owner string) (clients.CodeOwnerAccess, error) {
+			if a, ok := access[owner]; ok {
+				return a, nil
+			}
+			return clients.CodeOwnerAccessUnknown, fmt.Errorf("%w", clients.ErrUnsupportedFeature)
+		}).Times(5)
+
+	got, err := Codeowners(mockRepoClient)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	gotOwners := map[string]clients.CodeOwnerAccess{}
+	for _, o := range got.Owners {
+		gotOwners[o.Name] = o.Access
+	}
+	wantOwners := map[string]clients.CodeOwnerAccess{
+		"@go-team":        clients.CodeOwnerAccessWrite,
+		"@deleted-user":   clients.CodeOwnerAccessNotFound,
+		"@ossf/readers":   clients.CodeOwnerAccessRead,
+		"@maintainer":     clients.CodeOwnerAccessWrite,
+		"dev@example.com": clients.CodeOwnerAccessUnknown,
+	}
+	if diff := cmp.Diff(wantOwners, gotOwners); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+
+	wantPaths := []checker.CodeownersPath{
+		{Path: ".github/CODEOWNERS", Type: checker.CodeownersPathCodeowners},
+		{Path: ".github/workflows/ci.yml", Type: checker.CodeownersPathWorkflow},
+		{Path: ".goreleaser.yml", Type: checker.CodeownersPathRelease},
+		{Path: "Makefile", Type: checker.CodeownersPathBuild, Owners: []string{"@maintainer", "dev@example.com"}},
+	}
+	if diff := cmp.Diff(wantPaths, got.SensitivePaths); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+	if got.NumFiles != len(files) || got.NumOwnedFiles != 3 {
+		t.Errorf("got %d owned files out of %d, want 3 out of %d", got.NumOwnedFiles, got.NumFiles, len(files))
+	}
+}
+
+func TestCodeownersNoFile(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+	mockRepoClient.EXPECT().ListFiles(gomock.Any()).Return([]string{"README.md", "docs/CODEOWNERS.md"}, nil)
+
+	got, err := Codeowners(mockRepoClient)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(checker.CodeownersData{NumFiles: 2}, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,396 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/checks/fileparser"
+	"github.com/ossf/scorecard/v4/finding"
+)
+
+var (
+	// An image is pinned if it references a digest, e.g. `nginx@sha256:<64 hex>`.
+	digestPinnedImageRegex = regexp.MustCompile(`@sha256:[a-fA-F\d]{64}$`)
+	// Compose files support `${VAR:-default}` and `${VAR-default}` interpolation,
+	// https://docs.docker.com/compose/environment-variables/env-file/#interpolation.
+	composeDefaultVarRegex = regexp.MustCompile(`\$\{[A-Za-z_][A-Za-z0-9_]*:?-([^}]*)\}`)
+	// Compose files are named `compose.yaml`, `compose.<env>.yaml` or `docker-compose*.yaml`.
+	composeFileRegex = regexp.MustCompile(`^(?:docker-compose.*|compose(?:\..+)?)\.ya?ml$`)
+	// Kubernetes container lists, including those nested in pod templates
+	// of Deployments, StatefulSets, DaemonSets, Jobs, CronJobs, etc.
+	kubernetesContainerKeys = []string{"containers", "initContainers", "ephemeralContainers"}
+)
+
+const unresolvedImageMsg = "unable to resolve templated image reference"
+
+// helmCharts maps a Helm chart directory to the chart's appVersion.
+type helmCharts map[string]string
+
+// Check pinning of container images in Kubernetes, Helm, Kustomize and Compose manifests.
+func collectContainerManifestPinning(c *checker.CheckRequest, r *checker.PinningDependenciesData) error {
+	charts := helmCharts{}
+	if err := fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
+		Pattern:       "Chart.yaml",
+		CaseSensitive: true,
+	}, recordHelmChart, charts); err != nil {
+		return err
+	}
+
+	for _, pattern := range []string{"*.yaml", "*.yml"} {
+		if err := fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
+			Pattern:       pattern,
+			CaseSensitive: false,
+		}, validateContainerManifestsPinning, r, charts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var recordHelmChart fileparser.DoWhileTrueOnFileContent = func(
+	pathfn string,
+	content []byte,
+	args ...interface{},
+) (bool, error) {
+	if len(args) != 1 {
+		return false, fmt.Errorf(
+			"recordHelmChart requires exactly 1 arguments: got %v: %w", len(args), errInvalidArgLength)
+	}
+	charts, ok := args[0].(helmCharts)
+	if !ok {
+		return false, fmt.Errorf("recordHelmChart expects arg of type helmCharts: %w", errInvalidArgType)
+	}
+
+	var chart struct {
+		AppVersion string `yaml:"appVersion"`
+	}
+	// A malformed Chart.yaml still identifies the directory as a chart.
+	//nolint:errcheck
+	_ = yaml.Unmarshal(content, &chart)
+	charts[path.Dir(pathfn)] = chart.AppVersion
+	return true, nil
+}
+
+// validateContainerManifestsPinning records the container images referenced
+// in a manifest file. Returns true if the check should continue executing after this file.
+var validateContainerManifestsPinning fileparser.DoWhileTrueOnFileContent = func(
+	pathfn string,
+	content []byte,
+	args ...interface{},
+) (bool, error) {
+	if len(args) != 2 {
+		return false, fmt.Errorf(
+			"validateContainerManifestsPinning requires exactly 2 arguments: got %v: %w", len(args), errInvalidArgLength)
+	}
+	pdata := dataAsPinnedDependenciesPointer(args[0])
+	charts, ok := args[1].(helmCharts)
+	if !ok {
+		return false, fmt.Errorf("validateContainerManifestsPinning expects arg of type helmCharts: %w", errInvalidArgType)
+	}
+
+	// Workflows, Dependabot configuration, etc.
+	if strings.HasPrefix(pathfn, ".github/") {
+		return true, nil
+	}
+
+	if !fileparser.CheckFileContainsCommands(content, "#") {
+		return true, nil
+	}
+
+	var collect func(string, *yaml.Node, *checker.PinningDependenciesData)
+	if dir, appVersion, ok := charts.lookup(pathfn); ok {
+		// Templates are rendered from the chart's values, so we
+		// evaluate the default values instead.
+		if !strings.EqualFold(path.Base(pathfn), "values.yaml") ||
+			path.Dir(pathfn) != dir {
+			return true, nil
+		}
+		collect = func(p string, n *yaml.Node, r *checker.PinningDependenciesData) {
+			collectHelmValuesImages(p, appVersion, n, r)
+		}
+	} else {
+		switch {
+		case isKustomizationFile(pathfn):
+			collect = collectKustomizeImages
+		case isComposeFile(pathfn):
+			collect = collectComposeImages
+		default:
+			collect = collectKubernetesImages
+		}
+	}
+
+	dec := yaml.NewDecoder(bytes.NewReader(content))
+	for {
+		var doc yaml.Node
+		err := dec.Decode(&doc)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			// Many YAML files in a repository are not manifests, or are templates
+			// we cannot parse. Only report the ones that look like they declare images.
+			if bytes.Contains(content, []byte("image:")) {
+				pdata.Dependencies = append(pdata.Dependencies, checker.Dependency{
+					Location: &checker.File{
+						Path: pathfn,
+						Type: finding.FileTypeSource,
+					},
+					Msg:  asPointer(fmt.Sprintf("unable to parse manifest: %v", err)),
+					Type: checker.DependencyUseTypeManifestContainerImage,
+				})
+			}
+			return true, nil
+		}
+		if len(doc.Content) == 0 {
+			continue
+		}
+		collect(pathfn, doc.Content[0], pdata)
+	}
+
+	return true, nil
+}
+
+// lookup returns the directory and appVersion of the chart
+// containing pathfn, if any.
+func (h helmCharts) lookup(pathfn string) (dir, appVersion string, ok bool) {
+	for dir = path.Dir(pathfn); ; dir = path.Dir(dir) {
+		if appVersion, ok = h[dir]; ok {
+			return dir, appVersion, true
+		}
+		if dir == "." || dir == "/" {
+			return "", "", false
+		}
+	}
+}
+
+func isKustomizationFile(pathfn string) bool {
+	switch path.Base(pathfn) {
+	case "kustomization.yaml", "kustomization.yml", "Kustomization":
+		return true
+	default:
+		return false
+	}
+}
+
+func isComposeFile(pathfn string) bool {
+	return composeFileRegex.MatchString(strings.ToLower(path.Base(pathfn)))
+}
+
+// scalarValue returns the value of a scalar key in a YAML mapping node.
+func scalarValue(node *yaml.Node, key string) string {
+	v := fileparser.YAMLMappingValue(node, key)
+	if v == nil || v.Kind != yaml.ScalarNode {
+		return ""
+	}
+	return v.Value
+}
+
+func collectKubernetesImages(pathfn string, node *yaml.Node, r *checker.PinningDependenciesData) {
+	if scalarValue(node, "apiVersion") == "" || scalarValue(node, "kind") == "" {
+		return
+	}
+	walkKubernetesContainers(pathfn, node, r)
+}
+
+func walkKubernetesContainers(pathfn string, node *yaml.Node, r *checker.PinningDependenciesData) {
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			if isKubernetesContainerKey(key.Value) && value.Kind == yaml.SequenceNode {
+				for _, container := range value.Content {
+					image := fileparser.YAMLMappingValue(container, "image")
+					if image == nil || image.Kind != yaml.ScalarNode {
+						continue
+					}
+					r.Dependencies = append(r.Dependencies, newManifestImageDependency(pathfn, image, image.Value))
+				}
+				continue
+			}
+			walkKubernetesContainers(pathfn, value, r)
+		}
+	case yaml.SequenceNode:
+		for _, n := range node.Content {
+			walkKubernetesContainers(pathfn, n, r)
+		}
+	default:
+	}
+}
+
+func isKubernetesContainerKey(key string) bool {
+	for _, k := range kubernetesContainerKeys {
+		if key == k {
+			return true
+		}
+	}
+	return false
+}
+
+func collectComposeImages(pathfn string, node *yaml.Node, r *checker.PinningDependenciesData) {
+	services := fileparser.YAMLMappingValue(node, "services")
+	if services == nil || services.Kind != yaml.MappingNode {
+		return
+	}
+	for i := 1; i < len(services.Content); i += 2 {
+		image := fileparser.YAMLMappingValue(services.Content[i], "image")
+		if image == nil || image.Kind != yaml.ScalarNode {
+			continue
+		}
+		ref := composeDefaultVarRegex.ReplaceAllString(image.Value, "$1")
+		r.Dependencies = append(r.Dependencies, newManifestImageDependency(pathfn, image, ref))
+	}
+}
+
+// https://kubectl.docs.kubernetes.io/references/kustomize/kustomization/images/.
+func collectKustomizeImages(pathfn string, node *yaml.Node, r *checker.PinningDependenciesData) {
+	images := fileparser.YAMLMappingValue(node, "images")
+	if images == nil || images.Kind != yaml.SequenceNode {
+		return
+	}
+	for _, image := range images.Content {
+		name := scalarValue(image, "name")
+		newName := scalarValue(image, "newName")
+		newTag := scalarValue(image, "newTag")
+		digest := scalarValue(image, "digest")
+		// Overrides of the name only keep the tag from the
+		// resources, which are evaluated separately.
+		if newTag == "" && digest == "" {
+			continue
+		}
+		if newName != "" {
+			name = newName
+		}
+		dep := checker.Dependency{
+			Location: &checker.File{
+				Path:      pathfn,
+				Type:      finding.FileTypeSource,
+				Offset:    uint(image.Line),
+				EndOffset: uint(image.Line),
+				Snippet:   fmt.Sprintf("name: %s", name),
+			},
+			Name:     asPointer(name),
+			PinnedAt: asPointer(newTag),
+			Pinned:   asBoolPointer(digest != ""),
+			Type:     checker.DependencyUseTypeManifestContainerImage,
+		}
+		if digest != "" {
+			dep.PinnedAt = asPointer(digest)
+		}
+		if isTemplatedImageReference(name + newTag + digest) {
+			dep.Pinned = nil
+			dep.Msg = asPointer(unresolvedImageMsg)
+		}
+		r.Dependencies = append(r.Dependencies, dep)
+	}
+}
+
+// collectHelmValuesImages looks for `image` blocks in a chart's default values, e.g.
+// `image: {registry: docker.io, repository: nginx, tag: 1.25, digest: sha256:...}`
+// or `image: nginx:1.25`. An empty tag defaults to the chart's appVersion.
+func collectHelmValuesImages(pathfn, appVersion string, node *yaml.Node, r *checker.PinningDependenciesData) {
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			if key.Value != "image" {
+				collectHelmValuesImages(pathfn, appVersion, value, r)
+				continue
+			}
+			switch value.Kind {
+			case yaml.ScalarNode:
+				if value.Value != "" {
+					r.Dependencies = append(r.Dependencies, newManifestImageDependency(pathfn, key, value.Value))
+				}
+			case yaml.MappingNode:
+				repository := scalarValue(value, "repository")
+				if repository == "" {
+					collectHelmValuesImages(pathfn, appVersion, value, r)
+					continue
+				}
+				if registry := scalarValue(value, "registry"); registry != "" {
+					repository = registry + "/" + repository
+				}
+				ref := repository
+				tag := scalarValue(value, "tag")
+				if tag == "" {
+					tag = appVersion
+				}
+				if tag != "" {
+					ref += ":" + tag
+				}
+				if digest := scalarValue(value, "digest"); digest != "" {
+					ref += "@" + digest
+				}
+				r.Dependencies = append(r.Dependencies, newManifestImageDependency(pathfn, key, ref))
+			default:
+			}
+		}
+	case yaml.SequenceNode:
+		for _, n := range node.Content {
+			collectHelmValuesImages(pathfn, appVersion, n, r)
+		}
+	default:
+	}
+}
+
+func isTemplatedImageReference(ref string) bool {
+	return strings.Contains(ref, "{{") || strings.Contains(ref, "$")
+}
+
+// splitImageReference splits an image reference into its name
+// and its tag or digest, taking registry ports into account.
+func splitImageReference(ref string) (name, version string) {
+	if i := strings.Index(ref, "@"); i >= 0 {
+		return ref[:i], ref[i+1:]
+	}
+	if i := strings.LastIndex(ref, ":"); i > strings.LastIndex(ref, "/") {
+		return ref[:i], ref[i+1:]
+	}
+	return ref, ""
+}
+
+func newManifestImageDependency(pathfn string, node *yaml.Node, ref string) checker.Dependency {
+	dep := checker.Dependency{
+		Location: &checker.File{
+			Path:      pathfn,
+			Type:      finding.FileTypeSource,
+			Offset:    uint(node.Line),
+			EndOffset: uint(node.Line),
+			Snippet:   fmt.Sprintf("image: %s", ref),
+		},
+		Type: checker.DependencyUseTypeManifestContainerImage,
+	}
+	if isTemplatedImageReference(ref) {
+		dep.Msg = asPointer(unresolvedImageMsg)
+		return dep
+	}
+	name, version := splitImageReference(ref)
+	dep.Name = asPointer(name)
+	if version != "" {
+		dep.PinnedAt = asPointer(version)
+	}
+	dep.Pinned = asBoolPointer(digestPinnedImageRegex.MatchString(ref))
+	return dep
+}
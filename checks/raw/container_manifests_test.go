@@ -0,0 +1,183 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ossf/scorecard/v4/checker"
+)
+
+func TestContainerManifestsPinning(t *testing.T) {
+	t.Parallel()
+	//nolint
+	tests := []struct {
+		name     string
+		filename string
+		charts   helmCharts
+		pinned   int
+		unpinned int
+		unknown  int
+		lines    []uint
+	}{
+		{
+			name:     "kubernetes workloads",
+			filename: "./testdata/manifests/k8s-workloads.yaml",
+			pinned:   1,
+			unpinned: 2,
+			unknown:  1,
+			lines:    []uint{10, 13, 15, 29},
+		},
+		{
+			name:     "yaml without apiVersion and kind",
+			filename: "./testdata/manifests/not-a-manifest.yaml",
+		},
+		{
+			name:     "compose file",
+			filename: "./testdata/manifests/docker-compose.yml",
+			pinned:   1,
+			unpinned: 2,
+			unknown:  1,
+			lines:    []uint{3, 5, 7, 9},
+		},
+		{
+			name:     "kustomization",
+			filename: "./testdata/manifests/kustomization.yaml",
+			pinned:   1,
+			unpinned: 1,
+			lines:    []uint{4, 6},
+		},
+		{
+			name:     "helm values",
+			filename: "./testdata/manifests/chart/values.yaml",
+			charts:   helmCharts{"manifests/chart": "2.3.0"},
+			pinned:   1,
+			unpinned: 2,
+			lines:    []uint{1, 5, 11},
+		},
+		{
+			name:     "helm templates are skipped",
+			filename: "./testdata/manifests/chart/templates/deployment.yaml",
+			charts:   helmCharts{"manifests/chart": "2.3.0"},
+		},
+		{
+			name:     "workflow files are skipped",
+			filename: "./testdata/.github/workflows/workflow-not-pinned.yaml",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			content, err := os.ReadFile(tt.filename)
+			if err != nil {
+				t.Errorf("cannot read file: %v", err)
+			}
+			p := strings.Replace(tt.filename, "./testdata/", "", 1)
+			charts := tt.charts
+			if charts == nil {
+				charts = helmCharts{}
+			}
+
+			var r checker.PinningDependenciesData
+			if _, err := validateContainerManifestsPinning(p, content, &r, charts); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var pinned, unpinned, unknown int
+			var lines []uint
+			for _, d := range r.Dependencies {
+				if d.Type != checker.DependencyUseTypeManifestContainerImage {
+					t.Errorf("unexpected type %v", d.Type)
+				}
+				lines = append(lines, d.Location.Offset)
+				switch {
+				case d.Pinned == nil:
+					unknown++
+				case *d.Pinned:
+					pinned++
+				default:
+					unpinned++
+				}
+			}
+			if pinned != tt.pinned || unpinned != tt.unpinned || unknown != tt.unknown {
+				t.Errorf("expected %d/%d/%d pinned/unpinned/unknown. Got %d/%d/%d",
+					tt.pinned, tt.unpinned, tt.unknown, pinned, unpinned, unknown)
+			}
+			if len(lines) != len(tt.lines) {
+				t.Fatalf("expected lines %v. Got %v", tt.lines, lines)
+			}
+			for i := range lines {
+				if lines[i] != tt.lines[i] {
+					t.Errorf("expected lines %v. Got %v", tt.lines, lines)
+				}
+			}
+		})
+	}
+}
+
+func TestSplitImageReference(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		ref     string
+		name    string
+		version string
+	}{
+		{ref: "nginx", name: "nginx"},
+		{ref: "nginx:1.25", name: "nginx", version: "1.25"},
+		{ref: "registry:5000/nginx", name: "registry:5000/nginx"},
+		{ref: "registry:5000/nginx:1.25", name: "registry:5000/nginx", version: "1.25"},
+		{ref: "nginx:1.25@sha256:abc", name: "nginx:1.25", version: "sha256:abc"},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.ref, func(t *testing.T) {
+			t.Parallel()
+			name, version := splitImageReference(tt.ref)
+			if name != tt.name || version != tt.version {
+				t.Errorf("expected (%q, %q). Got (%q, %q)", tt.name, tt.version, name, version)
+			}
+		})
+	}
+}
+
+func TestIsComposeFile(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		pathfn string
+		want   bool
+	}{
+		{pathfn: "compose.yaml", want: true},
+		{pathfn: "deploy/compose.yml", want: true},
+		{pathfn: "compose.override.yaml", want: true},
+		{pathfn: "docker-compose.yml", want: true},
+		{pathfn: "docker-compose.prod.yaml", want: true},
+		{pathfn: "docker-compose-dev.yml", want: true},
+		{pathfn: "composer.yaml", want: false},
+		{pathfn: "composition.yml", want: false},
+		{pathfn: "compose.json", want: false},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.pathfn, func(t *testing.T) {
+			t.Parallel()
+			if got := isComposeFile(tt.pathfn); got != tt.want {
+				t.Errorf("isComposeFile(%q) = %v, want %v", tt.pathfn, got, tt.want)
+			}
+		})
+	}
+}
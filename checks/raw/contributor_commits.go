@@ -0,0 +1,171 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/clients"
+)
+
+// personalEmailDomains are the domains of the personal email providers, and of
+// the addresses generated by the forges, which tell nothing about the affiliation.
+var personalEmailDomains = map[string]bool{
+	"126.com":                  true,
+	"163.com":                  true,
+	"aol.com":                  true,
+	"fastmail.com":             true,
+	"gmail.com":                true,
+	"gmx.de":                   true,
+	"gmx.net":                  true,
+	"googlemail.com":           true,
+	"hey.com":                  true,
+	"hotmail.com":              true,
+	"icloud.com":               true,
+	"live.com":                 true,
+	"mail.ru":                  true,
+	"me.com":                   true,
+	"outlook.com":              true,
+	"pm.me":                    true,
+	"proton.me":                true,
+	"protonmail.com":           true,
+	"qq.com":                   true,
+	"users.noreply.github.com": true,
+	"users.noreply.gitlab.com": true,
+	"web.de":                   true,
+	"yahoo.com":                true,
+	"yandex.ru":                true,
+}
+
+// emailAffiliation returns the organization of the closest domain of the email
+// mapped in domainOrgs, or the domain itself, e.g., `redhat.com`. The domains
+// mapped to an empty organization are ignored, e.g., the domain of a personal
+// email provider.
+func emailAffiliation(email string, domainOrgs map[string]string) string {
+	email = strings.ToLower(strings.TrimSpace(email))
+	at := strings.LastIndex(email, "@")
+	if at < 0 || at == len(email)-1 {
+		return ""
+	}
+	domain := email[at+1:]
+	for d := domain; strings.Contains(d, "."); {
+		if org, ok := domainOrgs[d]; ok {
+			return org
+		}
+		if personalEmailDomains[d] {
+			return ""
+		}
+		_, d, _ = strings.Cut(d, ".")
+	}
+	return domain
+}
+
+func isBotAuthor(author *clients.CommitAuthor) bool {
+	return strings.Contains(author.Login, "[bot]") || strings.Contains(author.Email, "[bot]")
+}
+
+// contributorCommits returns the commits of the authors who are not bots. The
+// affiliation is only inferred from the emails the forge verified, i.e., linked
+// to an account, or used in a verified signature.
+func contributorCommits(commits []clients.Commit, domainOrgs map[string]string) []checker.ContributorCommit {
+	ret := []checker.ContributorCommit{}
+	for i := range commits {
+		commit := &commits[i]
+		author := &commit.Author
+		if isBotAuthor(author) {
+			continue
+		}
+		c := checker.ContributorCommit{
+			CommittedDate: commit.CommittedDate,
+			SHA:           commit.SHA,
+		}
+		switch {
+		case author.Login != "":
+			c.Author = strings.ToLower(author.Login)
+		case author.Email != "":
+			c.Author = strings.ToLower(author.Email)
+		default:
+			continue
+		}
+		verified := author.Login != "" || (commit.Signature != nil && commit.Signature.Verified)
+		if verified {
+			c.Affiliation = emailAffiliation(author.Email, domainOrgs)
+		}
+		ret = append(ret, c)
+	}
+	return ret
+}
+
+// topShares returns the number of distinct keys, the most frequent key and its
+// percentage, and the smallest number of keys accounting for half of the counts.
+func topShares(counts map[string]int, total int) (n int, top string, topShare, half int) {
+	keys := make([]string, 0, len(counts))
+	for k := range counts {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if counts[keys[i]] != counts[keys[j]] {
+			return counts[keys[i]] > counts[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+	if len(keys) == 0 {
+		return 0, "", 0, 0
+	}
+	sum := 0
+	for _, k := range keys {
+		sum += counts[k]
+		half++
+		if 2*sum >= total {
+			break
+		}
+	}
+	return len(keys), keys[0], 100 * counts[keys[0]] / total, half
+}
+
+// contributionWindows returns the concentration metrics of the commits
+// over each of the checker.ContributionWindowDays before now.
+func contributionWindows(commits []checker.ContributorCommit, now time.Time) []checker.ContributionWindow {
+	windows := make([]checker.ContributionWindow, 0, len(checker.ContributionWindowDays))
+	for _, days := range checker.ContributionWindowDays {
+		threshold := now.AddDate(0 /*years*/, 0 /*months*/, -1*days /*days*/)
+		authors := map[string]int{}
+		affiliations := map[string]int{}
+		window := checker.ContributionWindow{Days: days}
+		affiliated := 0
+		for i := range commits {
+			if commits[i].CommittedDate.Before(threshold) {
+				continue
+			}
+			window.Commits++
+			authors[commits[i].Author]++
+			if commits[i].Affiliation != "" {
+				affiliations[commits[i].Affiliation]++
+				affiliated++
+			}
+		}
+		if window.Commits > 0 {
+			window.Authors, _, window.TopAuthorShare, window.BusFactor = topShares(authors, window.Commits)
+		}
+		if affiliated > 0 {
+			window.Affiliations, window.TopAffiliation, window.TopAffiliationShare, _ = topShares(affiliations, affiliated)
+		}
+		windows = append(windows, window)
+	}
+	return windows
+}
@@ -15,8 +15,10 @@
 package raw
 
 import (
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/ossf/scorecard/v4/checker"
 	"github.com/ossf/scorecard/v4/clients"
@@ -32,6 +34,24 @@ func Contributors(cr *checker.CheckRequest) (checker.ContributorsData, error) {
 		return checker.ContributorsData{}, fmt.Errorf("Client.Repositories.ListContributors: %w", err)
 	}
 
+	// The commits only complete the affiliations of the contributors: if the
+	// platform does not support them, the result is based on the profiles alone.
+	rawCommits, err := c.ListCommits()
+	if err != nil && !errors.Is(err, clients.ErrUnsupportedFeature) {
+		return checker.ContributorsData{}, fmt.Errorf("Client.ListCommits: %w", err)
+	}
+	commits := contributorCommits(rawCommits, cr.EmailDomainOrgs)
+
+	// Profile companies are self-reported and often empty: complete them
+	// with the affiliations inferred from the verified commit emails.
+	affiliations := map[string][]string{}
+	for i := range commits {
+		login, affiliation := commits[i].Author, commits[i].Affiliation
+		if affiliation != "" && !companyContains(affiliations[login], affiliation) {
+			affiliations[login] = append(affiliations[login], affiliation)
+		}
+	}
+
 	for _, contrib := range contribs {
 		user := clients.User{
 			Login:            contrib.Login,
@@ -59,10 +79,20 @@ func Contributors(cr *checker.CheckRequest) (checker.ContributorsData, error) {
 			}
 		}
 
+		for _, affiliation := range affiliations[strings.ToLower(contrib.Login)] {
+			if !companyContains(user.Companies, affiliation) {
+				user.Companies = append(user.Companies, affiliation)
+			}
+		}
+
 		users = append(users, user)
 	}
 
-	return checker.ContributorsData{Users: users}, nil
+	return checker.ContributorsData{
+		Users:   users,
+		Commits: commits,
+		Windows: contributionWindows(commits, time.Now()),
+	}, nil
 }
 
 func companyContains(cs []string, name string) bool {
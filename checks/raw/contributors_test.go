@@ -14,7 +14,9 @@
 package raw
 
 import (
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/go-cmp/cmp"
@@ -131,8 +133,15 @@ func TestContributors(t *testing.T) {
 	}
 
 	mockRepoClient.EXPECT().ListContributors().Return(contributors, nil)
+	now := time.Now()
+	mockRepoClient.EXPECT().ListCommits().Return([]clients.Commit{
+		{SHA: "sha1", CommittedDate: now, Author: clients.CommitAuthor{Login: "User1", Email: "user1@company5.com"}},
+		{SHA: "sha2", CommittedDate: now, Author: clients.CommitAuthor{Login: "dependabot[bot]"}},
+		{SHA: "sha3", CommittedDate: now, Author: clients.CommitAuthor{Login: "user2", Email: "user2@eng.acme.com"}},
+	}, nil)
 	req := &checker.CheckRequest{
-		RepoClient: mockRepoClient,
+		RepoClient:      mockRepoClient,
+		EmailDomainOrgs: map[string]string{"acme.com": "acme"},
 	}
 	data, err := Contributors(req)
 	if err != nil {
@@ -143,7 +152,7 @@ func TestContributors(t *testing.T) {
 		{
 			Login:            "user1",
 			NumContributions: 5,
-			Companies:        []string{"company1", "company2"},
+			Companies:        []string{"company1", "company2", "company5.com"},
 			Organizations: []clients.User{
 				{Login: "org1"},
 				{Login: "org2"},
@@ -152,7 +161,7 @@ func TestContributors(t *testing.T) {
 		{
 			Login:            "user2",
 			NumContributions: 3,
-			Companies:        []string{"company3", "company4"},
+			Companies:        []string{"company3", "company4", "acme"},
 			Organizations: []clients.User{
 				{Login: "org3"},
 				{Login: "org4"},
@@ -163,4 +172,153 @@ func TestContributors(t *testing.T) {
 	if diff := cmp.Diff(expectedUsers, data.Users); diff != "" {
 		t.Errorf("unexpected contributors data (-want +got):\n%s", diff)
 	}
+	expectedCommits := []checker.ContributorCommit{
+		{SHA: "sha1", CommittedDate: now, Author: "user1", Affiliation: "company5.com"},
+		{SHA: "sha3", CommittedDate: now, Author: "user2", Affiliation: "acme"},
+	}
+	if diff := cmp.Diff(expectedCommits, data.Commits); diff != "" {
+		t.Errorf("unexpected commits (-want +got):\n%s", diff)
+	}
+}
+
+func TestContributorsWithoutCommits(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+	mockRepoClient.EXPECT().ListContributors().Return([]clients.User{
+		{Login: "user1", NumContributions: 5, Companies: []string{"@Company1"}},
+	}, nil)
+	mockRepoClient.EXPECT().ListCommits().Return(nil, clients.ErrUnsupportedFeature)
+	req := &checker.CheckRequest{
+		RepoClient: mockRepoClient,
+	}
+	data, err := Contributors(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expectedUsers := []clients.User{
+		{Login: "user1", NumContributions: 5, Companies: []string{"company1"}},
+	}
+	if diff := cmp.Diff(expectedUsers, data.Users); diff != "" {
+		t.Errorf("unexpected contributors data (-want +got):\n%s", diff)
+	}
+	if len(data.Commits) != 0 {
+		t.Errorf("unexpected commits: %v", data.Commits)
+	}
+}
+
+var errListCommits = errors.New("commits")
+
+func TestContributorsCommitsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+	mockRepoClient.EXPECT().ListContributors().Return([]clients.User{
+		{Login: "user1", NumContributions: 5},
+	}, nil)
+	mockRepoClient.EXPECT().ListCommits().Return(nil, errListCommits)
+	req := &checker.CheckRequest{
+		RepoClient: mockRepoClient,
+	}
+	if _, err := Contributors(req); !errors.Is(err, errListCommits) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestEmailAffiliation(t *testing.T) {
+	t.Parallel()
+	domainOrgs := map[string]string{
+		"chromium.org":   "google",
+		"example.com":    "",
+		"corp.acme.com":  "acme",
+		"contoso.com":    "contoso",
+		"users.acme.com": "acme-users",
+	}
+	tests := []struct {
+		email string
+		want  string
+	}{
+		{email: "dev@redhat.com", want: "redhat.com"},
+		{email: "Dev@RedHat.com", want: "redhat.com"},
+		{email: "dev@chromium.org", want: "google"},
+		{email: "dev@eu.corp.acme.com", want: "acme"},
+		{email: "dev@us.contoso.com", want: "contoso"},
+		{email: "dev@example.com", want: ""},
+		{email: "dev@gmail.com", want: ""},
+		{email: "123+dev@users.noreply.github.com", want: ""},
+		{email: "not-an-email", want: ""},
+		{email: "dev@", want: ""},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.email, func(t *testing.T) {
+			t.Parallel()
+			if got := emailAffiliation(tt.email, domainOrgs); got != tt.want {
+				t.Errorf("emailAffiliation(%q) = %q, want %q", tt.email, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestContributorCommits(t *testing.T) {
+	t.Parallel()
+	commits := []clients.Commit{
+		{SHA: "1", Author: clients.CommitAuthor{Login: "alice", Email: "alice@acme.com"}},
+		// Verified signature.
+		{SHA: "2", Author: clients.CommitAuthor{Email: "Bob@Contoso.com"}, Signature: &clients.Signature{Verified: true}},
+		// Unverified email.
+		{SHA: "3", Author: clients.CommitAuthor{Email: "carol@acme.com"}},
+		{SHA: "4", Author: clients.CommitAuthor{Login: "renovate[bot]", Email: "29139614+renovate[bot]@users.noreply.github.com"}},
+		{SHA: "5", Author: clients.CommitAuthor{Name: "unknown"}},
+	}
+	want := []checker.ContributorCommit{
+		{SHA: "1", Author: "alice", Affiliation: "acme.com"},
+		{SHA: "2", Author: "bob@contoso.com", Affiliation: "contoso.com"},
+		{SHA: "3", Author: "carol@acme.com"},
+	}
+	if diff := cmp.Diff(want, contributorCommits(commits, nil)); diff != "" {
+		t.Errorf("unexpected commits (-want +got):\n%s", diff)
+	}
+}
+
+func TestContributionWindows(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	daysAgo := func(days int) time.Time {
+		return now.AddDate(0, 0, -days)
+	}
+	var commits []checker.ContributorCommit
+	for i := 0; i < 6; i++ {
+		commits = append(commits, checker.ContributorCommit{CommittedDate: daysAgo(i), Author: "alice", Affiliation: "acme.com"})
+	}
+	commits = append(commits,
+		checker.ContributorCommit{CommittedDate: daysAgo(10), Author: "bob", Affiliation: "contoso.com"},
+		checker.ContributorCommit{CommittedDate: daysAgo(60), Author: "bob", Affiliation: "contoso.com"},
+		checker.ContributorCommit{CommittedDate: daysAgo(70), Author: "bob"},
+		checker.ContributorCommit{CommittedDate: daysAgo(80), Author: "carol"},
+		checker.ContributorCommit{CommittedDate: daysAgo(200), Author: "carol"},
+		checker.ContributorCommit{CommittedDate: daysAgo(300), Author: "dave"},
+		checker.ContributorCommit{CommittedDate: daysAgo(350), Author: "dave"},
+		checker.ContributorCommit{CommittedDate: daysAgo(400), Author: "erin"},
+	)
+	want := []checker.ContributionWindow{
+		{
+			Days: 30, Commits: 7, Authors: 2, BusFactor: 1, TopAuthorShare: 85,
+			Affiliations: 2, TopAffiliation: "acme.com", TopAffiliationShare: 85,
+		},
+		{
+			Days: 90, Commits: 10, Authors: 3, BusFactor: 1, TopAuthorShare: 60,
+			Affiliations: 2, TopAffiliation: "acme.com", TopAffiliationShare: 75,
+		},
+		{
+			Days: 365, Commits: 13, Authors: 4, BusFactor: 2, TopAuthorShare: 46,
+			Affiliations: 2, TopAffiliation: "acme.com", TopAffiliationShare: 75,
+		},
+	}
+	if diff := cmp.Diff(want, contributionWindows(commits, now)); diff != "" {
+		t.Errorf("unexpected windows (-want +got):\n%s", diff)
+	}
 }
@@ -72,8 +72,11 @@ func DangerousWorkflow(c clients.RepoClient) (checker.DangerousWorkflowData, err
 	err := fileparser.OnMatchingFileContentDo(c, fileparser.PathMatcher{
 		Pattern:       ".github/workflows/*",
 		CaseSensitive: false,
-	}, validateGitHubActionWorkflowPatterns, &data)
+	}, validateGitHubActionWorkflowPatterns, &data, c)
 
+	// Composite actions and reusable workflows are checked both on their own
+	// and in the context of their callers: only report each pattern once.
+	data.Workflows = dedupDangerousWorkflows(data.Workflows)
 	return data, err
 }
 
@@ -86,7 +89,7 @@ var validateGitHubActionWorkflowPatterns fileparser.DoWhileTrueOnFileContent = f
 		return true, nil
 	}
 
-	if len(args) != 1 {
+	if len(args) != 2 {
 		return false, fmt.Errorf(
 			"validateGitHubActionWorkflowPatterns requires exactly 2 arguments: %w", errInvalidArgLength)
 	}
@@ -97,6 +100,11 @@ var validateGitHubActionWorkflowPatterns fileparser.DoWhileTrueOnFileContent = f
 		return false, fmt.Errorf(
 			"validateGitHubActionWorkflowPatterns expects arg[0] of type *patternCbData: %w", errInvalidArgType)
 	}
+	repoClient, ok := args[1].(clients.RepoClient)
+	if !ok {
+		return false, fmt.Errorf(
+			"validateGitHubActionWorkflowPatterns expects arg[1] of type clients.RepoClient: %w", errInvalidArgType)
+	}
 
 	if !fileparser.CheckFileContainsCommands(content, "#") {
 		return true, nil
@@ -119,10 +127,71 @@ var validateGitHubActionWorkflowPatterns fileparser.DoWhileTrueOnFileContent = f
 		return false, err
 	}
 
+	// 3. Check the local composite actions and reusable workflows called by the workflow.
+	if err := fileparser.OnWorkflowLocalCalleesDo(repoClient, path, workflow,
+		func(callee *fileparser.LocalCallee) error {
+			return validateLocalCallee(callee, pdata)
+		}); err != nil {
+		return false, err
+	}
+
 	// TODO: Check other dangerous patterns.
 	return true, nil
 }
 
+// validateLocalCallee checks a composite action or a reusable workflow in the context of
+// its caller, e.g. a reusable workflow called by a workflow triggered by `pull_request_target`,
+// or an input of a composite action set to an untrusted value by the caller.
+func validateLocalCallee(callee *fileparser.LocalCallee, pdata *checker.DangerousWorkflowData) error {
+	var found checker.DangerousWorkflowData
+	if err := validateUntrustedCodeCheckout(callee.Workflow, callee.Path, &found); err != nil {
+		return err
+	}
+	if err := validateScriptInjection(callee.Workflow, callee.Path, &found); err != nil {
+		return err
+	}
+	for i := range found.Workflows {
+		caller := callee.Caller
+		found.Workflows[i].Caller = &caller
+	}
+	pdata.Workflows = append(pdata.Workflows, found.Workflows...)
+	return nil
+}
+
+// dedupDangerousWorkflows removes the patterns reported more than once for the same location,
+// preferring the ones found when checking a workflow on its own.
+func dedupDangerousWorkflows(workflows []checker.DangerousWorkflow) []checker.DangerousWorkflow {
+	type key struct {
+		t       checker.DangerousWorkflowType
+		path    string
+		snippet string
+		offset  uint
+	}
+	keyOf := func(w *checker.DangerousWorkflow) key {
+		return key{t: w.Type, path: w.File.Path, offset: w.File.Offset, snippet: w.File.Snippet}
+	}
+
+	seen := make(map[key]bool)
+	for i := range workflows {
+		if workflows[i].Caller == nil {
+			seen[keyOf(&workflows[i])] = true
+		}
+	}
+	var ret []checker.DangerousWorkflow
+	for i := range workflows {
+		w := &workflows[i]
+		if w.Caller != nil {
+			k := keyOf(w)
+			if seen[k] {
+				continue
+			}
+			seen[k] = true
+		}
+		ret = append(ret, *w)
+	}
+	return ret
+}
+
 func validateUntrustedCodeCheckout(workflow *actionlint.Workflow, path string,
 	pdata *checker.DangerousWorkflowData,
 ) error {
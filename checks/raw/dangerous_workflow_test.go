@@ -24,7 +24,9 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 
+	"github.com/ossf/scorecard/v4/checker"
 	mockrepo "github.com/ossf/scorecard/v4/clients/mockclients"
+	"github.com/ossf/scorecard/v4/finding"
 )
 
 func errCmp(e1, e2 error) bool {
@@ -182,3 +184,89 @@ func TestGithubDangerousWorkflow(t *testing.T) {
 		})
 	}
 }
+
+func TestGithubDangerousWorkflowLocalCallees(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+	mockRepoClient.EXPECT().ListFiles(gomock.Any()).Return([]string{
+		".github/workflows/github-workflow-dangerous-pattern-local-callees.yml",
+		".github/workflows/github-workflow-dangerous-pattern-reusable-callee.yml",
+	}, nil)
+	mockRepoClient.EXPECT().GetFileContent(gomock.Any()).DoAndReturn(func(file string) ([]byte, error) {
+		content, err := os.ReadFile("../testdata/" + file)
+		if err != nil {
+			return content, fmt.Errorf("%w", err)
+		}
+		return content, nil
+	}).AnyTimes()
+
+	dw, err := DangerousWorkflow(mockRepoClient)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	caller := ".github/workflows/github-workflow-dangerous-pattern-local-callees.yml"
+	reusable := ".github/workflows/github-workflow-dangerous-pattern-reusable-callee.yml"
+	expected := []checker.DangerousWorkflow{
+		{
+			Type: checker.DangerousWorkflowUntrustedCheckout,
+			File: checker.File{
+				Path:    reusable,
+				Type:    finding.FileTypeSource,
+				Offset:  26,
+				Snippet: "${{ github.event.pull_request.head.sha }}",
+			},
+			Caller: &checker.File{
+				Path:      caller,
+				Type:      finding.FileTypeSource,
+				Offset:    19,
+				EndOffset: 19,
+				Snippet:   "./" + reusable,
+			},
+		},
+		{
+			Type: checker.DangerousWorkflowScriptInjection,
+			File: checker.File{
+				Path:    reusable,
+				Type:    finding.FileTypeSource,
+				Offset:  29,
+				Snippet: " github.event.pull_request.title ",
+			},
+			Caller: &checker.File{
+				Path:      caller,
+				Type:      finding.FileTypeSource,
+				Offset:    19,
+				EndOffset: 19,
+				Snippet:   "./" + reusable,
+			},
+		},
+		{
+			Type: checker.DangerousWorkflowScriptInjection,
+			File: checker.File{
+				Path:    ".github/actions/dangerous-composite/action.yml",
+				Type:    finding.FileTypeSource,
+				Offset:  23,
+				Snippet: " github.event.pull_request.body ",
+			},
+			Caller: &checker.File{
+				Path:      caller,
+				Type:      finding.FileTypeSource,
+				Offset:    29,
+				EndOffset: 29,
+				Snippet:   "./.github/actions/dangerous-composite",
+			},
+		},
+	}
+	sortWorkflows := cmpopts.SortSlices(func(a, b checker.DangerousWorkflow) bool {
+		if a.File.Path != b.File.Path {
+			return a.File.Path < b.File.Path
+		}
+		return a.File.Offset < b.File.Offset
+	})
+	if diff := cmp.Diff(expected, dw.Workflows, sortWorkflows,
+		cmpopts.IgnoreFields(checker.DangerousWorkflow{}, "Job")); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v4/checker"
+)
+
+func TestRegistryConfig(t *testing.T) {
+	t.Parallel()
+
+	type issue struct {
+		Type     checker.RegistryConfigIssueType
+		Registry string
+		Line     uint
+	}
+	//nolint:govet
+	tests := []struct {
+		name       string
+		filename   string
+		pathfn     string
+		parser     int
+		mirrorsAll bool
+		issues     []issue
+	}{
+		{
+			name:     "npmrc",
+			filename: "./testdata/registry-config/npmrc",
+			pathfn:   ".npmrc",
+			parser:   0,
+			issues: []issue{
+				{Type: checker.RegistryConfigInsecureURL, Registry: "http://npm.example.com/", Line: 2},
+				{Type: checker.RegistryConfigEmbeddedCredentials, Registry: "//npm.example.com/", Line: 3},
+			},
+		},
+		{
+			name:     "yarnrc",
+			filename: "./testdata/registry-config/yarnrc.yml",
+			pathfn:   ".yarnrc.yml",
+			parser:   1,
+			issues: []issue{
+				{Type: checker.RegistryConfigInsecureURL, Registry: "http://npm.example.com", Line: 4},
+				{Type: checker.RegistryConfigEmbeddedCredentials, Registry: "myorg", Line: 5},
+				{Type: checker.RegistryConfigInsecureURL, Registry: "*.example.com", Line: 10},
+			},
+		},
+		{
+			name:     "pip.conf",
+			filename: "./testdata/registry-config/pip.conf",
+			pathfn:   "pip.conf",
+			parser:   2,
+			issues: []issue{
+				{Type: checker.RegistryConfigUnscopedSources, Registry: "https://[REDACTED]@pypi.example.com/simple", Line: 4},
+				{Type: checker.RegistryConfigEmbeddedCredentials, Registry: "https://[REDACTED]@pypi.example.com/simple", Line: 4},
+				{Type: checker.RegistryConfigInsecureURL, Registry: "pypi.example.com", Line: 5},
+			},
+		},
+		{
+			name:     "requirements",
+			filename: "./testdata/registry-config/requirements.txt",
+			pathfn:   "requirements.txt",
+			parser:   4,
+			issues: []issue{
+				{Type: checker.RegistryConfigUnscopedSources, Registry: "http://pypi.internal.example.com/simple", Line: 3},
+				{Type: checker.RegistryConfigInsecureURL, Registry: "http://pypi.internal.example.com/simple", Line: 3},
+			},
+		},
+		{
+			name:     "NuGet.config without source mapping",
+			filename: "./testdata/registry-config/NuGet.config",
+			pathfn:   "NuGet.config",
+			parser:   5,
+			issues: []issue{
+				{Type: checker.RegistryConfigInsecureURL, Registry: "http://nuget.example.com/v3/index.json", Line: 5},
+				{Type: checker.RegistryConfigEmbeddedCredentials, Registry: "internal", Line: 10},
+				{Type: checker.RegistryConfigUnscopedSources, Registry: "http://nuget.example.com/v3/index.json", Line: 3},
+			},
+		},
+		{
+			name:     "NuGet.config with source mapping",
+			filename: "./testdata/registry-config/NuGet-mapped.config",
+			pathfn:   "NuGet.config",
+			parser:   5,
+		},
+		{
+			name:     "Maven settings",
+			filename: "./testdata/registry-config/settings.xml",
+			pathfn:   ".mvn/settings.xml",
+			parser:   6,
+			issues: []issue{
+				{Type: checker.RegistryConfigEmbeddedCredentials, Registry: "internal", Line: 6},
+			},
+		},
+		{
+			name:     "Maven POM",
+			filename: "./testdata/registry-config/pom.xml",
+			pathfn:   "pom.xml",
+			parser:   7,
+			issues: []issue{
+				{Type: checker.RegistryConfigInsecureURL, Registry: "http://maven.example.com/releases", Line: 9},
+				{Type: checker.RegistryConfigUnscopedSources, Registry: "http://maven.example.com/releases", Line: 9},
+			},
+		},
+		{
+			name:       "Maven POM with mirror of all repositories",
+			filename:   "./testdata/registry-config/pom.xml",
+			pathfn:     "pom.xml",
+			parser:     7,
+			mirrorsAll: true,
+			issues: []issue{
+				{Type: checker.RegistryConfigInsecureURL, Registry: "http://maven.example.com/releases", Line: 9},
+			},
+		},
+		{
+			name:     "Cargo config",
+			filename: "./testdata/registry-config/cargo-config.toml",
+			pathfn:   ".cargo/config.toml",
+			parser:   8,
+			issues: []issue{
+				{Type: checker.RegistryConfigInsecureURL, Registry: "sparse+http://cargo.example.com/index/", Line: 2},
+				{Type: checker.RegistryConfigEmbeddedCredentials, Registry: "registries.internal", Line: 3},
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			content, err := os.ReadFile(tt.filename)
+			if err != nil {
+				t.Fatalf("cannot read file: %v", err)
+			}
+
+			var r checker.DependencyConfusionData
+			state := registryConfigState{mavenMirrorsAll: tt.mirrorsAll}
+			if _, err := validateRegistryConfig(tt.pathfn, content, &r, &registryConfigParsers[tt.parser], &state); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if r.NumConfigFiles != 1 {
+				t.Errorf("expected 1 configuration file, got %d", r.NumConfigFiles)
+			}
+
+			var got []issue
+			for _, i := range r.Issues {
+				got = append(got, issue{Type: i.Type, Registry: i.Registry, Line: i.File.Offset})
+			}
+			if diff := cmp.Diff(tt.issues, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
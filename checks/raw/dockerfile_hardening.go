@@ -0,0 +1,261 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/moby/buildkit/frontend/dockerfile/parser"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/checks/fileparser"
+	sce "github.com/ossf/scorecard/v4/errors"
+	"github.com/ossf/scorecard/v4/finding"
+)
+
+// dockerSecretNameRegex matches the names of build arguments and environment
+// variables holding secrets, e.g. NPM_TOKEN or DB_PASSWORD.
+var dockerSecretNameRegex = regexp.MustCompile(
+	`(?i)(?:^|_)(?:password|passwd|pwd|secret|token|api_?key|access_?key|secret_?key|private_?key|credentials?)$`)
+
+// dockerStage is the state of a stage of a Dockerfile, used to evaluate the final stage.
+type dockerStage struct {
+	from *parser.Node
+	// user is the last USER instruction, nil if the stage does not set the user.
+	user        *parser.Node
+	name        string
+	base        string
+	exposes     bool
+	healthcheck bool
+}
+
+// DockerfileHardening retrieves the raw data for the Dockerfile-Hardening check.
+func DockerfileHardening(c *checker.CheckRequest) (checker.DockerfileHardeningData, error) {
+	var results checker.DockerfileHardeningData
+	err := fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
+		Pattern:       "*Dockerfile*",
+		CaseSensitive: false,
+	}, validateDockerfileHardening, &results)
+	if err != nil {
+		return checker.DockerfileHardeningData{}, err
+	}
+	return results, nil
+}
+
+var validateDockerfileHardening fileparser.DoWhileTrueOnFileContent = func(
+	pathfn string,
+	content []byte,
+	args ...interface{},
+) (bool, error) {
+	if len(args) != 1 {
+		return false, fmt.Errorf(
+			"validateDockerfileHardening requires exactly 1 arguments: got %v: %w", len(args), errInvalidArgLength)
+	}
+	data, ok := args[0].(*checker.DockerfileHardeningData)
+	if !ok {
+		return false, fmt.Errorf("validateDockerfileHardening expects arg of type *DockerfileHardeningData: %w",
+			errInvalidArgType)
+	}
+
+	if !isDockerfile(pathfn, content) ||
+		!fileparser.CheckFileContainsCommands(content, "#") ||
+		fileparser.IsTemplateFile(pathfn) {
+		return true, nil
+	}
+
+	res, err := parser.Parse(strings.NewReader(string(content)))
+	if err != nil {
+		return false, sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("%v: %v", errInternalInvalidDockerFile, err))
+	}
+	data.NumDockerfiles++
+
+	var stages []*dockerStage
+	current := &dockerStage{}
+	addIssue := func(t checker.DockerfileIssueType, node *parser.Node) {
+		stage := current.name
+		if stage == "" {
+			stage = current.base
+		}
+		data.Issues = append(data.Issues, checker.DockerfileIssue{
+			Type:  t,
+			Stage: stage,
+			File:  dockerfileNodeLocation(pathfn, node),
+		})
+	}
+
+	for _, child := range res.AST.Children {
+		values := dockerNodeValues(child)
+		switch strings.ToUpper(child.Value) {
+		case "FROM":
+			current = newDockerStage(child, values, stages)
+			stages = append(stages, current)
+			if isLatestBaseImage(current.base, stages) {
+				addIssue(checker.DockerfileLatestBaseImage, child)
+			}
+		case "USER":
+			current.user = child
+		case "EXPOSE":
+			current.exposes = true
+		case "HEALTHCHECK":
+			current.healthcheck = len(values) == 0 || !strings.EqualFold(values[0], "NONE")
+		case "ADD":
+			if isRemoteAdd(child, values) {
+				addIssue(checker.DockerfileRemoteAdd, child)
+			}
+		case "ARG":
+			for _, v := range values {
+				name, _, _ := strings.Cut(v, "=")
+				if dockerSecretNameRegex.MatchString(name) {
+					addIssue(checker.DockerfileSecretInArgOrEnv, child)
+					break
+				}
+			}
+		case "ENV":
+			// The values alternate keys and values.
+			for i := 0; i < len(values); i += 2 {
+				if dockerSecretNameRegex.MatchString(values[i]) {
+					addIssue(checker.DockerfileSecretInArgOrEnv, child)
+					break
+				}
+			}
+		case "RUN":
+			if len(values) == 0 {
+				continue
+			}
+			// Reuse the analysis of the Pinned-Dependencies check, and only keep
+			// the downloaded scripts which are executed.
+			var pdata checker.PinningDependenciesData
+			err := validateShellFile(pathfn, uint(child.StartLine)-1, uint(child.EndLine)-1,
+				[]byte(strings.Join(values, " ")), make(map[string]bool), &pdata)
+			switch {
+			case errors.Is(err, sce.ErrorShellParsing):
+				// Commands our parser does not understand are ignored.
+				continue
+			case err != nil:
+				return false, err
+			}
+			for i := range pdata.Dependencies {
+				if pdata.Dependencies[i].Type == checker.DependencyUseTypeDownloadThenRun {
+					addIssue(checker.DockerfileFetchPipeExecute, child)
+					break
+				}
+			}
+		}
+	}
+
+	// Only the final stage matters for the runtime configuration of the image.
+	// The file need not have a FROM statement, e.g. partial Dockerfiles.
+	if len(stages) == 0 {
+		return true, nil
+	}
+	final := stages[len(stages)-1]
+	current = final
+	if final.user == nil {
+		if !strings.Contains(strings.ToLower(final.base), "nonroot") {
+			addIssue(checker.DockerfileRunsAsRoot, final.from)
+		}
+	} else if isRootUser(dockerNodeValues(final.user)) {
+		addIssue(checker.DockerfileRunsAsRoot, final.user)
+	}
+	if final.exposes && !final.healthcheck {
+		addIssue(checker.DockerfileMissingHealthcheck, final.from)
+	}
+	return true, nil
+}
+
+// newDockerStage starts a stage. Stages based on a previous stage inherit its configuration.
+func newDockerStage(from *parser.Node, values []string, stages []*dockerStage) *dockerStage {
+	stage := &dockerStage{}
+	if len(values) > 0 {
+		if parent := findDockerStage(values[0], stages); parent != nil {
+			*stage = *parent
+		}
+		stage.base = values[0]
+	}
+	stage.name = ""
+	// FROM name AS newname.
+	if len(values) == 3 && strings.EqualFold(values[1], "as") {
+		stage.name = values[2]
+	}
+	stage.from = from
+	return stage
+}
+
+func findDockerStage(name string, stages []*dockerStage) *dockerStage {
+	for i := len(stages) - 1; i >= 0; i-- {
+		if stages[i].name != "" && strings.EqualFold(stages[i].name, name) {
+			return stages[i]
+		}
+	}
+	return nil
+}
+
+// isLatestBaseImage returns true for base images without tag or with the `latest` tag.
+// Images pinned by digest, previous stages, `scratch` and images set by build arguments are ignored.
+func isLatestBaseImage(image string, stages []*dockerStage) bool {
+	if image == "" || strings.EqualFold(image, "scratch") || strings.Contains(image, "$") ||
+		strings.Contains(image, "@") || findDockerStage(image, stages[:len(stages)-1]) != nil {
+		return false
+	}
+	name := image[strings.LastIndex(image, "/")+1:]
+	_, tag, hasTag := strings.Cut(name, ":")
+	return !hasTag || tag == "latest"
+}
+
+// isRemoteAdd returns true for ADD instructions fetching a URL without verifying its checksum.
+func isRemoteAdd(node *parser.Node, values []string) bool {
+	for _, f := range node.Flags {
+		if strings.HasPrefix(f, "--checksum") {
+			return false
+		}
+	}
+	// The last value is the destination.
+	for i := 0; i < len(values)-1; i++ {
+		if strings.HasPrefix(values[i], "http://") || strings.HasPrefix(values[i], "https://") {
+			return true
+		}
+	}
+	return false
+}
+
+// isRootUser returns true for the USER instructions switching to root, e.g. `root`, `0` or `root:root`.
+func isRootUser(values []string) bool {
+	if len(values) == 0 {
+		return false
+	}
+	user, _, _ := strings.Cut(values[0], ":")
+	return user == "root" || user == "0"
+}
+
+func dockerNodeValues(node *parser.Node) []string {
+	var values []string
+	for n := node.Next; n != nil; n = n.Next {
+		values = append(values, n.Value)
+	}
+	return values
+}
+
+func dockerfileNodeLocation(pathfn string, node *parser.Node) checker.File {
+	return checker.File{
+		Path:      pathfn,
+		Type:      finding.FileTypeSource,
+		Offset:    uint(node.StartLine),
+		EndOffset: uint(node.EndLine),
+		Snippet:   node.Original,
+	}
+}
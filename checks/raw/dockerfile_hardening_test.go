@@ -0,0 +1,88 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v4/checker"
+)
+
+func TestDockerfileHardening(t *testing.T) {
+	t.Parallel()
+
+	type issue struct {
+		Type  checker.DockerfileIssueType
+		Stage string
+		Line  uint
+	}
+	//nolint:govet
+	tests := []struct {
+		name     string
+		filename string
+		issues   []issue
+	}{
+		{
+			name:     "hardening issues",
+			filename: "./testdata/Dockerfile-hardening-issues",
+			issues: []issue{
+				{Type: checker.DockerfileSecretInArgOrEnv, Line: 1},
+				{Type: checker.DockerfileRemoteAdd, Stage: "builder", Line: 3},
+				{Type: checker.DockerfileFetchPipeExecute, Stage: "builder", Line: 5},
+				{Type: checker.DockerfileLatestBaseImage, Stage: "ubuntu", Line: 8},
+				{Type: checker.DockerfileSecretInArgOrEnv, Stage: "ubuntu", Line: 9},
+				{Type: checker.DockerfileRunsAsRoot, Stage: "ubuntu", Line: 12},
+				{Type: checker.DockerfileMissingHealthcheck, Stage: "ubuntu", Line: 8},
+			},
+		},
+		{
+			name:     "hardened multi-stage",
+			filename: "./testdata/Dockerfile-hardening-ok",
+		},
+		{
+			name:     "nonroot base image",
+			filename: "./testdata/Dockerfile-hardening-distroless",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			content, err := os.ReadFile(tt.filename)
+			if err != nil {
+				t.Fatalf("cannot read file: %v", err)
+			}
+
+			var r checker.DockerfileHardeningData
+			if _, err := validateDockerfileHardening(tt.filename, content, &r); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if r.NumDockerfiles != 1 {
+				t.Errorf("expected 1 Dockerfile, got %d", r.NumDockerfiles)
+			}
+
+			var got []issue
+			for _, i := range r.Issues {
+				got = append(got, issue{Type: i.Type, Stage: i.Stage, Line: i.File.Offset})
+			}
+			if diff := cmp.Diff(tt.issues, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -24,4 +24,7 @@ var (
 	errInvalidArgType            = errors.New("invalid arg type")
 	errInvalidArgLength          = errors.New("invalid arg length")
 	errInvalidGitHubWorkflow     = errors.New("invalid GitHub workflow")
+	// errUnexpectedRegistryResponse is returned for the responses of a package
+	// registry which are neither the metadata of a package nor a missing package.
+	errUnexpectedRegistryResponse = errors.New("unexpected package registry response")
 )
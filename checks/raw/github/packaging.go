@@ -36,6 +36,9 @@ func Packaging(c *checker.CheckRequest) (checker.PackagingData, error) {
 		return data, fmt.Errorf("RepoClient.ListFiles: %w", err)
 	}
 
+	// published is true once a packaging workflow used in runs is found.
+	// The remaining workflows are only parsed for their publication targets.
+	published := false
 	for _, fp := range matchedFiles {
 		fc, err := c.RepoClient.GetFileContent(fp)
 		if err != nil {
@@ -50,6 +53,12 @@ func Packaging(c *checker.CheckRequest) (checker.PackagingData, error) {
 
 		// Check if it's a packaging workflow.
 		match, ok := fileparser.IsPackagingWorkflow(workflow, fp)
+		if ok {
+			data.Targets = append(data.Targets, fileparser.GetPublicationTargets(fp, fc)...)
+		}
+		if published {
+			continue
+		}
 		// Always print debug messages.
 		data.Packages = append(data.Packages,
 			checker.Package{
@@ -71,8 +80,11 @@ func Packaging(c *checker.CheckRequest) (checker.PackagingData, error) {
 		}
 
 		if len(runs) > 0 {
+			auth, provenance := fileparser.GetPublishingAuth(workflow)
 			// Create package.
 			pkg := checker.Package{
+				Auth:       auth,
+				Provenance: provenance,
 				File: &checker.File{
 					Path:   fp,
 					Type:   finding.FileTypeSource,
@@ -93,8 +105,8 @@ func Packaging(c *checker.CheckRequest) (checker.PackagingData, error) {
 				)
 			}
 			data.Packages = append(data.Packages, pkg)
-
-			return data, nil
+			published = true
+			continue
 		}
 
 		data.Packages = append(data.Packages,
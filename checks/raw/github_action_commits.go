@@ -0,0 +1,191 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/clients"
+)
+
+var (
+	commitSHARegex = regexp.MustCompile(`^[a-fA-F\d]{40}$`)
+	// Matches comments such as `# v3.1.0`, `# tag=v3.1.0` or `# pin@v3`.
+	versionCommentRegex = regexp.MustCompile(`^\s*#\s*(?:tag=|pin@)?(v?\d+(?:\.\d+)*(?:[-+][\w.-]+)?)\b`)
+)
+
+// addVersionComments sets the version comment of the dependencies
+// pinned by hash from the line they were found in.
+func addVersionComments(content []byte, deps []checker.Dependency) {
+	lines := bytes.Split(content, []byte("\n"))
+	for i := range deps {
+		dep := &deps[i]
+		if dep.Location == nil || dep.PinnedAt == nil || !commitSHARegex.MatchString(*dep.PinnedAt) {
+			continue
+		}
+		if dep.Location.Offset == 0 || int(dep.Location.Offset) > len(lines) {
+			continue
+		}
+		line := string(lines[dep.Location.Offset-1])
+		idx := strings.Index(line, dep.Location.Snippet)
+		if idx < 0 {
+			continue
+		}
+		m := versionCommentRegex.FindStringSubmatch(line[idx+len(dep.Location.Snippet):])
+		if len(m) > 1 {
+			dep.VersionComment = asPointer(m[1])
+		}
+	}
+}
+
+type actionRepo struct {
+	client    clients.RepoClient
+	tags      []clients.Tag
+	reachable map[string]bool
+	hasTags   bool
+}
+
+type actionCommitVerifier struct {
+	ctx        context.Context
+	repoClient clients.RepoClient
+	repos      map[string]*actionRepo
+	// errs contains the errors met while getting the action repositories.
+	errs map[string]error
+}
+
+// verifyGitHubActionCommits checks that the commits GitHub Actions are pinned at
+// belong to the action repository, and that they match their version comment.
+// Commits that cannot be verified because of an API error are marked as unverified.
+func verifyGitHubActionCommits(c *checker.CheckRequest, r *checker.PinningDependenciesData) {
+	v := actionCommitVerifier{
+		ctx:        c.Ctx,
+		repoClient: c.RepoClient,
+		repos:      make(map[string]*actionRepo),
+		errs:       make(map[string]error),
+	}
+	defer v.close()
+
+	for i := range r.Dependencies {
+		dep := &r.Dependencies[i]
+		if dep.Type != checker.DependencyUseTypeGHAction || dep.Msg != nil ||
+			dep.Name == nil || dep.PinnedAt == nil || !commitSHARegex.MatchString(*dep.PinnedAt) {
+			continue
+		}
+		if strings.HasPrefix(*dep.Name, "docker://") {
+			continue
+		}
+		dep.PinnedCommitIssue = v.verify(*dep.Name, strings.ToLower(*dep.PinnedAt), dep.VersionComment)
+	}
+}
+
+func (v *actionCommitVerifier) verify(name, sha string, versionComment *string) *checker.PinnedCommitIssue {
+	repo, err := v.getRepo(name)
+	if err != nil {
+		return asPinnedCommitIssue(checker.PinnedCommitUnverified)
+	}
+	if repo == nil {
+		return nil
+	}
+
+	reachable, ok := repo.reachable[sha]
+	if !ok {
+		reachable, err = repo.client.IsCommitReachable(sha)
+		switch {
+		case errors.Is(err, clients.ErrUnsupportedFeature):
+			return nil
+		case err != nil:
+			return asPinnedCommitIssue(checker.PinnedCommitUnverified)
+		}
+		repo.reachable[sha] = reachable
+	}
+	if !reachable {
+		return asPinnedCommitIssue(checker.PinnedCommitImpostor)
+	}
+
+	if versionComment == nil || !repo.hasTags || matchesVersion(repo.tags, sha, *versionComment) {
+		return nil
+	}
+	return asPinnedCommitIssue(checker.PinnedCommitMislabeled)
+}
+
+// getRepo returns the action repository, or nil if it cannot be verified
+// by the platform of the repository.
+func (v *actionCommitVerifier) getRepo(name string) (*actionRepo, error) {
+	parts := strings.SplitN(name, "/", 3)
+	if len(parts) < 2 {
+		return nil, nil
+	}
+	key := strings.ToLower(parts[0] + "/" + parts[1])
+	if err, ok := v.errs[key]; ok {
+		return nil, err
+	}
+	if repo, ok := v.repos[key]; ok {
+		return repo, nil
+	}
+
+	client, err := v.repoClient.GetRepoClient(v.ctx, parts[0]+"/"+parts[1])
+	switch {
+	case errors.Is(err, clients.ErrUnsupportedFeature):
+		v.repos[key] = nil
+		return nil, nil
+	case err != nil:
+		v.errs[key] = err
+		return nil, fmt.Errorf("GetRepoClient: %w", err)
+	}
+
+	repo := &actionRepo{
+		client:    client,
+		reachable: make(map[string]bool),
+	}
+	// Version comments are not verified if the tags cannot be listed.
+	if tags, err := client.ListTags(); err == nil {
+		repo.tags = tags
+		repo.hasTags = true
+	}
+	v.repos[key] = repo
+	return repo, nil
+}
+
+func (v *actionCommitVerifier) close() {
+	for _, repo := range v.repos {
+		if repo != nil {
+			repo.client.Close()
+		}
+	}
+}
+
+// matchesVersion returns whether a tag with the version name points at sha.
+// A partial version such as `v3` matches the tags it is a prefix of, e.g., `v3.1.0`.
+func matchesVersion(tags []clients.Tag, sha, version string) bool {
+	for _, tag := range tags {
+		if !strings.EqualFold(tag.CommitSHA, sha) {
+			continue
+		}
+		if tag.Name == version || strings.HasPrefix(tag.Name, version+".") {
+			return true
+		}
+	}
+	return false
+}
+
+func asPinnedCommitIssue(issue checker.PinnedCommitIssue) *checker.PinnedCommitIssue {
+	return &issue
+}
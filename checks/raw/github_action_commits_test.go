@@ -0,0 +1,237 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/clients"
+	sce "github.com/ossf/scorecard/v4/errors"
+	"github.com/ossf/scorecard/v4/finding"
+)
+
+const (
+	releasedSHA = "8e5e7e5ab8b370d6c329ec480221332ada57f0ab"
+	mainSHA     = "2541b1294d2704b0964813337f33b291d3f8596b"
+	forkSHA     = "0123456789abcdef0123456789abcdef01234567"
+)
+
+// stubRepoClient is a local RepoClient backed by a directory.
+type stubRepoClient struct {
+	clients.RepoClient
+	errTags      error
+	errReachable error
+	repos        map[string]*stubRepoClient
+	reachable    map[string]bool
+	dir          string
+	tags         []clients.Tag
+}
+
+func (s *stubRepoClient) GetFileContent(filename string) ([]byte, error) {
+	if s.dir == "" {
+		return nil, os.ErrNotExist
+	}
+	//nolint:wrapcheck
+	return os.ReadFile(filepath.Join(s.dir, filename))
+}
+
+func (s *stubRepoClient) GetRepoClient(ctx context.Context, repo string) (clients.RepoClient, error) {
+	r, ok := s.repos[repo]
+	if !ok {
+		return nil, sce.WithMessage(sce.ErrRepoUnreachable, repo)
+	}
+	return r, nil
+}
+
+func (s *stubRepoClient) ListTags() ([]clients.Tag, error) {
+	return s.tags, s.errTags
+}
+
+func (s *stubRepoClient) IsCommitReachable(commitSHA string) (bool, error) {
+	return s.reachable[commitSHA], s.errReachable
+}
+
+func (s *stubRepoClient) Close() error {
+	return nil
+}
+
+func TestAddVersionComments(t *testing.T) {
+	t.Parallel()
+
+	content := []byte(fmt.Sprintf(`steps:
+  - uses: actions/checkout@%[1]s # v3.1.0
+  - uses: actions/setup-go@%[1]s # tag=v4
+  - uses: actions/cache@%[1]s # pin@v3.3.1
+  - uses: actions/setup-node@%[1]s # latest release
+  - uses: actions/upload-artifact@v3 # v3.1.0
+`, releasedSHA))
+	var deps []checker.Dependency
+	for i, name := range []string{
+		"actions/checkout", "actions/setup-go", "actions/cache", "actions/setup-node",
+	} {
+		deps = append(deps, checker.Dependency{
+			Name:     asPointer(name),
+			PinnedAt: asPointer(releasedSHA),
+			Location: &checker.File{Offset: uint(i + 2), Snippet: name + "@" + releasedSHA},
+		})
+	}
+	deps = append(deps, checker.Dependency{
+		Name:     asPointer("actions/upload-artifact"),
+		PinnedAt: asPointer("v3"),
+		Location: &checker.File{Offset: 6, Snippet: "actions/upload-artifact@v3"},
+	})
+
+	addVersionComments(content, deps)
+
+	var got []string
+	for _, dep := range deps {
+		if dep.VersionComment == nil {
+			got = append(got, "")
+			continue
+		}
+		got = append(got, *dep.VersionComment)
+	}
+	want := []string{"v3.1.0", "v4", "v3.3.1", "", ""}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestVerifyGitHubActionCommits(t *testing.T) {
+	t.Parallel()
+
+	action := &stubRepoClient{
+		tags: []clients.Tag{
+			{Name: "v3.1.0", CommitSHA: releasedSHA},
+			{Name: "v3.0.0", CommitSHA: "93ea575cb5d8a053eaa0ac8fa3b40d7e05a33cc8"},
+		},
+		reachable: map[string]bool{
+			releasedSHA: true,
+			mainSHA:     true,
+		},
+	}
+	rateLimited := &stubRepoClient{
+		errTags:      errors.New("API rate limit exceeded"),
+		errReachable: errors.New("API rate limit exceeded"),
+	}
+	noTags := &stubRepoClient{
+		errTags: errors.New("API rate limit exceeded"),
+		reachable: map[string]bool{
+			mainSHA: true,
+		},
+	}
+	repoClient := &stubRepoClient{
+		repos: map[string]*stubRepoClient{
+			"actions/checkout": action,
+			"actions/cache":    rateLimited,
+			"actions/setup-go": noTags,
+		},
+	}
+
+	dependency := func(name, sha string, version *string) checker.Dependency {
+		return checker.Dependency{
+			Name:           asPointer(name),
+			PinnedAt:       asPointer(sha),
+			VersionComment: version,
+			Pinned:         asBoolPointer(true),
+			Type:           checker.DependencyUseTypeGHAction,
+			Location: &checker.File{
+				Path:    ".github/workflows/main.yml",
+				Type:    finding.FileTypeSource,
+				Snippet: name + "@" + sha,
+			},
+		}
+	}
+	impostor := checker.PinnedCommitImpostor
+	mislabeled := checker.PinnedCommitMislabeled
+	unverified := checker.PinnedCommitUnverified
+
+	tests := []struct {
+		want *checker.PinnedCommitIssue
+		name string
+		dep  checker.Dependency
+	}{
+		{
+			name: "released commit",
+			dep:  dependency("actions/checkout", releasedSHA, asPointer("v3.1.0")),
+		},
+		{
+			name: "released commit with major version comment",
+			dep:  dependency("actions/checkout", releasedSHA, asPointer("v3")),
+		},
+		{
+			name: "commit on a branch without version comment",
+			dep:  dependency("actions/checkout", mainSHA, nil),
+		},
+		{
+			name: "commit from a fork",
+			dep:  dependency("actions/checkout/sub", forkSHA, asPointer("v3.1.0")),
+			want: &impostor,
+		},
+		{
+			name: "commit not matching its version comment",
+			dep:  dependency("actions/checkout", mainSHA, asPointer("v3.1.0")),
+			want: &mislabeled,
+		},
+		{
+			name: "commit not matching its major version comment",
+			dep:  dependency("actions/checkout", releasedSHA, asPointer("v4")),
+			want: &mislabeled,
+		},
+		{
+			name: "unreachable action repository",
+			dep:  dependency("example/action", forkSHA, nil),
+			want: &unverified,
+		},
+		{
+			name: "API error while verifying the commit",
+			dep:  dependency("actions/cache", forkSHA, asPointer("v3.1.0")),
+			want: &unverified,
+		},
+		{
+			name: "API error while listing the tags",
+			dep:  dependency("actions/setup-go", mainSHA, asPointer("v3.1.0")),
+		},
+		{
+			name: "pinned by tag",
+			dep:  dependency("actions/checkout", "v3", nil),
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			r := checker.PinningDependenciesData{
+				Dependencies: []checker.Dependency{tt.dep},
+			}
+			c := checker.CheckRequest{
+				Ctx:        context.Background(),
+				RepoClient: repoClient,
+			}
+			verifyGitHubActionCommits(&c, &r)
+			if diff := cmp.Diff(tt.want, r.Dependencies[0].PinnedCommitIssue); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
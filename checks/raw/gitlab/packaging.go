@@ -16,6 +16,7 @@ package gitlab
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/ossf/scorecard/v4/checker"
@@ -37,17 +38,20 @@ func Packaging(c *checker.CheckRequest) (checker.PackagingData, error) {
 			return data, fmt.Errorf("RepoClient.GetFileContent: %w", err)
 		}
 
+		data.Targets = append(data.Targets, fileparser.GetPublicationTargets(fp, fc)...)
 		file, found := isGitlabPackagingWorkflow(fc, fp)
 
-		if found {
+		if found && len(data.Packages) == 0 {
+			auth, provenance := gitlabPublishingAuth(fc)
 			data.Packages = append(data.Packages, checker.Package{
-				Name: new(string),
-				Job:  &checker.WorkflowJob{},
-				File: &file,
-				Msg:  nil,
-				Runs: []checker.Run{{URL: c.Repo.URI()}},
+				Name:       new(string),
+				Job:        &checker.WorkflowJob{},
+				File:       &file,
+				Msg:        nil,
+				Runs:       []checker.Run{{URL: c.Repo.URI()}},
+				Auth:       auth,
+				Provenance: provenance,
 			})
-			return data, nil
 		}
 	}
 
@@ -61,21 +65,9 @@ func StringPointer(s string) *string {
 func isGitlabPackagingWorkflow(fc []byte, fp string) (checker.File, bool) {
 	lineNumber := checker.OffsetDefault
 
-	packagingStrings := []string{
-		"docker push",
-		"nuget push",
-		"poetry publish",
-		"twine upload",
-	}
-
-ParseLines:
-	for idx, val := range strings.Split(string(fc[:]), "\n") {
-		for _, element := range packagingStrings {
-			if strings.Contains(val, element) {
-				lineNumber = uint(idx + 1)
-				break ParseLines
-			}
-		}
+	// The publication targets are in the order of the lines of the pipeline.
+	if targets := fileparser.GetPublicationTargets(fp, fc); len(targets) > 0 {
+		lineNumber = targets[0].File.Offset
 	}
 
 	return checker.File{
@@ -84,3 +76,27 @@ ParseLines:
 		Type:   finding.FileTypeSource,
 	}, lineNumber != checker.OffsetDefault
 }
+
+// gitlabRegistryCredentialRegex matches CI/CD variables which commonly hold the credentials
+// of a package registry. The predefined CI_ variables are short-lived job credentials.
+var gitlabRegistryCredentialRegex = regexp.MustCompile(
+	`\$\{?(?:[A-Z0-9_]*_)?(?:NPM|PYPI|TWINE|CARGO|NUGET|DOCKER|DOCKERHUB|REGISTRY)[A-Z0-9_]*(?:TOKEN|PASSWORD|KEY)\b`)
+
+// gitlabPublishingAuth returns the authentication used by a packaging pipeline
+// to publish its packages, and whether the pipeline generates provenance for them.
+func gitlabPublishingAuth(fc []byte) (checker.PublishingAuth, bool) {
+	content := string(fc)
+	provenance := strings.Contains(content, "RUNNER_GENERATE_ARTIFACTS_METADATA") ||
+		strings.Contains(content, "--provenance")
+
+	for _, m := range gitlabRegistryCredentialRegex.FindAllString(content, -1) {
+		if !strings.HasPrefix(strings.TrimLeft(m, "${"), "CI_") {
+			return checker.PublishingAuthToken, provenance
+		}
+	}
+	// ID tokens are the OIDC tokens used for trusted publishing.
+	if strings.Contains(content, "id_tokens:") {
+		return checker.PublishingAuthTrusted, provenance
+	}
+	return checker.PublishingAuthUnknown, provenance
+}
@@ -64,6 +64,12 @@ func TestGitlabPackagingYamlCheck(t *testing.T) {
 			lineNumber: 26,
 			exists:     true,
 		},
+		{
+			name:       "Cargo",
+			filename:   "./testdata/release.yaml",
+			lineNumber: 22,
+			exists:     true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -185,3 +191,101 @@ func TestGitlabPackagingPackager(t *testing.T) {
 		})
 	}
 }
+
+func TestGitlabPublicationTargets(t *testing.T) {
+	t.Parallel()
+
+	const filename = "./testdata/release.yaml"
+	ctrl := gomock.NewController(t)
+	moqRepoClient := mockrepo.NewMockRepoClient(ctrl)
+	moqRepo := mockrepo.NewMockRepo(ctrl)
+	moqRepoClient.EXPECT().ListFiles(gomock.Any()).Return([]string{filename}, nil)
+	moqRepoClient.EXPECT().GetFileContent(filename).DoAndReturn(func(b string) ([]byte, error) {
+		//nolint: wrapcheck
+		return os.ReadFile(b)
+	})
+	moqRepo.EXPECT().URI().Return("gitlab.com/owner/project")
+
+	packagingData, err := Packaging(&checker.CheckRequest{
+		RepoClient: moqRepoClient,
+		Repo:       moqRepo,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	//nolint:govet
+	want := []struct {
+		registry checker.PackageRegistry
+		tool     string
+		line     uint
+	}{
+		{checker.PackageRegistryCratesIO, "cargo publish", 22},
+		{checker.PackageRegistryHelm, "helm push", 31},
+		{checker.PackageRegistryReleases, "semantic-release", 38},
+	}
+	if len(packagingData.Targets) != len(want) {
+		t.Fatalf("expected %d publication targets, got %v", len(want), packagingData.Targets)
+	}
+	for i, w := range want {
+		got := packagingData.Targets[i]
+		if got.Registry != w.registry || got.Tool != w.tool || got.File.Offset != w.line {
+			t.Errorf("target %d = (%v, %v, %d), expected (%v, %v, %d)",
+				i, got.Registry, got.Tool, got.File.Offset, w.registry, w.tool, w.line)
+		}
+		// The chart is signed with cosign.
+		if !got.Signed {
+			t.Errorf("target %d should be signed", i)
+		}
+	}
+}
+
+func TestGitlabPublishingAuth(t *testing.T) {
+	t.Parallel()
+
+	//nolint:govet
+	tests := []struct {
+		name       string
+		content    string
+		auth       checker.PublishingAuth
+		provenance bool
+	}{
+		{
+			name:    "job token",
+			content: `poetry publish --username gitlab-ci-token --password "${CI_JOB_TOKEN}"`,
+			auth:    checker.PublishingAuthUnknown,
+		},
+		{
+			name:    "long-lived token",
+			content: `twine upload -u __token__ -p $PYPI_API_TOKEN dist/*`,
+			auth:    checker.PublishingAuthToken,
+		},
+		{
+			name: "trusted publishing with provenance",
+			content: `publish:
+  variables:
+    RUNNER_GENERATE_ARTIFACTS_METADATA: "true"
+  id_tokens:
+    PYPI_ID_TOKEN:
+      aud: pypi
+  script:
+  - twine upload dist/*`,
+			auth:       checker.PublishingAuthTrusted,
+			provenance: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			auth, provenance := gitlabPublishingAuth([]byte(tt.content))
+			if auth != tt.auth {
+				t.Errorf("Expected auth: %v != %v", tt.auth, auth)
+			}
+			if provenance != tt.provenance {
+				t.Errorf("Expected provenance: %v != %v", tt.provenance, provenance)
+			}
+		})
+	}
+}
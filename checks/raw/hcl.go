@@ -0,0 +1,90 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+var errInvalidHCL = errors.New("invalid HCL")
+
+// parseHCL parses content written in the native HCL syntax and returns its top-level body.
+func parseHCL(pathfn string, content []byte) (*hclsyntax.Body, error) {
+	f, diags := hclsyntax.ParseConfig(content, pathfn, hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, fmt.Errorf("%w: %s", errInvalidHCL, diags.Error())
+	}
+	body, ok := f.Body.(*hclsyntax.Body)
+	if !ok {
+		return nil, fmt.Errorf("%w: unexpected body type %T", errInvalidHCL, f.Body)
+	}
+	return body, nil
+}
+
+// hclBlocksOfType returns the direct children of body with the given type.
+func hclBlocksOfType(body *hclsyntax.Body, t string) []*hclsyntax.Block {
+	var ret []*hclsyntax.Block
+	for _, b := range body.Blocks {
+		if b.Type == t {
+			ret = append(ret, b)
+		}
+	}
+	return ret
+}
+
+// hclString returns the value of a string expression that does not
+// reference any variable or function.
+func hclString(expr hcl.Expression) (string, bool) {
+	v, diags := expr.Value(nil)
+	if diags.HasErrors() || v.IsNull() || !v.IsKnown() || v.Type() != cty.String {
+		return "", false
+	}
+	return v.AsString(), true
+}
+
+// hclStringAttribute returns the value of a string attribute of body.
+func hclStringAttribute(body *hclsyntax.Body, name string) (string, bool) {
+	attr, ok := body.Attributes[name]
+	if !ok {
+		return "", false
+	}
+	return hclString(attr.Expr)
+}
+
+// hclObjectElements returns the elements of an object constructor, e.g.
+// `{ source = "hashicorp/aws" }`, indexed by their key.
+func hclObjectElements(expr hcl.Expression) map[string]hcl.Expression {
+	obj, ok := expr.(*hclsyntax.ObjectConsExpr)
+	if !ok {
+		return nil
+	}
+	ret := map[string]hcl.Expression{}
+	for _, item := range obj.Items {
+		if key, ok := hclString(item.KeyExpr); ok {
+			ret[key] = item.ValueExpr
+		}
+	}
+	return ret
+}
+
+// hclLine returns the line an HCL range starts at.
+func hclLine(r hcl.Range) uint {
+	return uint(r.Start.Line)
+}
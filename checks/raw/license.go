@@ -111,6 +111,23 @@ func License(c *checker.CheckRequest) (checker.LicenseData, error) {
 	// prepare case insensitive map to map approved licenses matched in repo.
 	setCiMap()
 
+	licenseFiles, err := licenseFiles(c)
+	if err != nil {
+		return results, err
+	}
+	results.LicenseFiles = licenseFiles
+
+	if err := licenseDeclarations(c.RepoClient, &results); err != nil {
+		return results, err
+	}
+	return results, nil
+}
+
+// licenseFiles returns the license files of the repository, from the
+// repository API if it is supported, else from the repository files.
+func licenseFiles(c *checker.CheckRequest) ([]checker.LicenseFile, error) {
+	var results []checker.LicenseFile
+
 	licensesFound, lerr := c.RepoClient.ListLicenses()
 	switch {
 	// repo API for licenses is supported
@@ -122,7 +139,7 @@ func License(c *checker.CheckRequest) (checker.LicenseData, error) {
 			break
 		}
 		for _, v := range licensesFound {
-			results.LicenseFiles = append(results.LicenseFiles,
+			results = append(results,
 				checker.LicenseFile{
 					File: checker.File{
 						Path: v.Path,
@@ -177,7 +194,7 @@ func License(c *checker.CheckRequest) (checker.LicenseData, error) {
 		path.LicenseInformation.Approved = len(
 			fsfOsiApprovedLicenseCiMap[strings.ToUpper(path.LicenseInformation.SpdxID)].Name) > 0
 		path.LicenseInformation.Attribution = checker.LicenseAttributionTypeHeuristics
-		results.LicenseFiles = append(results.LicenseFiles, path)
+		results = append(results, path)
 	}
 
 	return results, nil
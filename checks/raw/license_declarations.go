@@ -0,0 +1,250 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/checks/fileparser"
+	"github.com/ossf/scorecard/v4/clients"
+	"github.com/ossf/scorecard/v4/finding"
+)
+
+const (
+	reuseDep5Path = ".reuse/dep5"
+	reuseTomlPath = "REUSE.toml"
+	// licensesDir is the directory of the license texts of a REUSE project.
+	licensesDir = "LICENSES/"
+	// licenseCompanionExt is the extension of the files holding
+	// the license header of another file, e.g., `logo.png.license`.
+	licenseCompanionExt = ".license"
+)
+
+// spdxHeaderRegex matches an SPDX-License-Identifier tag. The tag is split
+// so that this file is not reported as declaring a license.
+var spdxHeaderRegex = regexp.MustCompile(`SPDX-` + `License-Identifier:\s*(.*)$`)
+
+// licenseSourceExtensions are the extensions of the source files
+// expected to have a license declaration.
+var licenseSourceExtensions = map[string]bool{
+	".bash": true, ".c": true, ".cc": true, ".cjs": true, ".clj": true, ".cpp": true,
+	".cs": true, ".cxx": true, ".dart": true, ".erl": true, ".ex": true, ".exs": true,
+	".fs": true, ".go": true, ".groovy": true, ".h": true, ".hh": true, ".hpp": true,
+	".hs": true, ".java": true, ".jl": true, ".js": true, ".jsx": true, ".kt": true,
+	".kts": true, ".lua": true, ".m": true, ".ml": true, ".mjs": true, ".mm": true,
+	".php": true, ".pl": true, ".pm": true, ".ps1": true, ".py": true, ".r": true,
+	".rb": true, ".rs": true, ".scala": true, ".sh": true, ".sol": true, ".svelte": true,
+	".swift": true, ".ts": true, ".tsx": true, ".vue": true, ".zig": true, ".zsh": true,
+}
+
+// licenseDeclarationScan holds the state of the scan of the repository files.
+type licenseDeclarationScan struct {
+	// headers is the set of files with a header,
+	// either in the file or in its companion file.
+	headers     map[string]bool
+	data        *checker.LicenseData
+	annotations []fileparser.REUSEAnnotation
+	sourceFiles []string
+	expressions []*fileparser.SPDXExpression
+}
+
+// licenseDeclarations records the SPDX-License-Identifier headers of the source
+// files and the REUSE annotations, the share of source files with a declared
+// license, and the declarations that conflict with the licenses of the project.
+func licenseDeclarations(c clients.RepoClient, data *checker.LicenseData) error {
+	for i := range data.LicenseFiles {
+		id := data.LicenseFiles[i].LicenseInformation.SpdxID
+		if id != "" && id != "NOASSERTION" {
+			data.ProjectLicenses = appendLicense(data.ProjectLicenses, id)
+		}
+	}
+
+	scan := licenseDeclarationScan{
+		headers: map[string]bool{},
+		data:    data,
+	}
+	err := fileparser.OnMatchingFileContentDo(c, fileparser.PathMatcher{
+		Pattern:       "*",
+		CaseSensitive: false,
+	}, scanLicenseDeclarations, &scan)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	data.SourceFiles = len(scan.sourceFiles)
+	for _, pathfn := range scan.sourceFiles {
+		if scan.isDeclared(pathfn) {
+			data.DeclaredSourceFiles++
+		}
+	}
+
+	if len(data.ProjectLicenses) == 0 {
+		return nil
+	}
+	allowed := func(license string) bool {
+		for _, l := range data.ProjectLicenses {
+			if strings.EqualFold(fileparser.NormalizeSPDXLicenseID(l), fileparser.NormalizeSPDXLicenseID(license)) {
+				return true
+			}
+		}
+		return false
+	}
+	for i, e := range scan.expressions {
+		if e != nil {
+			data.Declarations[i].ConflictsWithLicenseFile = !e.SatisfiedBy(allowed)
+		}
+	}
+	return nil
+}
+
+func (s *licenseDeclarationScan) isDeclared(pathfn string) bool {
+	if s.headers[pathfn] {
+		return true
+	}
+	for i := range s.annotations {
+		if s.annotations[i].Matches(pathfn) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *licenseDeclarationScan) addDeclaration(file checker.File, src checker.LicenseDeclarationSource,
+	expression string,
+) {
+	declaration := checker.LicenseDeclaration{
+		File:       file,
+		Source:     src,
+		Expression: expression,
+	}
+	e, err := fileparser.ParseSPDXExpression(expression)
+	if err != nil {
+		declaration.Error = err.Error()
+	} else {
+		declaration.Licenses = e.Licenses()
+	}
+	s.data.Declarations = append(s.data.Declarations, declaration)
+	s.expressions = append(s.expressions, e)
+}
+
+var scanLicenseDeclarations fileparser.DoWhileTrueOnFileContent = func(pathfn string, content []byte,
+	args ...interface{},
+) (bool, error) {
+	if len(args) != 1 {
+		return false, fmt.Errorf(
+			"scanLicenseDeclarations requires exactly one argument: %w", errInvalidArgLength)
+	}
+	scan, ok := args[0].(*licenseDeclarationScan)
+	if !ok {
+		return false, fmt.Errorf(
+			"scanLicenseDeclarations requires argument of type *licenseDeclarationScan: %w", errInvalidArgType)
+	}
+
+	switch {
+	case pathfn == reuseDep5Path:
+		for _, a := range fileparser.ParseREUSEDep5(content) {
+			scan.annotations = append(scan.annotations, a)
+			scan.addDeclaration(checker.File{
+				Path:   pathfn,
+				Type:   finding.FileTypeText,
+				Offset: a.Line,
+			}, checker.LicenseDeclarationSourceREUSEDep5, a.License)
+		}
+		return true, nil
+	case pathfn == reuseTomlPath:
+		annotations, err := fileparser.ParseREUSEToml(content)
+		if err != nil {
+			// An invalid file declares no license.
+			return true, nil
+		}
+		for _, a := range annotations {
+			scan.annotations = append(scan.annotations, a)
+			scan.addDeclaration(checker.File{
+				Path: pathfn,
+				Type: finding.FileTypeText,
+			}, checker.LicenseDeclarationSourceREUSEToml, a.License)
+		}
+		return true, nil
+	case strings.HasPrefix(pathfn, licensesDir):
+		name := strings.TrimPrefix(pathfn, licensesDir)
+		if ext := path.Ext(name); ext != "" && extensionOK(ext) {
+			name = strings.TrimSuffix(name, ext)
+		}
+		if !strings.Contains(name, "/") {
+			scan.data.ProjectLicenses = appendLicense(scan.data.ProjectLicenses, name)
+		}
+		return true, nil
+	}
+
+	if isVendoredPath(pathfn) {
+		return true, nil
+	}
+	declared := pathfn
+	if strings.HasSuffix(pathfn, licenseCompanionExt) {
+		declared = strings.TrimSuffix(pathfn, licenseCompanionExt)
+	} else if licenseSourceExtensions[strings.ToLower(path.Ext(pathfn))] {
+		scan.sourceFiles = append(scan.sourceFiles, pathfn)
+	} else {
+		return true, nil
+	}
+
+	expression, line, found := findSPDXHeader(content)
+	if !found {
+		return true, nil
+	}
+	scan.headers[declared] = true
+	scan.addDeclaration(checker.File{
+		Path:    pathfn,
+		Type:    finding.FileTypeSource,
+		Offset:  line,
+		Snippet: expression,
+	}, checker.LicenseDeclarationSourceHeader, expression)
+	return true, nil
+}
+
+// findSPDXHeader returns the expression of the first SPDX-License-Identifier
+// tag of the content, without the end of the comment around it, if any.
+func findSPDXHeader(content []byte) (string, uint, bool) {
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	var line uint
+	for scanner.Scan() {
+		line++
+		m := spdxHeaderRegex.FindStringSubmatch(scanner.Text())
+		if m == nil {
+			continue
+		}
+		expression := strings.TrimSpace(m[1])
+		for _, end := range []string{"*/", "-->", "#}", "--}}", "*)", "\"\"\"", "'''"} {
+			expression = strings.TrimSpace(strings.TrimSuffix(expression, end))
+		}
+		return expression, line, true
+	}
+	return "", 0, false
+}
+
+func appendLicense(licenses []string, license string) []string {
+	for _, l := range licenses {
+		if strings.EqualFold(l, license) {
+			return licenses
+		}
+	}
+	return append(licenses, license)
+}
@@ -0,0 +1,187 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/ossf/scorecard/v4/checker"
+	mockrepo "github.com/ossf/scorecard/v4/clients/mockclients"
+	"github.com/ossf/scorecard/v4/finding"
+)
+
+// The SPDX tags are split so that the test file is not reported as declaring a license.
+const spdxTag = "SPDX-" + "License-Identifier:"
+
+func TestLicenseDeclarations(t *testing.T) {
+	t.Parallel()
+	files := map[string]string{
+		"LICENSES/Apache-2.0.txt": "Apache License\n",
+		"LICENSES/CC-BY-4.0.txt":  "Creative Commons\n",
+		".reuse/dep5": "Format: https://www.debian.org/doc/packaging-manuals/copyright-format/1.0/\n\n" +
+			"Files: docs/*\nCopyright: 2023 Someone\nLicense: CC-BY-4.0\n\n" +
+			"Files: gen/*.go\nCopyright: 2023 Someone\nLicense: Apache-2.0\n",
+		"main.go":                "// " + spdxTag + " Apache-2.0\npackage main\n",
+		"lib/lib.c":              "/* " + spdxTag + " MIT OR Apache-2.0 */\n",
+		"lib/gpl.c":              "/* " + spdxTag + " GPL-3.0-or-later */\n",
+		"lib/invalid.py":         "# " + spdxTag + " MIT AND\n",
+		"lib/undeclared.js":      "console.log('hello');\n",
+		"gen/zz_generated.go":    "package gen\n",
+		"scripts/run.sh":         "#!/bin/sh\n",
+		"scripts/run.sh.license": spdxTag + " Apache-2.0\n",
+		"vendor/lib/lib.go":      "package lib\n",
+		"docs/index.md":          "# Docs\n",
+	}
+	var paths []string
+	for p := range files {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	ctrl := gomock.NewController(t)
+	mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+	mockRepoClient.EXPECT().ListFiles(gomock.Any()).DoAndReturn(func(predicate func(string) (bool, error)) ([]string, error) {
+		var matches []string
+		for _, p := range paths {
+			if ok, err := predicate(p); err == nil && ok {
+				matches = append(matches, p)
+			}
+		}
+		return matches, nil
+	})
+	mockRepoClient.EXPECT().GetFileContent(gomock.Any()).DoAndReturn(func(file string) ([]byte, error) {
+		return []byte(files[file]), nil
+	}).AnyTimes()
+
+	got := checker.LicenseData{
+		LicenseFiles: []checker.LicenseFile{
+			{
+				File:               checker.File{Path: "LICENSE"},
+				LicenseInformation: checker.License{SpdxID: "Apache-2.0"},
+			},
+		},
+	}
+	if err := licenseDeclarations(mockRepoClient, &got); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := checker.LicenseData{
+		LicenseFiles:        got.LicenseFiles,
+		ProjectLicenses:     []string{"Apache-2.0", "CC-BY-4.0"},
+		SourceFiles:         7,
+		DeclaredSourceFiles: 6,
+		Declarations: []checker.LicenseDeclaration{
+			{
+				File:       checker.File{Path: ".reuse/dep5", Type: finding.FileTypeText, Offset: 3},
+				Source:     checker.LicenseDeclarationSourceREUSEDep5,
+				Expression: "CC-BY-4.0",
+				Licenses:   []string{"CC-BY-4.0"},
+			},
+			{
+				File:       checker.File{Path: ".reuse/dep5", Type: finding.FileTypeText, Offset: 7},
+				Source:     checker.LicenseDeclarationSourceREUSEDep5,
+				Expression: "Apache-2.0",
+				Licenses:   []string{"Apache-2.0"},
+			},
+			{
+				File:                     checker.File{Path: "lib/gpl.c", Type: finding.FileTypeSource, Offset: 1, Snippet: "GPL-3.0-or-later"},
+				Source:                   checker.LicenseDeclarationSourceHeader,
+				Expression:               "GPL-3.0-or-later",
+				Licenses:                 []string{"GPL-3.0-or-later"},
+				ConflictsWithLicenseFile: true,
+			},
+			{
+				File:       checker.File{Path: "lib/invalid.py", Type: finding.FileTypeSource, Offset: 1, Snippet: "MIT AND"},
+				Source:     checker.LicenseDeclarationSourceHeader,
+				Expression: "MIT AND",
+				Error:      "invalid SPDX license expression: unexpected end of expression",
+			},
+			{
+				File:       checker.File{Path: "lib/lib.c", Type: finding.FileTypeSource, Offset: 1, Snippet: "MIT OR Apache-2.0"},
+				Source:     checker.LicenseDeclarationSourceHeader,
+				Expression: "MIT OR Apache-2.0",
+				Licenses:   []string{"MIT", "Apache-2.0"},
+			},
+			{
+				File:       checker.File{Path: "main.go", Type: finding.FileTypeSource, Offset: 1, Snippet: "Apache-2.0"},
+				Source:     checker.LicenseDeclarationSourceHeader,
+				Expression: "Apache-2.0",
+				Licenses:   []string{"Apache-2.0"},
+			},
+			{
+				File:       checker.File{Path: "scripts/run.sh.license", Type: finding.FileTypeSource, Offset: 1, Snippet: "Apache-2.0"},
+				Source:     checker.LicenseDeclarationSourceHeader,
+				Expression: "Apache-2.0",
+				Licenses:   []string{"Apache-2.0"},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestFindSPDXHeader(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name       string
+		content    string
+		expression string
+		line       uint
+		found      bool
+	}{
+		{
+			name:       "line comment",
+			content:    "#!/bin/sh\n# " + spdxTag + " MIT\n",
+			expression: "MIT",
+			line:       2,
+			found:      true,
+		},
+		{
+			name:       "block comment",
+			content:    "/* " + spdxTag + " (MIT OR Apache-2.0) */\n",
+			expression: "(MIT OR Apache-2.0)",
+			line:       1,
+			found:      true,
+		},
+		{
+			name:       "html comment",
+			content:    "<template>\n<!-- " + spdxTag + " GPL-2.0-only WITH Classpath-exception-2.0 -->\n",
+			expression: "GPL-2.0-only WITH Classpath-exception-2.0",
+			line:       2,
+			found:      true,
+		},
+		{
+			name:    "no header",
+			content: "package main\n",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			expression, line, found := findSPDXHeader([]byte(tt.content))
+			if expression != tt.expression || line != tt.line || found != tt.found {
+				t.Errorf("findSPDXHeader() = (%q, %d, %v), want (%q, %d, %v)",
+					expression, line, found, tt.expression, tt.line, tt.found)
+			}
+		})
+	}
+}
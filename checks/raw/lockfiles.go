@@ -0,0 +1,657 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/checks/fileparser"
+	"github.com/ossf/scorecard/v4/finding"
+)
+
+// commitRegex matches the commit of a package fetched from a git repository.
+var commitRegex = regexp.MustCompile(`\b[0-9a-f]{40}\b`)
+
+// lockfileManifest is a manifest declaring dependencies that are expected
+// to be locked by a committed lockfile.
+type lockfileManifest struct {
+	// needsLockfile returns whether the manifest declares dependencies to lock.
+	needsLockfile func(pathfn string, content []byte, files map[string]bool) bool
+	name          string
+	depType       checker.DependencyUseType
+	lockfiles     []string
+}
+
+// lockfileParser returns the packages of a lockfile that are not locked by an integrity hash.
+type lockfileParser struct {
+	unhashedPackages func(content []byte) ([]string, error)
+	name             string
+	depType          checker.DependencyUseType
+}
+
+var lockfileManifests = []lockfileManifest{
+	{
+		name:          "package.json",
+		depType:       checker.DependencyUseTypeNpmLockfile,
+		lockfiles:     []string{"package-lock.json", "npm-shrinkwrap.json", "yarn.lock", "pnpm-lock.yaml", "bun.lockb"},
+		needsLockfile: npmManifestNeedsLockfile,
+	},
+	{
+		name:          "pyproject.toml",
+		depType:       checker.DependencyUseTypePythonLockfile,
+		lockfiles:     []string{"poetry.lock", "uv.lock", "pdm.lock"},
+		needsLockfile: pyprojectNeedsLockfile,
+	},
+	{
+		name:          "Pipfile",
+		depType:       checker.DependencyUseTypePythonLockfile,
+		lockfiles:     []string{"Pipfile.lock"},
+		needsLockfile: pipfileNeedsLockfile,
+	},
+	{
+		name:          "Cargo.toml",
+		depType:       checker.DependencyUseTypeCargoLockfile,
+		lockfiles:     []string{"Cargo.lock"},
+		needsLockfile: cargoManifestNeedsLockfile,
+	},
+	{
+		name:          "Gemfile",
+		depType:       checker.DependencyUseTypeGemLockfile,
+		lockfiles:     []string{"Gemfile.lock"},
+		needsLockfile: gemfileNeedsLockfile,
+	},
+	{
+		name:          "gems.rb",
+		depType:       checker.DependencyUseTypeGemLockfile,
+		lockfiles:     []string{"gems.locked"},
+		needsLockfile: gemfileNeedsLockfile,
+	},
+}
+
+// Note: bun.lockb is a binary file, which always contains the integrity of the packages.
+var lockfileParsers = []lockfileParser{
+	{name: "package-lock.json", depType: checker.DependencyUseTypeNpmLockfile, unhashedPackages: unhashedNpmPackages},
+	{name: "npm-shrinkwrap.json", depType: checker.DependencyUseTypeNpmLockfile, unhashedPackages: unhashedNpmPackages},
+	{name: "yarn.lock", depType: checker.DependencyUseTypeNpmLockfile, unhashedPackages: unhashedYarnPackages},
+	{name: "pnpm-lock.yaml", depType: checker.DependencyUseTypeNpmLockfile, unhashedPackages: unhashedPnpmPackages},
+	{name: "poetry.lock", depType: checker.DependencyUseTypePythonLockfile, unhashedPackages: unhashedPythonPackages},
+	{name: "uv.lock", depType: checker.DependencyUseTypePythonLockfile, unhashedPackages: unhashedPythonPackages},
+	{name: "pdm.lock", depType: checker.DependencyUseTypePythonLockfile, unhashedPackages: unhashedPythonPackages},
+	{name: "Pipfile.lock", depType: checker.DependencyUseTypePythonLockfile, unhashedPackages: unhashedPipfilePackages},
+	{name: "Cargo.lock", depType: checker.DependencyUseTypeCargoLockfile, unhashedPackages: unhashedCargoPackages},
+	{name: "Gemfile.lock", depType: checker.DependencyUseTypeGemLockfile, unhashedPackages: unhashedGems},
+	{name: "gems.locked", depType: checker.DependencyUseTypeGemLockfile, unhashedPackages: unhashedGems},
+}
+
+// Check the presence and integrity of the lockfiles of application dependencies.
+func collectLockfilePinning(c *checker.CheckRequest, r *checker.PinningDependenciesData) error {
+	listed, err := c.RepoClient.ListFiles(func(string) (bool, error) { return true, nil })
+	if err != nil {
+		return fmt.Errorf("error during ListFiles: %w", err)
+	}
+	files := make(map[string]bool, len(listed))
+	for _, fn := range listed {
+		files[fn] = true
+	}
+
+	for i := range lockfileManifests {
+		if err := fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
+			Pattern:       lockfileManifests[i].name,
+			CaseSensitive: true,
+		}, validateLockfileManifest, r, &lockfileManifests[i], files); err != nil {
+			return err
+		}
+	}
+
+	for i := range lockfileParsers {
+		if err := fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
+			Pattern:       lockfileParsers[i].name,
+			CaseSensitive: true,
+		}, validateLockfileIntegrity, r, &lockfileParsers[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isVendoredPath returns whether the file belongs to a dependency vendored in the repository.
+func isVendoredPath(pathfn string) bool {
+	for _, dir := range []string{"node_modules", "vendor", "third_party"} {
+		if strings.HasPrefix(pathfn, dir+"/") || strings.Contains(pathfn, "/"+dir+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// validateLockfileManifest records the manifests declaring dependencies without a lockfile
+// in their directory or one of its parents, e.g., the root of a workspace.
+var validateLockfileManifest fileparser.DoWhileTrueOnFileContent = func(
+	pathfn string,
+	content []byte,
+	args ...interface{},
+) (bool, error) {
+	if len(args) != 3 {
+		return false, fmt.Errorf(
+			"validateLockfileManifest requires exactly 3 arguments: got %v: %w", len(args), errInvalidArgLength)
+	}
+	pdata := dataAsPinnedDependenciesPointer(args[0])
+	m, ok := args[1].(*lockfileManifest)
+	if !ok {
+		return false, fmt.Errorf("validateLockfileManifest expects arg[1] of type *lockfileManifest: %w",
+			errInvalidArgType)
+	}
+	files, ok := args[2].(map[string]bool)
+	if !ok {
+		return false, fmt.Errorf("validateLockfileManifest expects arg[2] of type map[string]bool: %w",
+			errInvalidArgType)
+	}
+
+	if isVendoredPath(pathfn) || !m.needsLockfile(pathfn, content, files) {
+		return true, nil
+	}
+	if hasLockfile(pathfn, m.lockfiles, files) {
+		return true, nil
+	}
+
+	pdata.Dependencies = append(pdata.Dependencies, checker.Dependency{
+		Location: &checker.File{
+			Path:      pathfn,
+			Type:      finding.FileTypeSource,
+			Offset:    1,
+			EndOffset: 1,
+			Snippet:   path.Base(pathfn),
+		},
+		Name:   asPointer(pathfn),
+		Pinned: asBoolPointer(false),
+		Type:   m.depType,
+	})
+	return true, nil
+}
+
+func hasLockfile(pathfn string, lockfiles []string, files map[string]bool) bool {
+	for dir := path.Dir(pathfn); ; dir = path.Dir(dir) {
+		for _, lockfile := range lockfiles {
+			if files[path.Join(dir, lockfile)] {
+				return true
+			}
+		}
+		if dir == "." || dir == "/" {
+			return false
+		}
+	}
+}
+
+// validateLockfileIntegrity records the lockfiles, which are pinned
+// if all the packages they lock are verified with an integrity hash.
+var validateLockfileIntegrity fileparser.DoWhileTrueOnFileContent = func(
+	pathfn string,
+	content []byte,
+	args ...interface{},
+) (bool, error) {
+	if len(args) != 2 {
+		return false, fmt.Errorf(
+			"validateLockfileIntegrity requires exactly 2 arguments: got %v: %w", len(args), errInvalidArgLength)
+	}
+	pdata := dataAsPinnedDependenciesPointer(args[0])
+	p, ok := args[1].(*lockfileParser)
+	if !ok {
+		return false, fmt.Errorf("validateLockfileIntegrity expects arg[1] of type *lockfileParser: %w",
+			errInvalidArgType)
+	}
+
+	if isVendoredPath(pathfn) {
+		return true, nil
+	}
+
+	unhashed, err := p.unhashedPackages(content)
+	if err != nil {
+		pdata.Dependencies = append(pdata.Dependencies, checker.Dependency{
+			Location: &checker.File{
+				Path: pathfn,
+				Type: finding.FileTypeSource,
+			},
+			Msg:  asPointer(fmt.Sprintf("cannot parse lockfile: %v", err)),
+			Type: p.depType,
+		})
+		return true, nil
+	}
+
+	dep := checker.Dependency{
+		Location: &checker.File{
+			Path:      pathfn,
+			Type:      finding.FileTypeSource,
+			Offset:    1,
+			EndOffset: 1,
+			Snippet:   path.Base(pathfn),
+		},
+		Name:     asPointer(pathfn),
+		PinnedAt: asPointer(path.Base(pathfn)),
+		Pinned:   asBoolPointer(len(unhashed) == 0),
+		Type:     p.depType,
+	}
+	// Point to the first package that is not verified.
+	if len(unhashed) > 0 {
+		line := lineContaining(content, unhashed[0])
+		dep.Location.Offset = line
+		dep.Location.EndOffset = line
+		dep.Location.Snippet = unhashed[0]
+	}
+	pdata.Dependencies = append(pdata.Dependencies, dep)
+	return true, nil
+}
+
+// lineContaining returns the first line containing s, or 1 if it is not found.
+func lineContaining(content []byte, s string) uint {
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	scanner.Buffer(nil, len(content)+1)
+	for line := uint(1); scanner.Scan(); line++ {
+		if strings.Contains(scanner.Text(), s) {
+			return line
+		}
+	}
+	return 1
+}
+
+func npmManifestNeedsLockfile(pathfn string, content []byte, files map[string]bool) bool {
+	var pkg struct {
+		Dependencies         map[string]interface{} `json:"dependencies"`
+		DevDependencies      map[string]interface{} `json:"devDependencies"`
+		OptionalDependencies map[string]interface{} `json:"optionalDependencies"`
+	}
+	if err := json.Unmarshal(content, &pkg); err != nil {
+		return false
+	}
+	return len(pkg.Dependencies)+len(pkg.DevDependencies)+len(pkg.OptionalDependencies) > 0
+}
+
+func pyprojectNeedsLockfile(pathfn string, content []byte, files map[string]bool) bool {
+	var pyproject struct {
+		Project struct {
+			Dependencies         []string            `toml:"dependencies"`
+			OptionalDependencies map[string][]string `toml:"optional-dependencies"`
+		} `toml:"project"`
+		DependencyGroups map[string][]interface{} `toml:"dependency-groups"`
+		Tool             struct {
+			Poetry struct {
+				Dependencies map[string]interface{} `toml:"dependencies"`
+				Group        map[string]struct {
+					Dependencies map[string]interface{} `toml:"dependencies"`
+				} `toml:"group"`
+			} `toml:"poetry"`
+		} `toml:"tool"`
+	}
+	if _, err := toml.Decode(string(content), &pyproject); err != nil {
+		return false
+	}
+
+	if len(pyproject.Project.Dependencies) > 0 || len(pyproject.Project.OptionalDependencies) > 0 ||
+		len(pyproject.DependencyGroups) > 0 {
+		return true
+	}
+	// Poetry lists the supported Python versions as a dependency.
+	for name := range pyproject.Tool.Poetry.Dependencies {
+		if name != "python" {
+			return true
+		}
+	}
+	for _, group := range pyproject.Tool.Poetry.Group {
+		if len(group.Dependencies) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func pipfileNeedsLockfile(pathfn string, content []byte, files map[string]bool) bool {
+	var pipfile struct {
+		Packages    map[string]interface{} `toml:"packages"`
+		DevPackages map[string]interface{} `toml:"dev-packages"`
+	}
+	if _, err := toml.Decode(string(content), &pipfile); err != nil {
+		return false
+	}
+	return len(pipfile.Packages)+len(pipfile.DevPackages) > 0
+}
+
+// cargoManifestNeedsLockfile returns whether the manifest is the one of a binary crate:
+// Cargo.lock is only expected to be committed for binaries.
+// https://doc.rust-lang.org/cargo/faq.html#why-have-cargolock-in-version-control.
+func cargoManifestNeedsLockfile(pathfn string, content []byte, files map[string]bool) bool {
+	var manifest struct {
+		Package map[string]interface{}   `toml:"package"`
+		Bin     []map[string]interface{} `toml:"bin"`
+	}
+	if _, err := toml.Decode(string(content), &manifest); err != nil {
+		return false
+	}
+	// Virtual manifests of workspaces are checked through their members.
+	if manifest.Package == nil {
+		return false
+	}
+	if len(manifest.Bin) > 0 {
+		return true
+	}
+	dir := path.Dir(pathfn)
+	if files[path.Join(dir, "src/main.rs")] {
+		return true
+	}
+	for fn := range files {
+		if strings.HasPrefix(fn, path.Join(dir, "src/bin")+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func gemfileNeedsLockfile(pathfn string, content []byte, files map[string]bool) bool {
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "gem ") || line == "gemspec" || strings.HasPrefix(line, "gemspec ") {
+			return true
+		}
+	}
+	return false
+}
+
+// https://docs.npmjs.com/cli/v9/configuring-npm/package-lock-json.
+func unhashedNpmPackages(content []byte) ([]string, error) {
+	type npmLockedDependency struct {
+		Dependencies map[string]json.RawMessage `json:"dependencies"`
+		Resolved     string                     `json:"resolved"`
+		Integrity    string                     `json:"integrity"`
+	}
+	var lock struct {
+		Packages     map[string]npmLockedDependency `json:"packages"`
+		Dependencies map[string]json.RawMessage     `json:"dependencies"`
+	}
+	if err := json.Unmarshal(content, &lock); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	// Packages fetched from a registry are verified by their integrity. Local packages
+	// are part of the repository, and git packages are pinned by their commit.
+	isUnhashed := func(d *npmLockedDependency) bool {
+		return d.Integrity == "" && (strings.HasPrefix(d.Resolved, "https://") || strings.HasPrefix(d.Resolved, "http://"))
+	}
+
+	var unhashed []string
+	// Lockfile versions 2 and 3.
+	if len(lock.Packages) > 0 {
+		for name := range lock.Packages {
+			p := lock.Packages[name]
+			if isUnhashed(&p) {
+				unhashed = append(unhashed, name)
+			}
+		}
+		return sortedStrings(unhashed), nil
+	}
+
+	// Lockfile version 1.
+	var walk func(deps map[string]json.RawMessage) error
+	walk = func(deps map[string]json.RawMessage) error {
+		for name, raw := range deps {
+			var d npmLockedDependency
+			if err := json.Unmarshal(raw, &d); err != nil {
+				return fmt.Errorf("%w", err)
+			}
+			if isUnhashed(&d) {
+				unhashed = append(unhashed, name)
+			}
+			if err := walk(d.Dependencies); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := walk(lock.Dependencies); err != nil {
+		return nil, err
+	}
+	return sortedStrings(unhashed), nil
+}
+
+// unhashedYarnPackages supports yarn v1 lockfiles, whose resolved URLs contain the
+// package's sha1 when the integrity is missing, and yarn berry lockfiles.
+func unhashedYarnPackages(content []byte) ([]string, error) {
+	var unhashed []string
+	var name, resolved, resolution string
+	hashed := false
+	flush := func() {
+		switch {
+		case name == "" || hashed:
+		// Yarn v1.
+		case strings.HasPrefix(resolved, "http") && !strings.Contains(resolved, "#"):
+			unhashed = append(unhashed, name)
+		// Yarn berry: workspaces, links and patches are not fetched from a registry.
+		case strings.Contains(resolution, "@npm:"):
+			unhashed = append(unhashed, name)
+		}
+		name, resolved, resolution, hashed = "", "", "", false
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case trimmed == "" || strings.HasPrefix(trimmed, "#"):
+			continue
+		case !strings.HasPrefix(line, " "):
+			flush()
+			if !strings.HasPrefix(trimmed, "__metadata") {
+				name = strings.Trim(strings.TrimSuffix(trimmed, ":"), `"`)
+			}
+		case strings.HasPrefix(trimmed, "integrity ") || strings.HasPrefix(trimmed, "checksum:"):
+			hashed = true
+		case strings.HasPrefix(trimmed, "resolved "):
+			resolved = strings.Trim(strings.TrimPrefix(trimmed, "resolved "), `"`)
+		case strings.HasPrefix(trimmed, "resolution:"):
+			resolution = strings.Trim(strings.TrimSpace(strings.TrimPrefix(trimmed, "resolution:")), `"`)
+		}
+	}
+	flush()
+	return unhashed, nil
+}
+
+// https://pnpm.io/git#lockfiles.
+func unhashedPnpmPackages(content []byte) ([]string, error) {
+	var lock struct {
+		Packages map[string]struct {
+			Resolution struct {
+				Integrity string `yaml:"integrity"`
+				Tarball   string `yaml:"tarball"`
+			} `yaml:"resolution"`
+		} `yaml:"packages"`
+	}
+	if err := yaml.Unmarshal(content, &lock); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	var unhashed []string
+	for name, p := range lock.Packages {
+		// Local packages have no tarball, and git packages are fetched at a commit.
+		if p.Resolution.Integrity == "" && strings.HasPrefix(p.Resolution.Tarball, "http") &&
+			!commitRegex.MatchString(p.Resolution.Tarball) {
+			unhashed = append(unhashed, name)
+		}
+	}
+	return sortedStrings(unhashed), nil
+}
+
+// unhashedPythonPackages supports poetry, uv and pdm lockfiles, which list the hashes of
+// the files of each package.
+func unhashedPythonPackages(content []byte) ([]string, error) {
+	var lock struct {
+		Package  []map[string]interface{} `toml:"package"`
+		Metadata struct {
+			// Poetry 1.x lockfiles list the hashes separately.
+			Files map[string]interface{} `toml:"files"`
+		} `toml:"metadata"`
+	}
+	if _, err := toml.Decode(string(content), &lock); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
+	var unhashed []string
+	for _, p := range lock.Package {
+		name, _ := p["name"].(string)
+		if isLocalOrVCSPythonPackage(p) || hasHash(p) || hasHash(lock.Metadata.Files[name]) {
+			continue
+		}
+		unhashed = append(unhashed, name)
+	}
+	return unhashed, nil
+}
+
+// isLocalOrVCSPythonPackage returns whether the package is part of the repository
+// or fetched from a version control system, which pins it by commit.
+func isLocalOrVCSPythonPackage(p map[string]interface{}) bool {
+	keys := []string{"path", "editable", "virtual", "directory", "git"}
+	source, ok := p["source"].(map[string]interface{})
+	if ok {
+		// Poetry.
+		if t, ok := source["type"].(string); ok && t != "url" && t != "legacy" {
+			return true
+		}
+		// uv.
+		for _, k := range keys {
+			if _, ok := source[k]; ok {
+				return true
+			}
+		}
+	}
+	// pdm.
+	for _, k := range keys {
+		if _, ok := p[k]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// hasHash returns whether the TOML value contains a non-empty `hash` key.
+func hasHash(v interface{}) bool {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if h, ok := v["hash"].(string); ok && h != "" {
+			return true
+		}
+		for _, e := range v {
+			if hasHash(e) {
+				return true
+			}
+		}
+	case []map[string]interface{}:
+		for _, e := range v {
+			if hasHash(e) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, e := range v {
+			if hasHash(e) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// https://pipenv.pypa.io/en/latest/pipfile.html#pipfile-lock-security-features.
+func unhashedPipfilePackages(content []byte) ([]string, error) {
+	type pipfileLockedPackage struct {
+		Git      string   `json:"git"`
+		Path     string   `json:"path"`
+		File     string   `json:"file"`
+		Hashes   []string `json:"hashes"`
+		Editable bool     `json:"editable"`
+	}
+	var lock struct {
+		Default map[string]pipfileLockedPackage `json:"default"`
+		Develop map[string]pipfileLockedPackage `json:"develop"`
+	}
+	if err := json.Unmarshal(content, &lock); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	var unhashed []string
+	for _, packages := range []map[string]pipfileLockedPackage{lock.Default, lock.Develop} {
+		for name, p := range packages {
+			if len(p.Hashes) == 0 && p.Git == "" && p.Path == "" && p.File == "" && !p.Editable {
+				unhashed = append(unhashed, name)
+			}
+		}
+	}
+	return sortedStrings(unhashed), nil
+}
+
+// https://doc.rust-lang.org/cargo/guide/cargo-toml-vs-cargo-lock.html.
+func unhashedCargoPackages(content []byte) ([]string, error) {
+	var lock struct {
+		Package []struct {
+			Name     string `toml:"name"`
+			Source   string `toml:"source"`
+			Checksum string `toml:"checksum"`
+		} `toml:"package"`
+	}
+	if _, err := toml.Decode(string(content), &lock); err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	var unhashed []string
+	for _, p := range lock.Package {
+		// Path dependencies have no source, and git dependencies are pinned by commit.
+		if p.Checksum == "" && (strings.HasPrefix(p.Source, "registry+") || strings.HasPrefix(p.Source, "sparse+")) {
+			unhashed = append(unhashed, p.Name)
+		}
+	}
+	return unhashed, nil
+}
+
+// unhashedGems returns the gems fetched from a gem server when the lockfile does not
+// have a CHECKSUMS section, which was added in Bundler 2.5.
+func unhashedGems(content []byte) ([]string, error) {
+	var unhashed []string
+	section := ""
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == "CHECKSUMS":
+			return nil, nil
+		case line != "" && !strings.HasPrefix(line, " "):
+			section = line
+		// Specs are indented by 4 spaces, and their dependencies by 6.
+		case section == "GEM" && strings.HasPrefix(line, "    ") && !strings.HasPrefix(line, "     "):
+			name, _, _ := strings.Cut(strings.TrimSpace(line), " ")
+			unhashed = append(unhashed, name)
+		}
+	}
+	return unhashed, nil
+}
+
+func sortedStrings(s []string) []string {
+	sort.Strings(s)
+	return s
+}
@@ -0,0 +1,250 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v4/checker"
+)
+
+type lockfileDependencyResult struct {
+	Name    string
+	Snippet string
+	Type    checker.DependencyUseType
+	Line    uint
+	Pinned  bool
+}
+
+func testdataFiles(t *testing.T, dir string) map[string]bool {
+	t.Helper()
+	files := make(map[string]bool)
+	err := filepath.Walk(dir, func(fn string, info os.FileInfo, err error) error {
+		if err == nil && !info.IsDir() {
+			files[strings.TrimPrefix(filepath.ToSlash(fn), "testdata/")] = true
+		}
+		return err
+	})
+	if err != nil {
+		t.Fatalf("cannot list files: %v", err)
+	}
+	return files
+}
+
+func TestLockfilePinning(t *testing.T) {
+	t.Parallel()
+
+	files := testdataFiles(t, "testdata/lockfiles")
+	var r checker.PinningDependenciesData
+	for i := range lockfileManifests {
+		for fn := range files {
+			if filepath.Base(fn) != lockfileManifests[i].name {
+				continue
+			}
+			content, err := os.ReadFile(filepath.Join("testdata", fn))
+			if err != nil {
+				t.Fatalf("cannot read file: %v", err)
+			}
+			if _, err := validateLockfileManifest(fn, content, &r, &lockfileManifests[i], files); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+	}
+	for i := range lockfileParsers {
+		for fn := range files {
+			if filepath.Base(fn) != lockfileParsers[i].name {
+				continue
+			}
+			content, err := os.ReadFile(filepath.Join("testdata", fn))
+			if err != nil {
+				t.Fatalf("cannot read file: %v", err)
+			}
+			if _, err := validateLockfileIntegrity(fn, content, &r, &lockfileParsers[i]); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+	}
+
+	got := make(map[string]lockfileDependencyResult)
+	for _, d := range r.Dependencies {
+		if d.Msg != nil {
+			t.Errorf("unexpected message: %s", *d.Msg)
+			continue
+		}
+		got[*d.Name] = lockfileDependencyResult{
+			Name:    *d.Name,
+			Snippet: d.Location.Snippet,
+			Type:    d.Type,
+			Line:    d.Location.Offset,
+			Pinned:  *d.Pinned,
+		}
+	}
+
+	want := map[string]lockfileDependencyResult{
+		"lockfiles/lib/package.json": {
+			Snippet: "package.json",
+			Type:    checker.DependencyUseTypeNpmLockfile,
+			Line:    1,
+		},
+		"lockfiles/python/Pipfile": {
+			Snippet: "Pipfile",
+			Type:    checker.DependencyUseTypePythonLockfile,
+			Line:    1,
+		},
+		"lockfiles/app/package-lock.json": {
+			Snippet: "node_modules/left-pad",
+			Type:    checker.DependencyUseTypeNpmLockfile,
+			Line:    20,
+		},
+		"lockfiles/app/yarn.lock": {
+			Snippet: "left-pad@1.3.0",
+			Type:    checker.DependencyUseTypeNpmLockfile,
+			Line:    10,
+		},
+		"lockfiles/app/pnpm-lock.yaml": {
+			Snippet: "/left-pad@1.3.0",
+			Type:    checker.DependencyUseTypeNpmLockfile,
+			Line:    15,
+		},
+		"lockfiles/python/poetry.lock": {
+			Snippet: "urllib3",
+			Type:    checker.DependencyUseTypePythonLockfile,
+			Line:    26,
+		},
+		"lockfiles/rust/Cargo.lock": {
+			Snippet: "unchecked",
+			Type:    checker.DependencyUseTypeCargoLockfile,
+			Line:    19,
+		},
+		"lockfiles/ruby/Gemfile.lock": {
+			Snippet: "actionpack",
+			Type:    checker.DependencyUseTypeGemLockfile,
+			Line:    4,
+		},
+		"lockfiles/ruby/gems.locked": {
+			Snippet: "gems.locked",
+			Type:    checker.DependencyUseTypeGemLockfile,
+			Line:    1,
+			Pinned:  true,
+		},
+	}
+	for name, w := range want {
+		w.Name = name
+		want[name] = w
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestLockfileParseError(t *testing.T) {
+	t.Parallel()
+
+	var r checker.PinningDependenciesData
+	p := lockfileParser{
+		name:             "Cargo.lock",
+		depType:          checker.DependencyUseTypeCargoLockfile,
+		unhashedPackages: unhashedCargoPackages,
+	}
+	if _, err := validateLockfileIntegrity("Cargo.lock", []byte("[[package"), &r, &p); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(r.Dependencies) != 1 || r.Dependencies[0].Msg == nil {
+		t.Errorf("expected a dependency with a message, got %v", r.Dependencies)
+	}
+}
+
+func TestPipRequirementsPinning(t *testing.T) {
+	t.Parallel()
+
+	fn := "./testdata/lockfiles/python/requirements.txt"
+	content, err := os.ReadFile(fn)
+	if err != nil {
+		t.Fatalf("cannot read file: %v", err)
+	}
+	var r checker.PinningDependenciesData
+	if _, err := validatePipRequirements(strings.TrimPrefix(fn, "./testdata/"), content, &r); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	type requirementResult struct {
+		Name     string
+		PinnedAt string
+		Line     uint
+		EndLine  uint
+		Pinned   bool
+	}
+	var got []requirementResult
+	for _, d := range r.Dependencies {
+		if d.Type != checker.DependencyUseTypePipRequirement {
+			t.Errorf("unexpected type: %s", d.Type)
+		}
+		res := requirementResult{
+			Name:    *d.Name,
+			Line:    d.Location.Offset,
+			EndLine: d.Location.EndOffset,
+			Pinned:  *d.Pinned,
+		}
+		if d.PinnedAt != nil {
+			res.PinnedAt = *d.PinnedAt
+		}
+		got = append(got, res)
+	}
+
+	want := []requirementResult{
+		{Name: "requests", PinnedAt: "2.31.0", Line: 2, EndLine: 3, Pinned: true},
+		{Name: "urllib3", PinnedAt: "2.0.7", Line: 6, EndLine: 6},
+		{Name: "flask", Line: 7, EndLine: 7},
+		{
+			Name:    "git+https://github.com/org/pkg.git@0123456789abcdef0123456789abcdef01234567#egg=pkg",
+			Line:    8,
+			EndLine: 8,
+			Pinned:  true,
+		},
+		{Name: "git+https://github.com/org/other.git@main#egg=other", Line: 9, EndLine: 9},
+		{Name: "pkg", Line: 12, EndLine: 12, Pinned: true},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestIsRequirementsFile(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		filename string
+		want     bool
+	}{
+		{filename: "requirements.txt", want: true},
+		{filename: "docs/requirements-dev.txt", want: true},
+		{filename: "requirements/test.txt", want: true},
+		{filename: "requirements.in", want: false},
+		{filename: "notes.txt", want: false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.filename, func(t *testing.T) {
+			t.Parallel()
+			if got := isRequirementsFile(tt.filename); got != tt.want {
+				t.Errorf("isRequirementsFile(%q) = %v, want %v", tt.filename, got, tt.want)
+			}
+		})
+	}
+}
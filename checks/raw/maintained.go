@@ -15,9 +15,14 @@
 package raw
 
 import (
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/clients"
 )
 
 // Maintained checks for maintenance.
@@ -51,5 +56,147 @@ func Maintained(c *checker.CheckRequest) (checker.MaintainedData, error) {
 	}
 	result.CreatedAt = createdAt
 
+	// Recent pull requests. They only complete the tracked items:
+	// if they cannot be listed, only the issues are tracked.
+	prs, err := c.RepoClient.ListPullRequests()
+	if err != nil {
+		if !errors.Is(err, clients.ErrUnsupportedFeature) && c.Dlogger != nil {
+			c.Dlogger.Debug(&checker.LogMessage{
+				Text: fmt.Sprintf("pull requests could not be listed: %v", err),
+			})
+		}
+		prs = nil
+	}
+
+	if len(issues) == 0 && len(prs) == 0 {
+		return result, nil
+	}
+	members := orgMembers(c)
+	for i := range issues {
+		result.TrackedItems = append(result.TrackedItems, trackedIssue(&issues[i], members))
+	}
+	for i := range prs {
+		result.TrackedItems = append(result.TrackedItems, trackedPullRequest(&prs[i], members))
+	}
+
 	return result, nil
 }
+
+// orgMembers returns the lowercase logins of the contributors who are public
+// members of the organization owning the repository. Author associations
+// hide the private memberships, so this is a best-effort complement: the
+// contributors are not available for every repository, e.g. the largest ones.
+func orgMembers(c *checker.CheckRequest) map[string]bool {
+	members := map[string]bool{}
+	if c.Repo == nil {
+		return members
+	}
+	// e.g. `github.com/ossf/scorecard`.
+	parts := strings.Split(c.Repo.URI(), "/")
+	if len(parts) < 3 {
+		return members
+	}
+	owner := parts[1]
+	contributors, err := c.RepoClient.ListContributors()
+	if err != nil {
+		return members
+	}
+	for i := range contributors {
+		for _, org := range contributors[i].Organizations {
+			if strings.EqualFold(org.Login, owner) {
+				members[strings.ToLower(contributors[i].Login)] = true
+			}
+		}
+	}
+	return members
+}
+
+func isMaintainer(user *clients.User, association *clients.RepoAssociation, members map[string]bool) bool {
+	if association != nil && association.Gte(clients.RepoAssociationCollaborator) {
+		return true
+	}
+	return user != nil && user.Login != "" && members[strings.ToLower(user.Login)]
+}
+
+func isSameUser(a, b *clients.User) bool {
+	if a == nil || b == nil {
+		return false
+	}
+	if a.Login != "" && b.Login != "" {
+		return strings.EqualFold(a.Login, b.Login)
+	}
+	// GitLab only identifies the authors of the issues by their ID.
+	return a.ID != 0 && a.ID == b.ID
+}
+
+// trackMaintainerActivity records the first response and the last activity
+// of the maintainers among the comments of an issue or a pull request.
+func trackMaintainerActivity(item *checker.TrackedItem, author *clients.User,
+	comments []clients.IssueComment, members map[string]bool,
+) {
+	if !item.External {
+		createdAt := item.CreatedAt
+		item.LastMaintainerActivity = &createdAt
+	}
+	sorted := make([]clients.IssueComment, 0, len(comments))
+	for i := range comments {
+		if comments[i].CreatedAt != nil {
+			sorted = append(sorted, comments[i])
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].CreatedAt.Before(*sorted[j].CreatedAt)
+	})
+	for i := range sorted {
+		comment := &sorted[i]
+		if !isMaintainer(comment.Author, comment.AuthorAssociation, members) {
+			continue
+		}
+		createdAt := *comment.CreatedAt
+		item.LastMaintainerActivity = &createdAt
+		if item.FirstMaintainerResponse == nil && !isSameUser(comment.Author, author) {
+			item.FirstMaintainerResponse = &createdAt
+		}
+	}
+}
+
+func trackedIssue(issue *clients.Issue, members map[string]bool) checker.TrackedItem {
+	item := checker.TrackedItem{
+		Type:     checker.TrackedItemIssue,
+		External: !isMaintainer(issue.Author, issue.AuthorAssociation, members),
+		ClosedAt: issue.ClosedAt,
+	}
+	if issue.URI != nil {
+		item.URI = *issue.URI
+	}
+	if issue.Author != nil {
+		item.Author = issue.Author.Login
+	}
+	if issue.CreatedAt != nil {
+		item.CreatedAt = *issue.CreatedAt
+	}
+	trackMaintainerActivity(&item, issue.Author, issue.Comments, members)
+	return item
+}
+
+func trackedPullRequest(pr *clients.PullRequest, members map[string]bool) checker.TrackedItem {
+	item := checker.TrackedItem{
+		Type:      checker.TrackedItemPullRequest,
+		Number:    pr.Number,
+		Author:    pr.Author.Login,
+		External:  !isMaintainer(&pr.Author, pr.AuthorAssociation, members),
+		CreatedAt: pr.CreatedAt,
+	}
+	if !pr.ClosedAt.IsZero() {
+		item.ClosedAt = timePtr(pr.ClosedAt)
+	}
+	if !pr.MergedAt.IsZero() {
+		item.MergedAt = timePtr(pr.MergedAt)
+	}
+	trackMaintainerActivity(&item, &pr.Author, pr.Comments, members)
+	return item
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
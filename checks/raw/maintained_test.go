@@ -15,15 +15,18 @@ package raw
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
 
 	"github.com/ossf/scorecard/v4/checker"
 	"github.com/ossf/scorecard/v4/clients"
 	mockrepo "github.com/ossf/scorecard/v4/clients/mockclients"
+	scut "github.com/ossf/scorecard/v4/utests"
 )
 
 func TestMaintained(t *testing.T) {
@@ -54,6 +57,7 @@ func TestMaintained(t *testing.T) {
 		mockRepoClient.EXPECT().ListCommits().Return(commits, nil)
 		mockRepoClient.EXPECT().ListIssues().Return(issues, nil)
 		mockRepoClient.EXPECT().GetCreatedAt().Return(createdAt, nil)
+		mockRepoClient.EXPECT().ListPullRequests().Return(nil, nil)
 
 		data, err := Maintained(req)
 		if err != nil {
@@ -119,3 +123,214 @@ func TestMaintained(t *testing.T) {
 		}
 	})
 }
+
+var errListPullRequests = errors.New("pull requests")
+
+func associationPtr(r clients.RepoAssociation) *clients.RepoAssociation {
+	return &r
+}
+
+func TestMaintainedResponsiveness(t *testing.T) {
+	t.Parallel()
+	created := time.Date(2023, time.July, 1, 0, 0, 0, 0, time.UTC)
+	at := func(hours int) *time.Time {
+		t := created.Add(time.Duration(hours) * time.Hour)
+		return &t
+	}
+	someone := &clients.User{Login: "someone"}
+	maintainer := &clients.User{Login: "maintainer"}
+	member := &clients.User{Login: "member"}
+	//nolint:govet
+	tests := []struct {
+		name         string
+		issues       []clients.Issue
+		prs          []clients.PullRequest
+		prErr        error
+		contributors []clients.User
+		want         []checker.TrackedItem
+		wantLogs     int
+	}{
+		{
+			name: "external issue with responses",
+			issues: []clients.Issue{
+				{
+					URI:               asPointer("https://github.com/ossf/scorecard/issues/1"),
+					CreatedAt:         &created,
+					Author:            someone,
+					AuthorAssociation: associationPtr(clients.RepoAssociationNone),
+					Comments: []clients.IssueComment{
+						// Comments are not necessarily sorted.
+						{CreatedAt: at(30), Author: maintainer, AuthorAssociation: associationPtr(clients.RepoAssociationOwner)},
+						{CreatedAt: at(5), Author: maintainer, AuthorAssociation: associationPtr(clients.RepoAssociationOwner)},
+						{CreatedAt: at(1), Author: someone, AuthorAssociation: associationPtr(clients.RepoAssociationNone)},
+					},
+				},
+			},
+			want: []checker.TrackedItem{
+				{
+					Type:                    checker.TrackedItemIssue,
+					URI:                     "https://github.com/ossf/scorecard/issues/1",
+					Author:                  "someone",
+					External:                true,
+					CreatedAt:               created,
+					FirstMaintainerResponse: at(5),
+					LastMaintainerActivity:  at(30),
+				},
+			},
+		},
+		{
+			name: "issue opened by a maintainer",
+			issues: []clients.Issue{
+				{
+					URI:               asPointer("https://github.com/ossf/scorecard/issues/2"),
+					CreatedAt:         &created,
+					ClosedAt:          at(48),
+					Author:            maintainer,
+					AuthorAssociation: associationPtr(clients.RepoAssociationCollaborator),
+					Comments: []clients.IssueComment{
+						{CreatedAt: at(2), Author: maintainer, AuthorAssociation: associationPtr(clients.RepoAssociationCollaborator)},
+					},
+				},
+			},
+			want: []checker.TrackedItem{
+				{
+					Type:                   checker.TrackedItemIssue,
+					URI:                    "https://github.com/ossf/scorecard/issues/2",
+					Author:                 "maintainer",
+					CreatedAt:              created,
+					LastMaintainerActivity: at(2),
+					ClosedAt:               at(48),
+				},
+			},
+		},
+		{
+			name: "public member of the organization",
+			issues: []clients.Issue{
+				{
+					URI:               asPointer("https://github.com/ossf/scorecard/issues/3"),
+					CreatedAt:         &created,
+					Author:            someone,
+					AuthorAssociation: associationPtr(clients.RepoAssociationContributor),
+					Comments: []clients.IssueComment{
+						{CreatedAt: at(3), Author: member, AuthorAssociation: associationPtr(clients.RepoAssociationContributor)},
+					},
+				},
+			},
+			contributors: []clients.User{
+				{Login: "Member", Organizations: []clients.User{{Login: "ossf"}}},
+				{Login: "someone", Organizations: []clients.User{{Login: "other"}}},
+			},
+			want: []checker.TrackedItem{
+				{
+					Type:                    checker.TrackedItemIssue,
+					URI:                     "https://github.com/ossf/scorecard/issues/3",
+					Author:                  "someone",
+					External:                true,
+					CreatedAt:               created,
+					FirstMaintainerResponse: at(3),
+					LastMaintainerActivity:  at(3),
+				},
+			},
+		},
+		{
+			name: "pull requests",
+			prs: []clients.PullRequest{
+				{
+					Number:            10,
+					Author:            *someone,
+					AuthorAssociation: associationPtr(clients.RepoAssociationFirstTimeContributor),
+					CreatedAt:         created,
+					ClosedAt:          *at(72),
+					MergedAt:          *at(72),
+					Comments: []clients.IssueComment{
+						{CreatedAt: at(24), Author: maintainer, AuthorAssociation: associationPtr(clients.RepoAssociationMember)},
+					},
+				},
+				{
+					Number:            11,
+					Author:            *someone,
+					AuthorAssociation: associationPtr(clients.RepoAssociationContributor),
+					CreatedAt:         created,
+				},
+			},
+			want: []checker.TrackedItem{
+				{
+					Type:                    checker.TrackedItemPullRequest,
+					Number:                  10,
+					Author:                  "someone",
+					External:                true,
+					CreatedAt:               created,
+					FirstMaintainerResponse: at(24),
+					LastMaintainerActivity:  at(24),
+					ClosedAt:                at(72),
+					MergedAt:                at(72),
+				},
+				{
+					Type:      checker.TrackedItemPullRequest,
+					Number:    11,
+					Author:    "someone",
+					External:  true,
+					CreatedAt: created,
+				},
+			},
+		},
+		{
+			name:  "pull requests not supported",
+			prErr: clients.ErrUnsupportedFeature,
+		},
+		{
+			name: "pull requests error",
+			issues: []clients.Issue{
+				{
+					URI:               asPointer("https://github.com/ossf/scorecard/issues/3"),
+					CreatedAt:         &created,
+					Author:            someone,
+					AuthorAssociation: associationPtr(clients.RepoAssociationNone),
+				},
+			},
+			prErr: errListPullRequests,
+			want: []checker.TrackedItem{
+				{
+					Type:      checker.TrackedItemIssue,
+					URI:       "https://github.com/ossf/scorecard/issues/3",
+					Author:    "someone",
+					External:  true,
+					CreatedAt: created,
+				},
+			},
+			wantLogs: 1,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			mockRepo := mockrepo.NewMockRepo(ctrl)
+			mockRepo.EXPECT().URI().Return("github.com/ossf/scorecard").AnyTimes()
+			mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+			mockRepoClient.EXPECT().IsArchived().Return(false, nil)
+			mockRepoClient.EXPECT().ListCommits().Return(nil, nil)
+			mockRepoClient.EXPECT().ListIssues().Return(tt.issues, nil)
+			mockRepoClient.EXPECT().GetCreatedAt().Return(created, nil)
+			mockRepoClient.EXPECT().ListPullRequests().Return(tt.prs, tt.prErr)
+			mockRepoClient.EXPECT().ListContributors().Return(tt.contributors, nil).AnyTimes()
+
+			dl := scut.TestDetailLogger{}
+			got, err := Maintained(&checker.CheckRequest{
+				Repo:       mockRepo,
+				RepoClient: mockRepoClient,
+				Dlogger:    &dl,
+			})
+			if err != nil {
+				t.Fatalf("Maintained() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got.TrackedItems); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+			if logs := dl.Flush(); len(logs) != tt.wantLogs {
+				t.Errorf("unexpected logs: %v", logs)
+			}
+		})
+	}
+}
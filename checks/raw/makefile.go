@@ -0,0 +1,227 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/checks/fileparser"
+)
+
+// maxMakeExpansionDepth limits the expansion of variables referencing other variables.
+const maxMakeExpansionDepth = 10
+
+var (
+	// https://www.gnu.org/software/make/manual/html_node/Setting.html.
+	makeAssignRegex = regexp.MustCompile(
+		`^(?:override\s+|export\s+)*([\w.-]+)\s*(:::=|::=|:=|\?=|\+=|!=|=)\s*(.*)$`)
+	makeConditionals      = []string{"ifeq", "ifneq", "ifdef", "ifndef", "else", "endif"}
+	makeIgnoredExtensions = []string{
+		".in", ".go", ".py", ".js", ".ts", ".rs", ".java", ".c", ".h", ".md", ".txt",
+	}
+)
+
+// makeRecipe is a recipe line of a Makefile rule.
+type makeRecipe struct {
+	command   string
+	startLine uint
+	endLine   uint
+}
+
+// makefile holds the recipes and variables of a Makefile.
+type makefile struct {
+	vars    map[string]string
+	recipes []makeRecipe
+}
+
+func isMakefile(pathfn string) bool {
+	base := strings.ToLower(path.Base(pathfn))
+	switch {
+	case base == "makefile", base == "gnumakefile":
+		return true
+	case strings.HasSuffix(base, ".mk"), strings.HasSuffix(base, ".mak"):
+		return true
+	// Makefile.am, Makefile.common, but not generated Makefile.in files or source files.
+	case strings.HasPrefix(base, "makefile."):
+		return !isOneOf(path.Ext(base), makeIgnoredExtensions) && !fileparser.IsTemplateFile(pathfn)
+	}
+	return false
+}
+
+// parseMakefile extracts the recipes of the rules of a Makefile.
+// Recipe lines continued with a backslash are joined into a single line.
+func parseMakefile(content []byte) makefile {
+	mk := makefile{vars: map[string]string{}}
+	lines := strings.Split(strings.ReplaceAll(string(content), "\r\n", "\n"), "\n")
+	inRule := false
+	inDefine := false
+	for i := 0; i < len(lines); i++ {
+		start := uint(i + 1)
+		isRecipe := inRule && strings.HasPrefix(lines[i], "\t")
+		line := lines[i]
+		for strings.HasSuffix(line, `\`) && i+1 < len(lines) {
+			i++
+			if isRecipe {
+				// The backslash-newline is handed to the shell, which joins the lines.
+				line = strings.TrimSuffix(line, `\`) + strings.TrimPrefix(lines[i], "\t")
+			} else {
+				line = strings.TrimSuffix(line, `\`) + " " + strings.TrimLeft(lines[i], " \t")
+			}
+		}
+		end := uint(i + 1)
+
+		trimmed := strings.TrimSpace(line)
+		fields := strings.Fields(trimmed)
+		switch {
+		case inDefine:
+			inDefine = len(fields) == 0 || fields[0] != "endef"
+		case isRecipe:
+			// `@`, `-` and `+` prefixes control how make runs the recipe.
+			command := strings.TrimLeft(line, "\t @-+")
+			if command != "" {
+				mk.recipes = append(mk.recipes, makeRecipe{
+					command:   mk.expand(command, 0),
+					startLine: start,
+					endLine:   end,
+				})
+			}
+		case len(fields) == 0 || strings.HasPrefix(trimmed, "#"):
+			continue
+		case fields[0] == "define":
+			inDefine = true
+			inRule = false
+		case isOneOf(fields[0], makeConditionals):
+			continue
+		case makeAssignRegex.MatchString(trimmed):
+			mk.assign(makeAssignRegex.FindStringSubmatch(trimmed))
+			inRule = false
+		case strings.Contains(trimmed, ":"):
+			target, prerequisites, _ := strings.Cut(trimmed, ":")
+			// Target-specific variables, e.g., `build: GOFLAGS = -mod=mod`.
+			if strings.Contains(prerequisites, "=") && !strings.Contains(prerequisites, ";") {
+				continue
+			}
+			inRule = target != ""
+			// `target: prerequisites ; recipe`.
+			if _, command, ok := strings.Cut(prerequisites, ";"); ok && strings.TrimSpace(command) != "" {
+				mk.recipes = append(mk.recipes, makeRecipe{
+					command:   mk.expand(strings.TrimLeft(command, " \t@-+"), 0),
+					startLine: start,
+					endLine:   end,
+				})
+			}
+		default:
+			inRule = false
+		}
+	}
+	return mk
+}
+
+func (mk *makefile) assign(m []string) {
+	name, op, value := m[1], m[2], m[3]
+	switch op {
+	case "?=":
+		if _, ok := mk.vars[name]; !ok {
+			mk.vars[name] = value
+		}
+	case "+=":
+		mk.vars[name] = strings.TrimSpace(mk.vars[name] + " " + value)
+	case "!=":
+		// The value is the output of a shell command.
+		delete(mk.vars, name)
+	default:
+		mk.vars[name] = value
+	}
+}
+
+// expand replaces the references to the Makefile's variables by their values.
+// `$$` is replaced by `$`, and references to unknown variables or functions are kept as is.
+func (mk *makefile) expand(s string, depth int) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '$' || i+1 >= len(s) {
+			b.WriteByte(s[i])
+			continue
+		}
+		switch s[i+1] {
+		case '$':
+			b.WriteByte('$')
+			i++
+			continue
+		case '(', '{':
+			closing := byte(')')
+			if s[i+1] == '{' {
+				closing = '}'
+			}
+			if j := strings.IndexByte(s[i+2:], closing); j >= 0 && depth < maxMakeExpansionDepth {
+				if v, ok := mk.vars[s[i+2:i+2+j]]; ok {
+					b.WriteString(mk.expand(v, depth+1))
+					i += j + 2
+					continue
+				}
+			}
+		}
+		b.WriteByte('$')
+	}
+	return b.String()
+}
+
+// usesPowerShell returns whether the recipes are run by PowerShell, e.g., `SHELL := pwsh.exe`.
+func (mk *makefile) usesPowerShell() bool {
+	shell, ok := mk.vars["SHELL"]
+	return ok && isOneOf(psName(mk.expand(shell, 0)), psInterpreters)
+}
+
+func collectMakefileInsecureDownloads(c *checker.CheckRequest, r *checker.PinningDependenciesData) error {
+	return fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
+		Pattern:       "*",
+		CaseSensitive: false,
+	}, validateMakefileIsFreeOfInsecureDownloads, r)
+}
+
+var validateMakefileIsFreeOfInsecureDownloads fileparser.DoWhileTrueOnFileContent = func(
+	pathfn string,
+	content []byte,
+	args ...interface{},
+) (bool, error) {
+	if len(args) != 1 {
+		return false, fmt.Errorf(
+			"validateMakefileIsFreeOfInsecureDownloads requires exactly 1 arguments: got %v: %w",
+			len(args), errInvalidArgLength)
+	}
+	pdata := dataAsPinnedDependenciesPointer(args[0])
+
+	if !isMakefile(pathfn) || !fileparser.CheckFileContainsCommands(content, "#") {
+		return true, nil
+	}
+
+	mk := parseMakefile(content)
+	// Each recipe line runs in its own shell, but they share the downloaded files.
+	taintedFiles := make(map[string]bool)
+	df := newPSDataFlow()
+	for _, recipe := range mk.recipes {
+		if mk.usesPowerShell() {
+			validatePowerShellScript(pathfn, recipe.startLine-1, recipe.endLine-1, []byte(recipe.command), df, pdata)
+			continue
+		}
+		//nolint:errcheck // Like for scripts, recipes our parser does not understand are ignored.
+		validateShellFile(pathfn, recipe.startLine-1, recipe.endLine-1, []byte(recipe.command), taintedFiles, pdata)
+	}
+	return true, nil
+}
@@ -0,0 +1,97 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v4/checker"
+)
+
+func TestMakefileDownloads(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		filename string
+		want     []scriptDependencyResult
+	}{
+		{
+			filename: "testdata/makefiles/Makefile",
+			want: []scriptDependencyResult{
+				{Type: checker.DependencyUseTypePipCommand, StartLine: 9, EndLine: 9},
+				{Type: checker.DependencyUseTypePipCommand, StartLine: 10, EndLine: 10, Pinned: true},
+				{Type: checker.DependencyUseTypeDownloadThenRun, StartLine: 11, EndLine: 11},
+				{Type: checker.DependencyUseTypeDownloadThenRun, StartLine: 16, EndLine: 16},
+				{Type: checker.DependencyUseTypeGoCommand, StartLine: 17, EndLine: 19},
+				{Type: checker.DependencyUseTypeNpmCommand, StartLine: 17, EndLine: 19},
+				{Type: checker.DependencyUseTypeChocoCommand, StartLine: 22, EndLine: 22},
+			},
+		},
+		{
+			filename: "testdata/makefiles/windows.mk",
+			want: []scriptDependencyResult{
+				{Type: checker.DependencyUseTypeDownloadThenRun, StartLine: 5, EndLine: 5},
+				{Type: checker.DependencyUseTypeChocoCommand, StartLine: 6, EndLine: 6},
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.filename, func(t *testing.T) {
+			t.Parallel()
+			content, err := os.ReadFile(tt.filename)
+			if err != nil {
+				t.Fatalf("cannot read file: %v", err)
+			}
+
+			var r checker.PinningDependenciesData
+			if _, err := validateMakefileIsFreeOfInsecureDownloads(tt.filename, content, &r); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, scriptDependencyResults(t, &r)); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsMakefile(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		pathfn string
+		want   bool
+	}{
+		{pathfn: "Makefile", want: true},
+		{pathfn: "build/GNUmakefile", want: true},
+		{pathfn: "scripts/common.mk", want: true},
+		{pathfn: "Makefile.am", want: true},
+		{pathfn: "Makefile.in"},
+		{pathfn: "Makefile.template"},
+		{pathfn: "makefile.go"},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.pathfn, func(t *testing.T) {
+			t.Parallel()
+			if got := isMakefile(tt.pathfn); got != tt.want {
+				t.Errorf("isMakefile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,425 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/checks/fileparser"
+	ngt "github.com/ossf/scorecard/v4/internal/nuget"
+	pmc "github.com/ossf/scorecard/v4/internal/packagemanager"
+)
+
+// maxRegistryLookups limits the number of packages looked up in a registry,
+// e.g., for the workspaces of a monorepo.
+const maxRegistryLookups = 5
+
+// registryLookup finds the packages of the repository in a registry.
+type registryLookup struct {
+	// name returns the name of the package declared by a manifest, if any.
+	name func(content []byte) string
+	// sources returns the source URLs of a package in the registry,
+	// and whether the registry has the package at all.
+	sources func(client pmc.Client, name string) ([]string, bool, error)
+	// manifests are the patterns of the package manifests.
+	manifests []string
+}
+
+// The registries without a lookup, e.g., OCI registries, are not verified.
+var registryLookups = map[checker.PackageRegistry]registryLookup{
+	checker.PackageRegistryNpm: {
+		manifests: []string{"package.json"},
+		name:      jsonPackageName,
+		sources:   npmSources,
+	},
+	checker.PackageRegistryPyPI: {
+		manifests: []string{"pyproject.toml"},
+		name:      pyprojectPackageName,
+		sources:   pypiSources,
+	},
+	checker.PackageRegistryCratesIO: {
+		manifests: []string{"Cargo.toml"},
+		name:      cargoPackageName,
+		sources:   cratesIOSources,
+	},
+	checker.PackageRegistryNuGet: {
+		manifests: []string{"*.csproj", "*.fsproj", "*.nuspec"},
+		name:      regexPackageName(regexp.MustCompile(`<(?:PackageId|id)>\s*([^<\s]+)\s*</(?:PackageId|id)>`)),
+		sources:   nugetSources,
+	},
+	checker.PackageRegistryRubyGems: {
+		manifests: []string{"*.gemspec"},
+		name:      regexPackageName(regexp.MustCompile(`\.name\s*=\s*["']([^"']+)["']`)),
+		sources:   rubyGemsSources,
+	},
+	checker.PackageRegistryHex: {
+		// The Hex package is named after the application by default.
+		manifests: []string{"mix.exs"},
+		name:      regexPackageName(regexp.MustCompile(`\bapp:\s*:(\w+)`)),
+		sources:   hexSources,
+	},
+	checker.PackageRegistryPackagist: {
+		manifests: []string{"composer.json"},
+		name:      jsonPackageName,
+		sources:   packagistSources,
+	},
+}
+
+// registryPackage is the result of the lookup of the packages of the repository in a registry.
+type registryPackage struct {
+	verified *bool
+	name     string
+}
+
+// VerifyPublicationTargets looks up, for each publication target, the packages declared
+// by the manifests of the repository in the registry, and records whether the registry
+// has one of them with this repository as source.
+func VerifyPublicationTargets(c *checker.CheckRequest, data *checker.PackagingData, client pmc.Client) error {
+	if len(data.Targets) == 0 || c.Repo == nil {
+		return nil
+	}
+	repoURI := normalizeSourceURL(c.Repo.URI())
+	packages := map[checker.PackageRegistry]registryPackage{}
+	for i := range data.Targets {
+		t := &data.Targets[i]
+		lookup, ok := registryLookups[t.Registry]
+		if !ok {
+			continue
+		}
+		pkg, ok := packages[t.Registry]
+		if !ok {
+			var names []string
+			for _, manifest := range lookup.manifests {
+				err := fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
+					Pattern:       manifest,
+					CaseSensitive: false,
+				}, readPackageNames, &lookup, &names)
+				if err != nil {
+					return err
+				}
+			}
+			pkg = lookupRegistryPackages(client, &lookup, names, repoURI)
+			packages[t.Registry] = pkg
+		}
+		t.Name = pkg.name
+		t.Verified = pkg.verified
+	}
+	return nil
+}
+
+var readPackageNames fileparser.DoWhileTrueOnFileContent = func(pathfn string, content []byte,
+	args ...interface{},
+) (bool, error) {
+	if len(args) != 2 {
+		return false, fmt.Errorf(
+			"readPackageNames requires exactly 2 arguments: %w", errInvalidArgLength)
+	}
+	lookup, ok := args[0].(*registryLookup)
+	if !ok {
+		return false, fmt.Errorf(
+			"readPackageNames expects arg[0] of type *registryLookup: %w", errInvalidArgType)
+	}
+	names, ok := args[1].(*[]string)
+	if !ok {
+		return false, fmt.Errorf(
+			"readPackageNames expects arg[1] of type *[]string: %w", errInvalidArgType)
+	}
+
+	if isVendoredPath(pathfn) {
+		return true, nil
+	}
+	name := lookup.name(content)
+	// Names computed at build time, e.g., $(AssemblyName), cannot be looked up.
+	if name == "" || strings.ContainsAny(name, "$#{") {
+		return true, nil
+	}
+	for _, n := range *names {
+		if n == name {
+			return true, nil
+		}
+	}
+	*names = append(*names, name)
+	return true, nil
+}
+
+// lookupRegistryPackages returns the first package with the repository as source. The
+// packages are unverified if none of them could be looked up, e.g., if the registry is
+// unavailable.
+func lookupRegistryPackages(client pmc.Client, lookup *registryLookup, names []string,
+	repoURI string,
+) registryPackage {
+	var pkg registryPackage
+	for i, name := range names {
+		if i == maxRegistryLookups {
+			break
+		}
+		sources, found, err := lookup.sources(client, name)
+		if err != nil {
+			continue
+		}
+		if pkg.name == "" {
+			pkg.name = name
+		}
+		verified := false
+		if found {
+			for _, source := range sources {
+				if isRepositorySource(source, repoURI) {
+					verified = true
+					break
+				}
+			}
+		}
+		pkg.verified = &verified
+		if verified {
+			pkg.name = name
+			return pkg
+		}
+	}
+	return pkg
+}
+
+// normalizeSourceURL returns the host and path of a repository URL, e.g.,
+// github.com/owner/repo for git+https://github.com/owner/repo.git#main.
+func normalizeSourceURL(s string) string {
+	s = strings.ToLower(strings.TrimSpace(s))
+	s = strings.TrimPrefix(s, "git+")
+	if i := strings.Index(s, "://"); i >= 0 {
+		s = s[i+len("://"):]
+	}
+	if i := strings.IndexAny(s, "#?"); i >= 0 {
+		s = s[:i]
+	}
+	if strings.HasPrefix(s, "git@") {
+		s = strings.Replace(strings.TrimPrefix(s, "git@"), ":", "/", 1)
+	}
+	// Shorthands of the npm repository field, e.g., github:owner/repo.
+	for _, host := range []string{"github", "gitlab"} {
+		if strings.HasPrefix(s, host+":") {
+			s = host + ".com/" + strings.TrimPrefix(s, host+":")
+		}
+	}
+	s = strings.TrimPrefix(s, "www.")
+	s = strings.TrimSuffix(s, "/")
+	return strings.TrimSuffix(s, ".git")
+}
+
+// isRepositorySource returns true if the source URL is the repository,
+// or a path in the repository, e.g., the directory of a workspace.
+func isRepositorySource(source, repoURI string) bool {
+	s := normalizeSourceURL(source)
+	return s == repoURI || strings.HasPrefix(s, repoURI+"/")
+}
+
+func jsonPackageName(content []byte) string {
+	var manifest struct {
+		Name    string `json:"name"`
+		Private bool   `json:"private"`
+	}
+	if err := json.Unmarshal(content, &manifest); err != nil || manifest.Private {
+		return ""
+	}
+	return manifest.Name
+}
+
+func pyprojectPackageName(content []byte) string {
+	var manifest struct {
+		Project struct {
+			Name string `toml:"name"`
+		} `toml:"project"`
+		Tool struct {
+			Poetry struct {
+				Name string `toml:"name"`
+			} `toml:"poetry"`
+		} `toml:"tool"`
+	}
+	if _, err := toml.Decode(string(content), &manifest); err != nil {
+		return ""
+	}
+	if manifest.Project.Name != "" {
+		return manifest.Project.Name
+	}
+	return manifest.Tool.Poetry.Name
+}
+
+func cargoPackageName(content []byte) string {
+	var manifest struct {
+		Package struct {
+			// Publish is either a boolean or a list of registries.
+			Publish interface{} `toml:"publish"`
+			Name    string      `toml:"name"`
+		} `toml:"package"`
+	}
+	if _, err := toml.Decode(string(content), &manifest); err != nil {
+		return ""
+	}
+	if publish, ok := manifest.Package.Publish.(bool); ok && !publish {
+		return ""
+	}
+	return manifest.Package.Name
+}
+
+func regexPackageName(regex *regexp.Regexp) func([]byte) string {
+	return func(content []byte) string {
+		m := regex.FindSubmatch(content)
+		if m == nil {
+			return ""
+		}
+		return string(m[1])
+	}
+}
+
+// getRegistryJSON decodes the metadata of a package returned by the registry.
+// It returns false if the registry has no such package.
+func getRegistryJSON(client pmc.Client, url, name string, v interface{}) (bool, error) {
+	resp, err := client.Get(url, name)
+	if err != nil {
+		return false, fmt.Errorf("%w", err)
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, fmt.Errorf("%w: %s", errUnexpectedRegistryResponse, resp.Status)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return false, fmt.Errorf("%w: %v", errUnexpectedRegistryResponse, err)
+	}
+	return true, nil
+}
+
+func npmSources(client pmc.Client, name string) ([]string, bool, error) {
+	var pkg struct {
+		// Repository is either a URL or an object with a URL.
+		Repository json.RawMessage `json:"repository"`
+		Homepage   string          `json:"homepage"`
+	}
+	// The slash of scoped packages is escaped, e.g., @scope%2Fname.
+	found, err := getRegistryJSON(client, "https://registry.npmjs.org/%s",
+		strings.Replace(name, "/", "%2F", 1), &pkg)
+	if !found || err != nil {
+		return nil, found, err
+	}
+	sources := []string{pkg.Homepage}
+	var repository struct {
+		URL string `json:"url"`
+	}
+	var url string
+	if err := json.Unmarshal(pkg.Repository, &url); err == nil {
+		sources = append(sources, url)
+	} else if err := json.Unmarshal(pkg.Repository, &repository); err == nil {
+		sources = append(sources, repository.URL)
+	}
+	return sources, true, nil
+}
+
+func pypiSources(client pmc.Client, name string) ([]string, bool, error) {
+	var pkg struct {
+		Info struct {
+			ProjectURLs map[string]string `json:"project_urls"`
+			HomePage    string            `json:"home_page"`
+		} `json:"info"`
+	}
+	found, err := getRegistryJSON(client, "https://pypi.org/pypi/%s/json", name, &pkg)
+	if !found || err != nil {
+		return nil, found, err
+	}
+	sources := []string{pkg.Info.HomePage}
+	for _, u := range pkg.Info.ProjectURLs {
+		sources = append(sources, u)
+	}
+	return sources, true, nil
+}
+
+func cratesIOSources(client pmc.Client, name string) ([]string, bool, error) {
+	var pkg struct {
+		Crate struct {
+			Repository string `json:"repository"`
+			Homepage   string `json:"homepage"`
+		} `json:"crate"`
+	}
+	found, err := getRegistryJSON(client, "https://crates.io/api/v1/crates/%s", name, &pkg)
+	if !found || err != nil {
+		return nil, found, err
+	}
+	return []string{pkg.Crate.Repository, pkg.Crate.Homepage}, true, nil
+}
+
+func rubyGemsSources(client pmc.Client, name string) ([]string, bool, error) {
+	var pkg struct {
+		SourceCodeURI string `json:"source_code_uri"`
+		HomepageURI   string `json:"homepage_uri"`
+	}
+	found, err := getRegistryJSON(client, "https://rubygems.org/api/v1/gems/%s.json", name, &pkg)
+	if !found || err != nil {
+		return nil, found, err
+	}
+	return []string{pkg.SourceCodeURI, pkg.HomepageURI}, true, nil
+}
+
+func hexSources(client pmc.Client, name string) ([]string, bool, error) {
+	var pkg struct {
+		Meta struct {
+			Links map[string]string `json:"links"`
+		} `json:"meta"`
+	}
+	found, err := getRegistryJSON(client, "https://hex.pm/api/packages/%s", name, &pkg)
+	if !found || err != nil {
+		return nil, found, err
+	}
+	var sources []string
+	for _, u := range pkg.Meta.Links {
+		sources = append(sources, u)
+	}
+	return sources, true, nil
+}
+
+func packagistSources(client pmc.Client, name string) ([]string, bool, error) {
+	var pkg struct {
+		Packages map[string][]struct {
+			Source struct {
+				URL string `json:"url"`
+			} `json:"source"`
+		} `json:"packages"`
+	}
+	found, err := getRegistryJSON(client, "https://repo.packagist.org/p2/%s.json", name, &pkg)
+	if !found || err != nil {
+		return nil, found, err
+	}
+	var sources []string
+	for _, version := range pkg.Packages[name] {
+		sources = append(sources, version.Source.URL)
+	}
+	return sources, true, nil
+}
+
+// nugetSources returns the repository of the latest listed version of the package. The
+// NuGet client does not tell missing packages apart, which are left unverified.
+func nugetSources(client pmc.Client, name string) ([]string, bool, error) {
+	nugetClient := ngt.NugetClient{Manager: client}
+	url, err := nugetClient.GitRepositoryByPackageName(name)
+	if err != nil {
+		return nil, false, fmt.Errorf("%w", err)
+	}
+	return []string{url}, true, nil
+}
@@ -0,0 +1,154 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v4/checker"
+	mockrepo "github.com/ossf/scorecard/v4/clients/mockclients"
+	pmc "github.com/ossf/scorecard/v4/internal/packagemanager"
+)
+
+func TestVerifyPublicationTargets(t *testing.T) {
+	t.Parallel()
+	files := map[string]string{
+		"Cargo.toml":     "[package]\nname = \"project\"\nversion = \"0.1.0\"\n",
+		"cli/Cargo.toml": "[package]\nname = \"project-cli\"\npublish = false\n",
+		"package.json":   `{"name": "@owner/project", "version": "1.0.0"}`,
+		"pyproject.toml": "[tool.poetry]\nname = \"project\"\n",
+		"project.gemspec": "Gem::Specification.new do |spec|\n" +
+			"  spec.name = \"project\"\nend\n",
+		"vendor/github.com/other/lib/package.json": `{"name": "lib"}`,
+	}
+	responses := map[string]struct {
+		body   string
+		status int
+	}{
+		"https://crates.io/api/v1/crates/project": {
+			status: http.StatusOK,
+			body:   `{"crate": {"repository": "https://github.com/owner/project"}}`,
+		},
+		"https://registry.npmjs.org/@owner%2Fproject": {
+			status: http.StatusOK,
+			body:   `{"repository": {"type": "git", "url": "git+https://github.com/other/fork.git"}}`,
+		},
+		"https://pypi.org/pypi/project/json": {
+			status: http.StatusNotFound,
+		},
+		"https://rubygems.org/api/v1/gems/project.json": {
+			status: http.StatusServiceUnavailable,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	mockRepo := mockrepo.NewMockRepo(ctrl)
+	mockRepo.EXPECT().URI().Return("github.com/owner/project")
+	mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+	mockRepoClient.EXPECT().ListFiles(gomock.Any()).DoAndReturn(func(predicate func(string) (bool, error)) ([]string, error) {
+		var matches []string
+		for p := range files {
+			if ok, err := predicate(p); err == nil && ok {
+				matches = append(matches, p)
+			}
+		}
+		return matches, nil
+	}).AnyTimes()
+	mockRepoClient.EXPECT().GetFileContent(gomock.Any()).DoAndReturn(func(file string) ([]byte, error) {
+		return []byte(files[file]), nil
+	}).AnyTimes()
+	mockPMClient := pmc.NewMockClient(ctrl)
+	mockPMClient.EXPECT().Get(gomock.Any(), gomock.Any()).DoAndReturn(func(url, name string) (*http.Response, error) {
+		r, ok := responses[fmt.Sprintf(url, name)]
+		if !ok {
+			t.Errorf("unexpected registry lookup: %s", fmt.Sprintf(url, name))
+			r.status = http.StatusNotFound
+		}
+		return &http.Response{
+			StatusCode: r.status,
+			Status:     http.StatusText(r.status),
+			Body:       io.NopCloser(bytes.NewBufferString(r.body)),
+		}, nil
+	}).Times(len(responses))
+
+	data := checker.PackagingData{
+		Targets: []checker.PublicationTarget{
+			{Registry: checker.PackageRegistryCratesIO, Tool: "cargo publish"},
+			{Registry: checker.PackageRegistryNpm, Tool: "npm publish"},
+			{Registry: checker.PackageRegistryPyPI, Tool: "poetry publish"},
+			{Registry: checker.PackageRegistryRubyGems, Tool: "gem push"},
+			{Registry: checker.PackageRegistryOCI, Tool: "docker push"},
+			// The registry is looked up once.
+			{Registry: checker.PackageRegistryCratesIO, Tool: "katyo/publish-crates"},
+		},
+	}
+	req := checker.CheckRequest{
+		RepoClient: mockRepoClient,
+		Repo:       mockRepo,
+	}
+	if err := VerifyPublicationTargets(&req, &data, mockPMClient); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	verified := true
+	unverified := false
+	want := []checker.PublicationTarget{
+		{Registry: checker.PackageRegistryCratesIO, Tool: "cargo publish", Name: "project", Verified: &verified},
+		{Registry: checker.PackageRegistryNpm, Tool: "npm publish", Name: "@owner/project", Verified: &unverified},
+		{Registry: checker.PackageRegistryPyPI, Tool: "poetry publish", Name: "project", Verified: &unverified},
+		{Registry: checker.PackageRegistryRubyGems, Tool: "gem push"},
+		{Registry: checker.PackageRegistryOCI, Tool: "docker push"},
+		{Registry: checker.PackageRegistryCratesIO, Tool: "katyo/publish-crates", Name: "project", Verified: &verified},
+	}
+	if diff := cmp.Diff(want, data.Targets); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestIsRepositorySource(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		source string
+		want   bool
+	}{
+		{source: "https://github.com/owner/project", want: true},
+		{source: "git+https://github.com/Owner/Project.git", want: true},
+		{source: "git@github.com:owner/project.git", want: true},
+		{source: "ssh://git@github.com/owner/project", want: true},
+		{source: "github:owner/project", want: true},
+		{source: "https://www.github.com/owner/project/", want: true},
+		{source: "https://github.com/owner/project/tree/main/crates/core", want: true},
+		{source: "https://github.com/owner/project.git#main", want: true},
+		{source: "https://github.com/owner/project-fork", want: false},
+		{source: "https://project.example.com", want: false},
+		{source: "", want: false},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.source, func(t *testing.T) {
+			t.Parallel()
+			if got := isRepositorySource(tt.source, "github.com/owner/project"); got != tt.want {
+				t.Errorf("isRepositorySource(%q) = %v, want %v", tt.source, got, tt.want)
+			}
+		})
+	}
+}
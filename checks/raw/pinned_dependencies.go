@@ -25,6 +25,7 @@ import (
 
 	"github.com/ossf/scorecard/v4/checker"
 	"github.com/ossf/scorecard/v4/checks/fileparser"
+	"github.com/ossf/scorecard/v4/clients"
 	sce "github.com/ossf/scorecard/v4/errors"
 	"github.com/ossf/scorecard/v4/finding"
 )
@@ -43,6 +44,26 @@ func PinningDependencies(c *checker.CheckRequest) (checker.PinningDependenciesDa
 		return checker.PinningDependenciesData{}, err
 	}
 
+	// Kubernetes, Helm, Kustomize and Compose manifests.
+	if err := collectContainerManifestPinning(c, &results); err != nil {
+		return checker.PinningDependenciesData{}, err
+	}
+
+	// Terraform and OpenTofu modules and providers.
+	if err := collectTerraformPinning(c, &results); err != nil {
+		return checker.PinningDependenciesData{}, err
+	}
+
+	// Lockfiles of npm, Python, Rust and Ruby projects.
+	if err := collectLockfilePinning(c, &results); err != nil {
+		return checker.PinningDependenciesData{}, err
+	}
+
+	// pip requirements files.
+	if err := collectPipRequirementsPinning(c, &results); err != nil {
+		return checker.PinningDependenciesData{}, err
+	}
+
 	// Docker downloads.
 	if err := collectDockerfileInsecureDownloads(c, &results); err != nil {
 		return checker.PinningDependenciesData{}, err
@@ -53,11 +74,29 @@ func PinningDependencies(c *checker.CheckRequest) (checker.PinningDependenciesDa
 		return checker.PinningDependenciesData{}, err
 	}
 
+	// PowerShell script downloads.
+	if err := collectPowerShellScriptInsecureDownloads(c, &results); err != nil {
+		return checker.PinningDependenciesData{}, err
+	}
+
+	// Makefile recipe downloads.
+	if err := collectMakefileInsecureDownloads(c, &results); err != nil {
+		return checker.PinningDependenciesData{}, err
+	}
+
 	// Action script downloads.
 	if err := collectGitHubWorkflowScriptInsecureDownloads(c, &results); err != nil {
 		return checker.PinningDependenciesData{}, err
 	}
 
+	// Local composite actions.
+	if err := collectGitHubLocalActionsPinning(c, &results); err != nil {
+		return checker.PinningDependenciesData{}, err
+	}
+
+	// Commits GitHub Actions are pinned at.
+	verifyGitHubActionCommits(c, &results)
+
 	return results, nil
 }
 
@@ -74,7 +113,7 @@ func collectShellScriptInsecureDownloads(c *checker.CheckRequest, r *checker.Pin
 	return fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
 		Pattern:       "*",
 		CaseSensitive: false,
-	}, validateShellScriptIsFreeOfInsecureDownloads, r)
+	}, validateShellScriptIsFreeOfInsecureDownloads, r, c.RepoClient)
 }
 
 var validateShellScriptIsFreeOfInsecureDownloads fileparser.DoWhileTrueOnFileContent = func(
@@ -82,20 +121,28 @@ var validateShellScriptIsFreeOfInsecureDownloads fileparser.DoWhileTrueOnFileCon
 	content []byte,
 	args ...interface{},
 ) (bool, error) {
-	if len(args) != 1 {
+	if len(args) != 2 {
 		return false, fmt.Errorf(
-			"validateShellScriptIsFreeOfInsecureDownloads requires exactly 1 arguments: got %v: %w",
+			"validateShellScriptIsFreeOfInsecureDownloads requires exactly 2 arguments: got %v: %w",
 			len(args), errInvalidArgLength)
 	}
 
 	pdata := dataAsPinnedDependenciesPointer(args[0])
+	repoClient, ok := args[1].(clients.RepoClient)
+	if !ok {
+		return false, fmt.Errorf(
+			"validateShellScriptIsFreeOfInsecureDownloads expects arg[1] of type clients.RepoClient: %w",
+			errInvalidArgType)
+	}
 
 	// Validate the file type.
 	if !isSupportedShellScriptFile(pathfn, content) {
 		return true, nil
 	}
 
-	if err := validateShellFile(pathfn, 0, 0, content, map[string]bool{}, pdata); err != nil {
+	// Repository scripts sourced by the script are followed.
+	df := newShellDataFlow(pathfn, repoClient)
+	if err := validateShellFileAndRecordWithFlow(pathfn, 0, 0, content, map[string]bool{}, df, pdata); err != nil {
 		return false, nil
 	}
 
@@ -352,6 +399,16 @@ var validateGitHubWorkflowIsFreeOfInsecureDownloads fileparser.DoWhileTrueOnFile
 		return false, fileparser.FormatActionlintError(errs)
 	}
 
+	if err := validateWorkflowScriptDownloads(workflow, pathfn, pdata); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// validateWorkflowScriptDownloads checks if the run steps of the workflow download dependencies that are unpinned.
+func validateWorkflowScriptDownloads(workflow *actionlint.Workflow, pathfn string,
+	pdata *checker.PinningDependenciesData,
+) error {
 	githubVarRegex := regexp.MustCompile(`{{[^{}]*}}`)
 	for jobName, job := range workflow.Jobs {
 		jobName := jobName
@@ -369,7 +426,7 @@ var validateGitHubWorkflowIsFreeOfInsecureDownloads fileparser.DoWhileTrueOnFile
 			execRun, ok := step.Exec.(*actionlint.ExecRun)
 			if !ok {
 				stepName := fileparser.GetStepName(step)
-				return false, sce.WithMessage(sce.ErrScorecardInternal,
+				return sce.WithMessage(sce.ErrScorecardInternal,
 					fmt.Sprintf("unable to parse step '%v' for job '%v'", jobName, stepName))
 			}
 
@@ -382,7 +439,7 @@ var validateGitHubWorkflowIsFreeOfInsecureDownloads fileparser.DoWhileTrueOnFile
 			// https://docs.github.com/en/actions/reference/workflow-syntax-for-github-actions#jobsjob_idstepsrun.
 			shell, err := fileparser.GetShellForStep(step, job)
 			if err != nil {
-				return false, err
+				return err
 			}
 			// Skip unsupported shells. We don't support Windows shells or some Unix shells.
 			if !isSupportedShell(shell) {
@@ -400,7 +457,7 @@ var validateGitHubWorkflowIsFreeOfInsecureDownloads fileparser.DoWhileTrueOnFile
 		}
 	}
 
-	return true, nil
+	return nil
 }
 
 // Check pinning of github actions in workflows.
@@ -439,6 +496,18 @@ var validateGitHubActionWorkflow fileparser.DoWhileTrueOnFileContent = func(
 		return false, fileparser.FormatActionlintError(errs)
 	}
 
+	start := len(pdata.Dependencies)
+	if err := validateWorkflowActionsPinning(workflow, pathfn, pdata); err != nil {
+		return false, err
+	}
+	addVersionComments(content, pdata.Dependencies[start:])
+	return true, nil
+}
+
+// validateWorkflowActionsPinning checks if the steps of the workflow use unpinned actions.
+func validateWorkflowActionsPinning(workflow *actionlint.Workflow, pathfn string,
+	pdata *checker.PinningDependenciesData,
+) error {
 	for jobName, job := range workflow.Jobs {
 		jobName := jobName
 		job := job
@@ -453,7 +522,7 @@ var validateGitHubActionWorkflow fileparser.DoWhileTrueOnFileContent = func(
 			execAction, ok := step.Exec.(*actionlint.ExecAction)
 			if !ok {
 				stepName := fileparser.GetStepName(step)
-				return false, sce.WithMessage(sce.ErrScorecardInternal,
+				return sce.WithMessage(sce.ErrScorecardInternal,
 					fmt.Sprintf("unable to parse step '%v' for job '%v'", jobName, stepName))
 			}
 
@@ -462,10 +531,9 @@ var validateGitHubActionWorkflow fileparser.DoWhileTrueOnFileContent = func(
 				continue
 			}
 
-			//nolint:lll
-			// Check whether this is an action defined in the same repo,
-			// https://docs.github.com/en/actions/learn-github-actions/finding-and-customizing-actions#referencing-an-action-in-the-same-repository-where-a-workflow-file-uses-the-action.
-			if strings.HasPrefix(execAction.Uses.Value, "./") {
+			// Check whether this is an action defined in the same repo.
+			// Local composite actions are checked by collectGitHubLocalActionsPinning.
+			if fileparser.IsLocalUses(execAction.Uses.Value) {
 				continue
 			}
 
@@ -491,7 +559,33 @@ var validateGitHubActionWorkflow fileparser.DoWhileTrueOnFileContent = func(
 		}
 	}
 
-	return true, nil
+	return nil
+}
+
+// Check pinning of the actions and script downloads of the local composite actions used by workflows.
+// Reusable workflows are checked with the other workflows.
+func collectGitHubLocalActionsPinning(c *checker.CheckRequest, r *checker.PinningDependenciesData) error {
+	checked := make(map[string]bool)
+	return fileparser.OnLocalCalleesDo(c.RepoClient, func(callee *fileparser.LocalCallee) error {
+		if !callee.IsCompositeAction || checked[callee.Path] {
+			return nil
+		}
+		checked[callee.Path] = true
+
+		var found checker.PinningDependenciesData
+		if err := validateWorkflowActionsPinning(callee.Workflow, callee.Path, &found); err != nil {
+			return err
+		}
+		if err := validateWorkflowScriptDownloads(callee.Workflow, callee.Path, &found); err != nil {
+			return err
+		}
+		for i := range found.Dependencies {
+			caller := callee.Caller
+			found.Dependencies[i].Caller = &caller
+		}
+		r.Dependencies = append(r.Dependencies, found.Dependencies...)
+		return nil
+	})
 }
 
 func isActionDependencyPinned(actionUses string) bool {
@@ -15,14 +15,17 @@
 package raw
 
 import (
+	"fmt"
 	"os"
 	"strings"
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 
 	"github.com/ossf/scorecard/v4/checker"
+	mockrepo "github.com/ossf/scorecard/v4/clients/mockclients"
 	scut "github.com/ossf/scorecard/v4/utests"
 )
 
@@ -859,7 +862,7 @@ func TestShellscriptInsecureDownloadsLineNumber(t *testing.T) {
 			}
 
 			var r checker.PinningDependenciesData
-			_, err = validateShellScriptIsFreeOfInsecureDownloads(tt.filename, content, &r)
+			_, err = validateShellScriptIsFreeOfInsecureDownloads(tt.filename, content, &r, &stubRepoClient{})
 			if err != nil {
 				t.Errorf("error during validateShellScriptIsFreeOfInsecureDownloads: %v", err)
 			}
@@ -1144,7 +1147,7 @@ func TestShellScriptDownload(t *testing.T) {
 			}
 
 			var r checker.PinningDependenciesData
-			_, err = validateShellScriptIsFreeOfInsecureDownloads(tt.filename, content, &r)
+			_, err = validateShellScriptIsFreeOfInsecureDownloads(tt.filename, content, &r, &stubRepoClient{})
 
 			if !errCmp(err, tt.err) {
 				t.Errorf(cmp.Diff(err, tt.err, cmpopts.EquateErrors()))
@@ -1198,7 +1201,7 @@ func TestShellScriptDownloadPinned(t *testing.T) {
 			}
 
 			var r checker.PinningDependenciesData
-			_, err = validateShellScriptIsFreeOfInsecureDownloads(tt.filename, content, &r)
+			_, err = validateShellScriptIsFreeOfInsecureDownloads(tt.filename, content, &r, &stubRepoClient{})
 
 			if !errCmp(err, tt.err) {
 				t.Errorf(cmp.Diff(err, tt.err, cmpopts.EquateErrors()))
@@ -1441,3 +1444,60 @@ func countUnpinned(r []checker.Dependency) int {
 
 	return unpinned
 }
+
+func TestGitHubLocalActionsPinning(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+	mockRepoClient.EXPECT().ListFiles(gomock.Any()).Return([]string{
+		".github/workflows/github-workflow-local-composite-action.yaml",
+	}, nil)
+	mockRepoClient.EXPECT().GetFileContent(gomock.Any()).DoAndReturn(func(file string) ([]byte, error) {
+		content, err := os.ReadFile("../testdata/" + file)
+		if err != nil {
+			return content, fmt.Errorf("%w", err)
+		}
+		return content, nil
+	}).AnyTimes()
+
+	var r checker.PinningDependenciesData
+	if err := collectGitHubLocalActionsPinning(&checker.CheckRequest{RepoClient: mockRepoClient}, &r); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	type result struct {
+		Path       string
+		Type       checker.DependencyUseType
+		CallerPath string
+		Line       uint
+		CallerLine uint
+		Pinned     bool
+	}
+	var got []result
+	for _, d := range r.Dependencies {
+		if d.Msg != nil {
+			t.Errorf("unexpected message: %s", *d.Msg)
+			continue
+		}
+		got = append(got, result{
+			Path:       d.Location.Path,
+			Type:       d.Type,
+			CallerPath: d.Caller.Path,
+			Line:       d.Location.Offset,
+			CallerLine: d.Caller.Offset,
+			Pinned:     *d.Pinned,
+		})
+	}
+
+	action := ".github/actions/unpinned-composite/action.yml"
+	workflow := ".github/workflows/github-workflow-local-composite-action.yaml"
+	want := []result{
+		{Path: action, Type: checker.DependencyUseTypeGHAction, Line: 19, CallerPath: workflow, CallerLine: 22},
+		{Path: action, Type: checker.DependencyUseTypeGHAction, Line: 20, CallerPath: workflow, CallerLine: 22, Pinned: true},
+		{Path: action, Type: checker.DependencyUseTypeDownloadThenRun, Line: 22, CallerPath: workflow, CallerLine: 22},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,494 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/checks/fileparser"
+	"github.com/ossf/scorecard/v4/finding"
+)
+
+var (
+	// Cmdlets and aliases printing the content they download.
+	psDownloadCmdlets = []string{"invoke-webrequest", "iwr", "invoke-restmethod", "irm"}
+	// Cmdlets and aliases executing the string they are given.
+	psExecuteCmdlets = []string{"invoke-expression", "iex", "invoke-command", "icm"}
+	// Cmdlets and aliases starting the file they are given.
+	psStartCmdlets = []string{"start-process", "saps", "start", "msiexec"}
+	// Cmdlets and aliases printing the content of a file.
+	psReadCmdlets   = []string{"get-content", "gc", "cat", "type"}
+	psInterpreters  = []string{"powershell", "pwsh"}
+	psDownloadRegex = regexp.MustCompile(
+		`(?i)((^|[(;|]\s*)(invoke-webrequest|iwr|invoke-restmethod|irm|curl|wget)(\.exe)?(\s|\)|$)` +
+			`|\.download(string|data)\()`)
+	psOutFileRegex      = regexp.MustCompile(`(?i)\s-outfile(\s|$)`)
+	psDownloadFileRegex = regexp.MustCompile(`(?i)\.downloadfile\(\s*[^,]+,\s*([^)]+)\)`)
+	psVariableRegex     = regexp.MustCompile(`\$([\w:]+)`)
+)
+
+// psCommand is a command of a PowerShell pipeline.
+type psCommand struct {
+	words []string
+	text  string
+}
+
+// psStatement is a PowerShell pipeline, e.g., `iwr https://example.com/install.ps1 | iex`.
+type psStatement struct {
+	commands  []psCommand
+	text      string
+	startLine uint
+	endLine   uint
+}
+
+// psParser splits a PowerShell script into statements.
+// It does not fully parse the language: script blocks are split into their statements
+// and parenthesized expressions are kept as single words.
+type psParser struct {
+	statements []psStatement
+	current    psStatement
+	command    psCommand
+	word       strings.Builder
+	text       strings.Builder
+	cmdText    strings.Builder
+	line       uint
+}
+
+func parsePowerShell(content string) []psStatement {
+	p := psParser{line: 1}
+	depth := 0
+	n := len(content)
+	for i := 0; i < n; i++ {
+		c := content[i]
+		switch {
+		// Block comment.
+		case depth == 0 && strings.HasPrefix(content[i:], "<#"):
+			end := strings.Index(content[i:], "#>")
+			if end < 0 {
+				end = n - i
+			}
+			p.line += uint(strings.Count(content[i:i+end], "\n"))
+			i += end + 1
+
+		// Line comment.
+		case depth == 0 && c == '#' && p.word.Len() == 0:
+			for i+1 < n && content[i+1] != '\n' {
+				i++
+			}
+
+		// Line continuation.
+		case c == '`' && i+1 < n && (content[i+1] == '\n' || strings.HasPrefix(content[i+1:], "\r\n")):
+			i = strings.IndexByte(content[i:], '\n') + i
+			p.line++
+			p.endWord()
+			p.write(' ')
+
+		case c == '\'' || c == '"':
+			end := psQuoteEnd(content, i)
+			quoted := content[i:end]
+			p.writeString(quoted)
+			p.line += uint(strings.Count(quoted, "\n"))
+			i = end - 1
+
+		case c == '(':
+			depth++
+			p.writeWord(c)
+		case c == ')':
+			if depth > 0 {
+				depth--
+			}
+			p.writeWord(c)
+		case depth > 0:
+			p.writeWord(c)
+			if c == '\n' {
+				p.line++
+			}
+
+		case c == ' ' || c == '\t' || c == '\r':
+			p.endWord()
+			p.write(c)
+		case c == '\n':
+			p.line++
+			// A pipeline continues on the next line after `|`.
+			if len(p.current.commands) > 0 && len(p.command.words) == 0 && p.word.Len() == 0 {
+				p.write(c)
+				continue
+			}
+			p.endStatement()
+		case c == ';' || c == '{' || c == '}':
+			p.endStatement()
+		case (c == '&' || c == '|') && i+1 < n && content[i+1] == c:
+			p.endStatement()
+			i++
+		case c == '|':
+			p.endCommand()
+			p.write(c)
+		default:
+			p.writeWord(c)
+		}
+	}
+	p.endStatement()
+	return p.statements
+}
+
+// psQuoteEnd returns the index following the string starting at i.
+func psQuoteEnd(content string, i int) int {
+	q := content[i]
+	for j := i + 1; j < len(content); j++ {
+		switch {
+		case q == '"' && content[j] == '`':
+			j++
+		case content[j] == q && j+1 < len(content) && content[j+1] == q:
+			// Escaped quote, e.g., 'it''s'.
+			j++
+		case content[j] == q:
+			return j + 1
+		}
+	}
+	return len(content)
+}
+
+func (p *psParser) write(c byte) {
+	if p.text.Len() > 0 {
+		p.text.WriteByte(c)
+	}
+	if p.cmdText.Len() > 0 {
+		p.cmdText.WriteByte(c)
+	}
+}
+
+func (p *psParser) writeWord(c byte) {
+	p.writeString(string(c))
+}
+
+func (p *psParser) writeString(s string) {
+	if p.text.Len() == 0 {
+		p.current.startLine = p.line
+	}
+	p.word.WriteString(s)
+	p.text.WriteString(s)
+	p.cmdText.WriteString(s)
+	p.current.endLine = p.line + uint(strings.Count(s, "\n"))
+}
+
+func (p *psParser) endWord() {
+	if p.word.Len() == 0 {
+		return
+	}
+	p.command.words = append(p.command.words, p.word.String())
+	p.word.Reset()
+}
+
+func (p *psParser) endCommand() {
+	p.endWord()
+	if len(p.command.words) > 0 {
+		p.command.text = strings.TrimSpace(p.cmdText.String())
+		p.current.commands = append(p.current.commands, p.command)
+	}
+	p.command = psCommand{}
+	p.cmdText.Reset()
+}
+
+func (p *psParser) endStatement() {
+	p.endCommand()
+	if len(p.current.commands) > 0 {
+		p.current.text = strings.TrimSpace(p.text.String())
+		p.statements = append(p.statements, p.current)
+	}
+	p.current = psStatement{}
+	p.text.Reset()
+}
+
+// psUnquote removes the quotes around a word.
+func psUnquote(w string) string {
+	if len(w) >= 2 && (w[0] == '\'' || w[0] == '"') && w[len(w)-1] == w[0] {
+		return w[1 : len(w)-1]
+	}
+	return w
+}
+
+// psPath normalizes a path, e.g., `.\scripts\install.ps1` and `scripts/install.ps1`.
+func psPath(w string) string {
+	p := strings.ReplaceAll(psUnquote(w), `\`, "/")
+	return strings.ToLower(path.Clean(p))
+}
+
+// psName returns the name of the command, e.g., `choco` for `C:\ProgramData\chocolatey\bin\choco.exe`.
+func psName(w string) string {
+	name := strings.ToLower(path.Base(strings.ReplaceAll(psUnquote(w), `\`, "/")))
+	return strings.TrimSuffix(name, ".exe")
+}
+
+// psArgs returns the unquoted words of the command, without the call operator.
+func psArgs(c psCommand) []string {
+	words := c.words
+	if len(words) > 1 && (words[0] == "&" || words[0] == ".") {
+		words = words[1:]
+	}
+	args := make([]string, 0, len(words))
+	for _, w := range words {
+		args = append(args, psUnquote(w))
+	}
+	if len(args) > 0 {
+		args[0] = psName(args[0])
+	}
+	return args
+}
+
+// psDataFlow tracks the downloaded content of a PowerShell script.
+type psDataFlow struct {
+	// Files downloaded, and whether they were not verified.
+	files map[string]bool
+	// Variables holding downloaded content.
+	vars  map[string]bool
+	depth int
+}
+
+// isDownload returns whether the expression evaluates to downloaded content.
+func (df *psDataFlow) isDownload(expr string) bool {
+	if psDownloadRegex.MatchString(expr) && !psOutFileRegex.MatchString(expr) {
+		return true
+	}
+	for _, m := range psVariableRegex.FindAllStringSubmatch(expr, -1) {
+		if df.vars[strings.ToLower(m[1])] {
+			return true
+		}
+	}
+	return false
+}
+
+// printsDownload returns whether the command prints downloaded content.
+func (df *psDataFlow) printsDownload(c psCommand) bool {
+	args := psArgs(c)
+	switch {
+	case isOneOf(args[0], psDownloadCmdlets):
+		return !psOutFileRegex.MatchString(c.text)
+	case args[0] == "curl" || args[0] == "wget":
+		if psOutFileRegex.MatchString(c.text) {
+			return false
+		}
+		_, ok, err := getOutputFile(args)
+		return err == nil && !ok
+	case isOneOf(args[0], psReadCmdlets):
+		for _, arg := range args[1:] {
+			if df.isTaintedFile(arg) {
+				return true
+			}
+		}
+		return false
+	}
+	// Expressions, e.g., `(iwr https://example.com/install.ps1).Content` or `$script`.
+	w := c.words[0]
+	return strings.ContainsAny(w[:1], "($[") && df.isDownload(c.text)
+}
+
+// executesInput returns whether the command executes the content it is given.
+func executesInput(args []string) bool {
+	return isOneOf(args[0], psExecuteCmdlets) || isOneOf(args[0], psInterpreters) || isInterpreter(args)
+}
+
+func (df *psDataFlow) isTaintedFile(w string) bool {
+	tainted, ok := df.files[psPath(w)]
+	return ok && tainted
+}
+
+// executesFile returns whether the command executes a downloaded file.
+func (df *psDataFlow) executesFile(c psCommand) bool {
+	words := c.words
+	if len(words) > 1 && (words[0] == "&" || words[0] == ".") {
+		words = words[1:]
+	}
+	if df.isTaintedFile(words[0]) {
+		return true
+	}
+	args := psArgs(c)
+	if !isOneOf(args[0], psStartCmdlets) && !isOneOf(args[0], psInterpreters) && !isInterpreter(args) {
+		return false
+	}
+	for _, arg := range args[1:] {
+		if df.isTaintedFile(arg) {
+			return true
+		}
+	}
+	return false
+}
+
+// recordDownloadedFiles records the files the command downloads.
+func (df *psDataFlow) recordDownloadedFiles(c psCommand) {
+	args := psArgs(c)
+	switch {
+	case isOneOf(args[0], psDownloadCmdlets) || args[0] == "curl" || args[0] == "wget":
+		for i := 1; i < len(args)-1; i++ {
+			if strings.EqualFold(args[i], "-OutFile") {
+				df.files[psPath(args[i+1])] = true
+				return
+			}
+		}
+		if fn, ok, err := getOutputFile(args); err == nil && ok {
+			df.files[psPath(fn)] = true
+		}
+	case args[0] == "start-bitstransfer":
+		for i := 1; i < len(args)-1; i++ {
+			if strings.EqualFold(args[i], "-Destination") {
+				df.files[psPath(args[i+1])] = true
+			}
+		}
+	}
+	// `(New-Object Net.WebClient).DownloadFile($url, $file)`.
+	for _, m := range psDownloadFileRegex.FindAllStringSubmatch(c.text, -1) {
+		df.files[psPath(strings.TrimSpace(m[1]))] = true
+	}
+}
+
+// recordVerifiedFiles marks the files whose hash is computed by the statement as verified,
+// e.g., `if ((Get-FileHash $file).Hash -ne $expected) { exit 1 }`.
+func (df *psDataFlow) recordVerifiedFiles(st *psStatement) {
+	text := strings.ToLower(st.text)
+	if !strings.Contains(text, "get-filehash") {
+		return
+	}
+	for _, c := range st.commands {
+		for _, w := range strings.FieldsFunc(c.text, func(r rune) bool {
+			return r == ' ' || r == '(' || r == ')'
+		}) {
+			if df.isTaintedFile(w) {
+				df.files[psPath(w)] = false
+			}
+		}
+	}
+}
+
+func validatePowerShellScript(pathfn string, startLine, endLine uint, content []byte,
+	df *psDataFlow, r *checker.PinningDependenciesData,
+) {
+	for _, st := range parsePowerShell(string(content)) {
+		st := st
+		start, end := startLine+st.startLine, startLine+st.endLine
+		if endLine >= startLine {
+			end = endLine + st.endLine
+		}
+		validatePowerShellStatement(pathfn, start, end, &st, df, r)
+	}
+}
+
+func validatePowerShellStatement(pathfn string, startLine, endLine uint, st *psStatement,
+	df *psDataFlow, r *checker.PinningDependenciesData,
+) {
+	commands := st.commands
+	// `$script = iwr https://example.com/install.ps1`.
+	first := commands[0].words
+	if len(first) > 2 && strings.HasPrefix(first[0], "$") && first[1] == "=" {
+		name := strings.ToLower(strings.TrimPrefix(first[0], "$"))
+		expr := psCommand{words: first[2:], text: strings.Join(first[2:], " ")}
+		commands = append([]psCommand{expr}, commands[1:]...)
+		df.vars[name] = len(commands) == 1 && df.printsDownload(expr)
+	}
+
+	df.recordVerifiedFiles(st)
+
+	downloaded := false
+	for _, c := range commands {
+		args := psArgs(c)
+		switch {
+		// `iwr https://example.com/install.ps1 | iex`.
+		case downloaded && executesInput(args),
+			// `iex ((New-Object Net.WebClient).DownloadString('https://example.com/install.ps1'))`.
+			isOneOf(args[0], psExecuteCmdlets) && len(c.words) > 1 && df.isDownload(strings.Join(c.words[1:], " ")),
+			// `& ([scriptblock]::Create((iwr https://example.com/install.ps1)))`.
+			c.words[0] == "&" && len(c.words) > 1 && strings.HasPrefix(c.words[1], "(") && df.isDownload(c.text),
+			// `& $env:TEMP\install.ps1`, `Start-Process install.exe`.
+			df.executesFile(c):
+			recordPowerShellDownloadThenRun(pathfn, startLine, endLine, st.text, r)
+		}
+		downloaded = downloaded || df.printsDownload(c)
+
+		// `powershell -Command "iwr https://example.com/install.ps1 | iex"`.
+		if isOneOf(args[0], psInterpreters) && df.depth < maxShellCallDepth {
+			for j := 1; j < len(args)-1; j++ {
+				if strings.EqualFold(args[j], "-Command") || strings.EqualFold(args[j], "-c") {
+					df.depth++
+					validatePowerShellScript(pathfn, startLine-1, endLine-1,
+						[]byte(strings.Join(args[j+1:], " ")), df, r)
+					df.depth--
+					break
+				}
+			}
+		}
+
+		df.recordDownloadedFiles(c)
+
+		// Package manager's unpinned installs.
+		collectUnpinnedPackageManagerCommand(startLine, endLine, args, st.text, pathfn, r)
+	}
+}
+
+func recordPowerShellDownloadThenRun(pathfn string, startLine, endLine uint, snippet string,
+	r *checker.PinningDependenciesData,
+) {
+	recordDependency(r, checker.Dependency{
+		Location: &checker.File{
+			Path:      pathfn,
+			Type:      finding.FileTypeSource,
+			Offset:    startLine,
+			EndOffset: endLine,
+			Snippet:   snippet,
+		},
+		Pinned: asBoolPointer(false),
+		Type:   checker.DependencyUseTypeDownloadThenRun,
+	})
+}
+
+func newPSDataFlow() *psDataFlow {
+	return &psDataFlow{
+		files: map[string]bool{},
+		vars:  map[string]bool{},
+	}
+}
+
+func collectPowerShellScriptInsecureDownloads(c *checker.CheckRequest, r *checker.PinningDependenciesData) error {
+	for _, pattern := range []string{"*.ps1", "*.psm1"} {
+		if err := fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
+			Pattern:       pattern,
+			CaseSensitive: false,
+		}, validatePowerShellScriptIsFreeOfInsecureDownloads, r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var validatePowerShellScriptIsFreeOfInsecureDownloads fileparser.DoWhileTrueOnFileContent = func(
+	pathfn string,
+	content []byte,
+	args ...interface{},
+) (bool, error) {
+	if len(args) != 1 {
+		return false, fmt.Errorf(
+			"validatePowerShellScriptIsFreeOfInsecureDownloads requires exactly 1 arguments: got %v: %w",
+			len(args), errInvalidArgLength)
+	}
+	pdata := dataAsPinnedDependenciesPointer(args[0])
+
+	if !fileparser.CheckFileContainsCommands(content, "#") {
+		return true, nil
+	}
+
+	validatePowerShellScript(pathfn, 0, 0, content, newPSDataFlow(), pdata)
+	return true, nil
+}
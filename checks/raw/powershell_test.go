@@ -0,0 +1,134 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v4/checker"
+)
+
+type scriptDependencyResult struct {
+	Type      checker.DependencyUseType
+	StartLine uint
+	EndLine   uint
+	Pinned    bool
+}
+
+func scriptDependencyResults(t *testing.T, r *checker.PinningDependenciesData) []scriptDependencyResult {
+	t.Helper()
+	var got []scriptDependencyResult
+	for _, d := range r.Dependencies {
+		if d.Msg != nil {
+			t.Errorf("unexpected message: %s", *d.Msg)
+			continue
+		}
+		got = append(got, scriptDependencyResult{
+			Type:      d.Type,
+			StartLine: d.Location.Offset,
+			EndLine:   d.Location.EndOffset,
+			Pinned:    *d.Pinned,
+		})
+	}
+	return got
+}
+
+func TestPowerShellScriptDownloads(t *testing.T) {
+	t.Parallel()
+
+	filename := "testdata/script-powershell.ps1"
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("cannot read file: %v", err)
+	}
+
+	var r checker.PinningDependenciesData
+	if _, err := validatePowerShellScriptIsFreeOfInsecureDownloads(filename, content, &r); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []scriptDependencyResult{
+		{Type: checker.DependencyUseTypeDownloadThenRun, StartLine: 9, EndLine: 9},
+		{Type: checker.DependencyUseTypeDownloadThenRun, StartLine: 10, EndLine: 10},
+		{Type: checker.DependencyUseTypeDownloadThenRun, StartLine: 12, EndLine: 12},
+		{Type: checker.DependencyUseTypeDownloadThenRun, StartLine: 15, EndLine: 15},
+		{Type: checker.DependencyUseTypeDownloadThenRun, StartLine: 18, EndLine: 18},
+		{Type: checker.DependencyUseTypeDownloadThenRun, StartLine: 19, EndLine: 19},
+		{Type: checker.DependencyUseTypeChocoCommand, StartLine: 27, EndLine: 27},
+		{Type: checker.DependencyUseTypeChocoCommand, StartLine: 28, EndLine: 28, Pinned: true},
+		{Type: checker.DependencyUseTypePipCommand, StartLine: 29, EndLine: 29},
+		{Type: checker.DependencyUseTypePipCommand, StartLine: 30, EndLine: 30, Pinned: true},
+		{Type: checker.DependencyUseTypePipCommand, StartLine: 31, EndLine: 31},
+		{Type: checker.DependencyUseTypeNpmCommand, StartLine: 32, EndLine: 32, Pinned: true},
+		{Type: checker.DependencyUseTypeNpmCommand, StartLine: 33, EndLine: 33},
+		{Type: checker.DependencyUseTypeNugetCommand, StartLine: 34, EndLine: 34, Pinned: true},
+		{Type: checker.DependencyUseTypeNugetCommand, StartLine: 35, EndLine: 35},
+		{Type: checker.DependencyUseTypeGoCommand, StartLine: 36, EndLine: 36},
+	}
+	if diff := cmp.Diff(want, scriptDependencyResults(t, &r)); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestParsePowerShell(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		content string
+		want    [][]string
+	}{
+		{
+			name:    "pipeline",
+			content: "iwr https://example.com/install.ps1 |\n  iex",
+			want:    [][]string{{"iwr", "https://example.com/install.ps1"}, {"iex"}},
+		},
+		{
+			name:    "statements",
+			content: "choco install git; pip install -r requirements.txt && npm ci",
+			want:    [][]string{{"choco", "install", "git"}, {"pip", "install", "-r", "requirements.txt"}, {"npm", "ci"}},
+		},
+		{
+			name:    "expressions and strings",
+			content: `iex ((New-Object Net.WebClient).DownloadString('https://example.com/a b.ps1')) "x | y"`,
+			want: [][]string{{
+				"iex", "((New-Object Net.WebClient).DownloadString('https://example.com/a b.ps1'))", `"x | y"`,
+			}},
+		},
+		{
+			name:    "comments",
+			content: "<# iwr https://example.com | iex #>\nnpm ci # npm install",
+			want:    [][]string{{"npm", "ci"}},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var got [][]string
+			for _, st := range parsePowerShell(tt.content) {
+				for _, c := range st.commands {
+					got = append(got, c.words)
+				}
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/checks/fileparser"
+	"github.com/ossf/scorecard/v4/finding"
+)
+
+var (
+	// https://pip.pypa.io/en/stable/reference/requirements-file-format/#requirements-file-format.
+	pipRequirementRegex = regexp.MustCompile(`^([A-Za-z0-9][A-Za-z0-9._-]*)(\[[^\]]*\])?\s*(.*)$`)
+	pipVersionRegex     = regexp.MustCompile(`^===?\s*([^\s;,]+)$`)
+	// Options which do not declare a requirement.
+	pipRequirementOptions = []string{
+		"-r", "--requirement", "-c", "--constraint", "-i", "--index-url", "--extra-index-url",
+		"--no-index", "-f", "--find-links", "--pre", "--prefer-binary", "--require-hashes",
+		"--only-binary", "--no-binary", "--trusted-host", "--use-feature",
+	}
+)
+
+// pipRequirement is an entry of a requirements file.
+type pipRequirement struct {
+	name      string
+	version   string
+	startLine uint
+	endLine   uint
+	pinned    bool
+}
+
+func isRequirementsFile(pathfn string) bool {
+	if !strings.EqualFold(path.Ext(pathfn), ".txt") {
+		return false
+	}
+	// requirements.txt, dev-requirements.txt, requirements/test.txt.
+	return strings.Contains(strings.ToLower(path.Base(pathfn)), "requirements") ||
+		strings.EqualFold(path.Base(path.Dir(pathfn)), "requirements")
+}
+
+// parsePipRequirements returns the requirements of the file that are fetched from
+// a package index or a URL. A requirement is pinned if pip verifies its hash.
+func parsePipRequirements(content []byte) []pipRequirement {
+	var requirements []pipRequirement
+	lines := strings.Split(strings.ReplaceAll(string(content), "\r\n", "\n"), "\n")
+	for i := 0; i < len(lines); i++ {
+		start := uint(i + 1)
+		line := lines[i]
+		for strings.HasSuffix(strings.TrimSpace(line), `\`) && i+1 < len(lines) {
+			i++
+			line = strings.TrimSuffix(strings.TrimSpace(line), `\`) + " " + lines[i]
+		}
+		end := uint(i + 1)
+
+		// Comments must be preceded by whitespace, as `#` is also used in URLs.
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		if j := strings.Index(line, " #"); j >= 0 {
+			line = line[:j]
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		option, _, _ := strings.Cut(fields[0], "=")
+		switch {
+		case isOneOf(option, pipRequirementOptions):
+			continue
+		case option == "-e" || option == "--editable":
+			source := strings.TrimPrefix(strings.TrimPrefix(fields[0], option), "=")
+			if source == "" && len(fields) > 1 {
+				source = fields[1]
+			}
+			if isLocalPipRequirement(source) {
+				continue
+			}
+			requirements = append(requirements, pipRequirement{
+				name:      source,
+				startLine: start,
+				endLine:   end,
+				pinned:    isPinnedEditableSource(source),
+			})
+			continue
+		case isLocalPipRequirement(fields[0]):
+			continue
+		}
+
+		r := pipRequirement{
+			name:      fields[0],
+			startLine: start,
+			endLine:   end,
+			pinned:    strings.Contains(line, "--hash="),
+		}
+		spec := strings.Join(fields, " ")
+		if m := pipRequirementRegex.FindStringSubmatch(spec); m != nil {
+			r.name = m[1]
+			spec, _, _ = strings.Cut(m[3], "--")
+			spec, _, _ = strings.Cut(spec, ";")
+			spec = strings.TrimSpace(spec)
+			if v := pipVersionRegex.FindStringSubmatch(spec); v != nil {
+				r.version = v[1]
+			}
+			// Direct references, e.g., `pkg @ git+https://github.com/org/pkg@<sha>`.
+			if strings.HasPrefix(spec, "@") {
+				url := strings.TrimSpace(strings.TrimPrefix(spec, "@"))
+				isVCS := strings.Contains(strings.SplitN(url, "://", 2)[0], "+")
+				r.pinned = r.pinned || strings.Contains(url, "#sha256=") || isVCS && isPinnedEditableSource(url)
+			}
+		} else if strings.Contains(spec, "#sha256=") {
+			r.pinned = true
+		}
+		requirements = append(requirements, r)
+	}
+	return requirements
+}
+
+// isLocalPipRequirement returns whether the requirement is part of the repository.
+func isLocalPipRequirement(source string) bool {
+	return source == "." || strings.HasPrefix(source, "./") || strings.HasPrefix(source, "../") ||
+		strings.HasPrefix(source, "/") || strings.HasPrefix(source, "file:")
+}
+
+func collectPipRequirementsPinning(c *checker.CheckRequest, r *checker.PinningDependenciesData) error {
+	return fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
+		Pattern:       "*.txt",
+		CaseSensitive: false,
+	}, validatePipRequirements, r)
+}
+
+var validatePipRequirements fileparser.DoWhileTrueOnFileContent = func(
+	pathfn string,
+	content []byte,
+	args ...interface{},
+) (bool, error) {
+	if len(args) != 1 {
+		return false, fmt.Errorf(
+			"validatePipRequirements requires exactly 1 arguments: got %v: %w", len(args), errInvalidArgLength)
+	}
+	pdata := dataAsPinnedDependenciesPointer(args[0])
+
+	if !isRequirementsFile(pathfn) || isVendoredPath(pathfn) {
+		return true, nil
+	}
+
+	lines := strings.Split(string(content), "\n")
+	for _, req := range parsePipRequirements(content) {
+		dep := checker.Dependency{
+			Location: &checker.File{
+				Path:      pathfn,
+				Type:      finding.FileTypeSource,
+				Offset:    req.startLine,
+				EndOffset: req.endLine,
+				Snippet:   strings.TrimSpace(lines[req.startLine-1]),
+			},
+			Name:   asPointer(req.name),
+			Pinned: asBoolPointer(req.pinned),
+			Type:   checker.DependencyUseTypePipRequirement,
+		}
+		if req.version != "" {
+			dep.PinnedAt = asPointer(req.version)
+		}
+		pdata.Dependencies = append(pdata.Dependencies, dep)
+	}
+	return true, nil
+}
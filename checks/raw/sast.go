@@ -0,0 +1,504 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/rhysd/actionlint"
+	"gopkg.in/yaml.v3"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/checks/fileparser"
+	"github.com/ossf/scorecard/v4/clients"
+	sce "github.com/ossf/scorecard/v4/errors"
+	"github.com/ossf/scorecard/v4/finding"
+)
+
+// sastCheckRunApps are the apps whose check runs report a SAST analysis.
+var sastCheckRunApps = map[string]checker.SASTTool{
+	"github-advanced-security": checker.SASTToolCodeQL,
+	"github-code-scanning":     checker.SASTToolCodeScanning,
+	"lgtm-com":                 checker.SASTToolLGTM,
+	"sonarcloud":               checker.SASTToolSonar,
+}
+
+var allowedConclusions = map[string]bool{"success": true, "neutral": true}
+
+// sastStatusContexts match the contexts of the commit statuses reporting a SAST
+// analysis, e.g. `code/snyk (org)`, or the names of the CI jobs running one.
+// GitLab SAST analyzer jobs are named `<analyzer>-sast`, e.g. `semgrep-sast`.
+var sastStatusContexts = []struct {
+	regex *regexp.Regexp
+	tool  checker.SASTTool
+}{
+	{regexp.MustCompile(`-sast$`), checker.SASTToolGitLabSAST},
+	{regexp.MustCompile(`codeql`), checker.SASTToolCodeQL},
+	{regexp.MustCompile(`^code/snyk`), checker.SASTToolSnykCode},
+	{regexp.MustCompile(`semgrep`), checker.SASTToolSemgrep},
+	{regexp.MustCompile(`sonar`), checker.SASTToolSonar},
+	{regexp.MustCompile(`gosec`), checker.SASTToolGosec},
+	{regexp.MustCompile(`golangci`), checker.SASTToolGolangciLint},
+	{regexp.MustCompile(`bandit`), checker.SASTToolBandit},
+	{regexp.MustCompile(`brakeman`), checker.SASTToolBrakeman},
+}
+
+// sastActions are the GitHub Actions and container images running a SAST tool.
+// They are matched on the prefix of the lowercase action or image name.
+var sastActions = []struct {
+	prefix string
+	tool   checker.SASTTool
+}{
+	{"github/codeql-action/analyze", checker.SASTToolCodeQL},
+	{"returntocorp/semgrep", checker.SASTToolSemgrep},
+	{"semgrep/semgrep", checker.SASTToolSemgrep},
+	{"securego/gosec", checker.SASTToolGosec},
+	{"golangci/golangci-lint-action", checker.SASTToolGolangciLint},
+	{"pycqa/bandit-action", checker.SASTToolBandit},
+	{"sonarsource/sonarcloud-github-action", checker.SASTToolSonar},
+	{"sonarsource/sonarqube-scan-action", checker.SASTToolSonar},
+}
+
+// sastCommandPrefix matches the start of a shell command, optionally run
+// through a package runner, e.g. `bundle exec brakeman` or `python -m bandit`.
+const sastCommandPrefix = `(?m)(?:^|[;&|(])\s*(?:bundle exec\s+|python3?\s+-m\s+|npx\s+|pipx run\s+|uvx\s+)?`
+
+// sastCommands match the shell commands running a SAST tool.
+var sastCommands = []struct {
+	regex *regexp.Regexp
+	tool  checker.SASTTool
+}{
+	{regexp.MustCompile(sastCommandPrefix + `semgrep\s+(?:ci|scan|--config)`), checker.SASTToolSemgrep},
+	{regexp.MustCompile(sastCommandPrefix + `snyk\s+code\s+test`), checker.SASTToolSnykCode},
+	{regexp.MustCompile(sastCommandPrefix + `gosec\s+\S`), checker.SASTToolGosec},
+	{regexp.MustCompile(sastCommandPrefix + `golangci-lint\s+run`), checker.SASTToolGolangciLint},
+	{regexp.MustCompile(sastCommandPrefix + `bandit\s+\S`), checker.SASTToolBandit},
+	{regexp.MustCompile(sastCommandPrefix + `brakeman(?:\s|$)`), checker.SASTToolBrakeman},
+	{regexp.MustCompile(sastCommandPrefix + `sonar-scanner(?:\s|$)`), checker.SASTToolSonar},
+}
+
+// gitlabSASTTemplateRegex matches the GitLab SAST templates and CI/CD component, e.g.
+// `Security/SAST.gitlab-ci.yml` or `$CI_SERVER_FQDN/components/sast/sast@2`.
+var gitlabSASTTemplateRegex = regexp.MustCompile(
+	`(?i)(?:(?:^|/)SAST(?:\.latest)?\.gitlab-ci\.yml$|/components/sast/sast(?:@|$))`)
+
+// eslintSecurityPluginRegex matches the ESLint security plugins in an ESLint configuration.
+var eslintSecurityPluginRegex = regexp.MustCompile(
+	`eslint-plugin-(?:security|no-unsanitized)\b|@microsoft/eslint-plugin-sdl|` +
+		`plugin:(?:security|no-unsanitized|@microsoft/sdl)/|` +
+		`["']?plugins["']?\s*:\s*\[[^\]]*["'](?:security|no-unsanitized|@microsoft/sdl)["']`)
+
+var (
+	sonarHostURLRegex    = regexp.MustCompile(`<sonar\.host\.url>\s*(\S+)\s*<\/sonar\.host\.url>`)
+	sonarProjectKeyRegex = regexp.MustCompile(`(?m)^\s*sonar\.projectKey\s*[=:]`)
+	snykCodeCommandRegex = regexp.MustCompile(`^\s*code\s+test\b`)
+)
+
+var eslintConfigFiles = map[string]bool{
+	".eslintrc":         true,
+	".eslintrc.js":      true,
+	".eslintrc.cjs":     true,
+	".eslintrc.json":    true,
+	".eslintrc.yaml":    true,
+	".eslintrc.yml":     true,
+	"eslint.config.js":  true,
+	"eslint.config.cjs": true,
+	"eslint.config.mjs": true,
+	"eslint.config.ts":  true,
+}
+
+// SAST retrieves the raw data for the SAST check.
+func SAST(c *checker.CheckRequest) (checker.SASTData, error) {
+	var data checker.SASTData
+	prs, err := sastToolsInPullRequests(c.RepoClient)
+	if err != nil {
+		return checker.SASTData{}, err
+	}
+	data.PullRequests = prs
+
+	err = fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
+		Pattern:       "*",
+		CaseSensitive: false,
+	}, validateSASTConfig, &data.Configs)
+	if err != nil {
+		return checker.SASTData{}, err
+	}
+	return data, nil
+}
+
+// sastToolsInPullRequests lists the SAST tools with a successful check run
+// or commit status on the head commit of the recently merged pull requests.
+func sastToolsInPullRequests(c clients.RepoClient) ([]checker.SASTPullRequest, error) {
+	commits, err := c.ListCommits()
+	if err != nil {
+		return nil, sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("RepoClient.ListCommits: %v", err))
+	}
+
+	prs := []checker.SASTPullRequest{}
+	seen := make(map[string]bool)
+	for i := range commits {
+		pr := &commits[i].AssociatedMergeRequest
+		// TODO(#575): We ignore associated PRs if Scorecard is being run on a fork
+		// but the PR was created in the original repo.
+		if pr.MergedAt.IsZero() {
+			continue
+		}
+		// With squash merges, several commits map to the head of the same PR.
+		if seen[pr.HeadSHA] {
+			continue
+		}
+		seen[pr.HeadSHA] = true
+
+		crs, err := c.ListCheckRunsForRef(pr.HeadSHA)
+		if err != nil {
+			return nil, sce.WithMessage(sce.ErrScorecardInternal,
+				fmt.Sprintf("Client.Checks.ListCheckRunsForRef: %v", err))
+		}
+		statuses, err := c.ListStatuses(pr.HeadSHA)
+		if err != nil {
+			return nil, sce.WithMessage(sce.ErrScorecardInternal,
+				fmt.Sprintf("Client.Repositories.ListStatuses: %v", err))
+		}
+		prs = append(prs, checker.SASTPullRequest{
+			Number:  pr.Number,
+			HeadSHA: pr.HeadSHA,
+			Tools:   sastToolsInChecks(crs, statuses),
+		})
+	}
+	return prs, nil
+}
+
+func sastToolsInChecks(crs []clients.CheckRun, statuses []clients.Status) []checker.SASTTool {
+	var tools []checker.SASTTool
+	add := func(tool checker.SASTTool) {
+		for _, t := range tools {
+			if t == tool {
+				return
+			}
+		}
+		tools = append(tools, tool)
+	}
+	// Note: crs may be `nil`: in this case
+	// the loop below will be skipped.
+	for _, cr := range crs {
+		if cr.Status != "completed" || !allowedConclusions[cr.Conclusion] {
+			continue
+		}
+		if tool, ok := sastCheckRunApps[cr.App.Slug]; ok {
+			add(tool)
+		}
+	}
+	for _, status := range statuses {
+		if status.State != "success" {
+			continue
+		}
+		context := strings.ToLower(status.Context)
+		for _, c := range sastStatusContexts {
+			if c.regex.MatchString(context) {
+				add(c.tool)
+				break
+			}
+		}
+	}
+	return tools
+}
+
+// Check file content.
+var validateSASTConfig fileparser.DoWhileTrueOnFileContent = func(pathfn string,
+	content []byte,
+	args ...interface{},
+) (bool, error) {
+	if len(args) != 1 {
+		return false, fmt.Errorf(
+			"validateSASTConfig requires exactly 1 arguments: %w", errInvalidArgLength)
+	}
+	configs, ok := args[0].(*[]checker.SASTConfig)
+	if !ok {
+		return false, fmt.Errorf(
+			"validateSASTConfig expects arg[0] of type *[]checker.SASTConfig: %w", errInvalidArgType)
+	}
+	if strings.Contains(pathfn, "node_modules/") {
+		return true, nil
+	}
+
+	var found []checker.SASTConfig
+	var err error
+	base := strings.ToLower(path.Base(pathfn))
+	switch {
+	case fileparser.IsWorkflowFile(pathfn):
+		found, err = sastToolsInWorkflow(pathfn, content)
+	case strings.HasSuffix(base, ".gitlab-ci.yml"):
+		found, err = sastToolsInGitLabCI(pathfn, content)
+	case base == "pom.xml":
+		found = sonarInPom(pathfn, content)
+	case base == "sonar-project.properties":
+		found = sastToolInFile(pathfn, content, sonarProjectKeyRegex,
+			checker.SASTToolSonar)
+	case eslintConfigFiles[base]:
+		found = sastToolInFile(pathfn, content, eslintSecurityPluginRegex, checker.SASTToolESLintSecurity)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	// Record each tool once per file.
+	for _, config := range found {
+		duplicate := false
+		for _, c := range *configs {
+			if c.Tool == config.Tool && c.File.Path == config.File.Path {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			*configs = append(*configs, config)
+		}
+	}
+	return true, nil
+}
+
+func newSASTConfig(pathfn string, line uint, tool checker.SASTTool, snippet string) checker.SASTConfig {
+	return checker.SASTConfig{
+		Tool: tool,
+		File: checker.File{
+			Path:      pathfn,
+			Type:      finding.FileTypeSource,
+			Offset:    line,
+			EndOffset: line,
+			Snippet:   snippet,
+		},
+	}
+}
+
+func sastToolInImage(image string) (checker.SASTTool, bool) {
+	image = strings.TrimPrefix(strings.ToLower(image), "docker://")
+	image = strings.TrimPrefix(image, "docker.io/")
+	for _, a := range sastActions {
+		if strings.HasPrefix(image, a.prefix) {
+			return a.tool, true
+		}
+	}
+	return "", false
+}
+
+func sastToolsInCommand(script string) []checker.SASTTool {
+	var tools []checker.SASTTool
+	for _, c := range sastCommands {
+		if c.regex.MatchString(script) {
+			tools = append(tools, c.tool)
+		}
+	}
+	return tools
+}
+
+func sastToolsInWorkflow(pathfn string, content []byte) ([]checker.SASTConfig, error) {
+	workflow, errs := actionlint.Parse(content)
+	if len(errs) > 0 && workflow == nil {
+		return nil, fileparser.FormatActionlintError(errs)
+	}
+
+	var configs []checker.SASTConfig
+	for _, job := range workflow.Jobs {
+		if job == nil {
+			continue
+		}
+		if job.Container != nil && job.Container.Image != nil {
+			if tool, ok := sastToolInImage(job.Container.Image.Value); ok {
+				configs = append(configs, newSASTConfig(pathfn,
+					fileparser.GetLineNumber(job.Container.Image.Pos), tool, job.Container.Image.Value))
+			}
+		}
+		for _, step := range job.Steps {
+			if step == nil {
+				continue
+			}
+			switch e := step.Exec.(type) {
+			case *actionlint.ExecAction:
+				if e.Uses == nil {
+					continue
+				}
+				line := fileparser.GetLineNumber(e.Uses.Pos)
+				if tool, ok := sastToolInAction(e); ok {
+					configs = append(configs, newSASTConfig(pathfn, line, tool, e.Uses.Value))
+				}
+			case *actionlint.ExecRun:
+				if e.Run == nil {
+					continue
+				}
+				for _, tool := range sastToolsInCommand(e.Run.Value) {
+					configs = append(configs, newSASTConfig(pathfn,
+						fileparser.GetLineNumber(e.Run.Pos), tool, fileparser.GetStepName(step)))
+				}
+			}
+		}
+	}
+	// The jobs are a map: report the tools in the order of the file.
+	sort.SliceStable(configs, func(i, j int) bool {
+		return configs[i].File.Offset < configs[j].File.Offset
+	})
+	return configs, nil
+}
+
+func sastToolInAction(e *actionlint.ExecAction) (checker.SASTTool, bool) {
+	uses := strings.TrimPrefix(e.Uses.Value, "actions://")
+	action, _, _ := strings.Cut(strings.ToLower(uses), "@")
+	// The Snyk actions run all the Snyk products: only `snyk code test` is a SAST analysis.
+	if strings.HasPrefix(action, "snyk/actions/") {
+		input, ok := e.Inputs["command"]
+		if ok && input != nil && input.Value != nil &&
+			snykCodeCommandRegex.MatchString(input.Value.Value) {
+			return checker.SASTToolSnykCode, true
+		}
+		return "", false
+	}
+	return sastToolInImage(action)
+}
+
+// sastToolsInGitLabCI looks for the GitLab SAST templates and the jobs
+// running a SAST tool in a GitLab CI configuration.
+func sastToolsInGitLabCI(pathfn string, content []byte) ([]checker.SASTConfig, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		return nil, sce.WithMessage(sce.ErrScorecardInternal,
+			fmt.Sprintf("unable to parse GitLab CI configuration %s: %v", pathfn, err))
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil, nil
+	}
+
+	var configs []checker.SASTConfig
+	root := doc.Content[0]
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		key, value := root.Content[i], root.Content[i+1]
+		if key.Value == "include" {
+			configs = append(configs, gitlabSASTIncludes(pathfn, value)...)
+			continue
+		}
+		if value.Kind != yaml.MappingNode {
+			continue
+		}
+		// Jobs.
+		for j := 0; j+1 < len(value.Content); j += 2 {
+			k, v := value.Content[j], value.Content[j+1]
+			switch k.Value {
+			case "image":
+				image := v
+				if v.Kind == yaml.MappingNode {
+					image = fileparser.YAMLMappingValue(v, "name")
+				}
+				if image == nil || image.Kind != yaml.ScalarNode {
+					continue
+				}
+				if tool, ok := sastToolInImage(image.Value); ok {
+					configs = append(configs, newSASTConfig(pathfn, uint(image.Line), tool, image.Value))
+				}
+			case "script", "before_script":
+				for _, line := range yamlScalars(v) {
+					for _, tool := range sastToolsInCommand(line.Value) {
+						configs = append(configs, newSASTConfig(pathfn, uint(line.Line), tool, key.Value))
+					}
+				}
+			}
+		}
+	}
+	return configs, nil
+}
+
+func gitlabSASTIncludes(pathfn string, include *yaml.Node) []checker.SASTConfig {
+	var configs []checker.SASTConfig
+	var includes []*yaml.Node
+	if include.Kind == yaml.SequenceNode {
+		includes = include.Content
+	} else {
+		includes = []*yaml.Node{include}
+	}
+	for _, inc := range includes {
+		var refs []*yaml.Node
+		switch inc.Kind {
+		case yaml.ScalarNode:
+			refs = append(refs, inc)
+		case yaml.MappingNode:
+			for _, k := range []string{"template", "component", "remote"} {
+				if n := fileparser.YAMLMappingValue(inc, k); n != nil && n.Kind == yaml.ScalarNode {
+					refs = append(refs, n)
+				}
+			}
+		}
+		for _, ref := range refs {
+			if gitlabSASTTemplateRegex.MatchString(ref.Value) {
+				configs = append(configs, newSASTConfig(pathfn, uint(ref.Line), checker.SASTToolGitLabSAST, ref.Value))
+			}
+		}
+	}
+	return configs
+}
+
+// yamlScalars returns the scalar or the scalars of the sequence node.
+func yamlScalars(node *yaml.Node) []*yaml.Node {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		return []*yaml.Node{node}
+	case yaml.SequenceNode:
+		var scalars []*yaml.Node
+		for _, n := range node.Content {
+			if n.Kind == yaml.ScalarNode {
+				scalars = append(scalars, n)
+			}
+		}
+		return scalars
+	}
+	return nil
+}
+
+// sonarInPom looks for the Sonar server of a Maven project.
+func sonarInPom(pathfn string, content []byte) []checker.SASTConfig {
+	match := sonarHostURLRegex.FindSubmatch(content)
+	if len(match) < 2 {
+		return nil
+	}
+	config := newSASTConfig(pathfn, findLine(content, []byte("<sonar.host.url>")),
+		checker.SASTToolSonar, string(match[1]))
+	config.File.EndOffset = findLine(content, []byte("</sonar.host.url>"))
+	return []checker.SASTConfig{config}
+}
+
+// sastToolInFile records the tool if a line of the file matches the regex.
+func sastToolInFile(pathfn string, content []byte, regex *regexp.Regexp, tool checker.SASTTool) []checker.SASTConfig {
+	loc := regex.FindIndex(content)
+	if loc == nil {
+		return nil
+	}
+	line := uint(bytes.Count(content[:loc[0]], []byte("\n")) + 1)
+	return []checker.SASTConfig{newSASTConfig(pathfn, line, tool, "")}
+}
+
+// findLine returns the number of the first line containing data, 0 if none does.
+func findLine(content, data []byte) uint {
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	line := uint(0)
+	for scanner.Scan() {
+		line++
+		if bytes.Contains(scanner.Bytes(), data) {
+			return line
+		}
+	}
+	return 0
+}
@@ -0,0 +1,249 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/clients"
+)
+
+func TestSASTConfig(t *testing.T) {
+	t.Parallel()
+
+	type config struct {
+		tool checker.SASTTool
+		line uint
+	}
+	//nolint:govet
+	tests := []struct {
+		name     string
+		path     string
+		filename string
+		want     []config
+	}{
+		{
+			name:     "CodeQL workflow",
+			path:     ".github/workflows/github-workflow-sast-codeql.yaml",
+			filename: ".github/workflows/codeql.yaml",
+			want: []config{
+				{tool: checker.SASTToolCodeQL, line: 22},
+			},
+		},
+		{
+			name:     "workflow without SAST",
+			path:     ".github/workflows/github-workflow-sast-no-codeql.yaml",
+			filename: ".github/workflows/build.yaml",
+		},
+		{
+			name:     "workflow running SAST tools",
+			path:     ".github/workflows/github-workflow-sast-tools.yaml",
+			filename: ".github/workflows/sast.yaml",
+			want: []config{
+				{tool: checker.SASTToolSemgrep, line: 21},
+				{tool: checker.SASTToolGolangciLint, line: 29},
+				{tool: checker.SASTToolGosec, line: 31},
+				{tool: checker.SASTToolBandit, line: 38},
+				{tool: checker.SASTToolBrakeman, line: 42},
+				{tool: checker.SASTToolSnykCode, line: 49},
+			},
+		},
+		{
+			name:     "GitLab CI with SAST",
+			path:     "sast/gitlab-ci.yml",
+			filename: ".gitlab-ci.yml",
+			want: []config{
+				{tool: checker.SASTToolGitLabSAST, line: 15},
+				{tool: checker.SASTToolSemgrep, line: 24},
+				{tool: checker.SASTToolGosec, line: 33},
+			},
+		},
+		{
+			name:     "GitLab CI without SAST",
+			path:     "sast/gitlab-ci-no-sast.yml",
+			filename: ".gitlab-ci.yml",
+		},
+		{
+			name:     "sonar config 1 line",
+			path:     "pom-1line.xml",
+			filename: "pom.xml",
+			want: []config{
+				{tool: checker.SASTToolSonar, line: 2},
+			},
+		},
+		{
+			name:     "sonar config 2 lines",
+			path:     "pom-2lines.xml",
+			filename: "pom.xml",
+			want: []config{
+				{tool: checker.SASTToolSonar, line: 2},
+			},
+		},
+		{
+			name:     "sonar scanner properties",
+			path:     "sast/sonar-project.properties",
+			filename: "sonar-project.properties",
+			want: []config{
+				{tool: checker.SASTToolSonar, line: 3},
+			},
+		},
+		{
+			name:     "eslintrc with security plugin",
+			path:     "sast/eslintrc.json",
+			filename: ".eslintrc.json",
+			want: []config{
+				{tool: checker.SASTToolESLintSecurity, line: 5},
+			},
+		},
+		{
+			name:     "flat ESLint config with security plugin",
+			path:     "sast/eslint.config.mjs",
+			filename: "web/eslint.config.mjs",
+			want: []config{
+				{tool: checker.SASTToolESLintSecurity, line: 2},
+			},
+		},
+		{
+			name:     "eslintrc without security plugin",
+			path:     "sast/eslintrc-no-security.json",
+			filename: ".eslintrc.json",
+		},
+		{
+			name:     "sonar config in a file which is not a pom",
+			path:     "pom-1line.xml",
+			filename: "settings.xml",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			content, err := os.ReadFile("./testdata/" + tt.path)
+			if err != nil {
+				t.Fatalf("cannot read file: %v", err)
+			}
+			var configs []checker.SASTConfig
+			if _, err := validateSASTConfig(tt.filename, content, &configs); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var got []config
+			for _, c := range configs {
+				if c.File.Path != tt.filename {
+					t.Errorf("unexpected path %s", c.File.Path)
+				}
+				got = append(got, config{tool: c.Tool, line: c.File.Offset})
+			}
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(config{})); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSASTConfig_invalidArgs(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		args []any
+	}{
+		{
+			name: "too few arguments",
+			args: []any{},
+		},
+		{
+			name: "wrong arguments",
+			args: []any{
+				&[]int{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if _, err := validateSASTConfig(".github/workflows/codeql.yaml", nil, tt.args...); err == nil {
+				t.Errorf("Expected error but err was nil")
+			}
+		})
+	}
+}
+
+func TestSASTToolsInChecks(t *testing.T) {
+	t.Parallel()
+	//nolint:govet
+	tests := []struct {
+		name      string
+		checkRuns []clients.CheckRun
+		statuses  []clients.Status
+		want      []checker.SASTTool
+	}{
+		{
+			name: "no checks",
+		},
+		{
+			name: "check runs",
+			checkRuns: []clients.CheckRun{
+				{Status: "completed", Conclusion: "success", App: clients.CheckRunApp{Slug: "github-actions"}},
+				{Status: "completed", Conclusion: "neutral", App: clients.CheckRunApp{Slug: "github-code-scanning"}},
+				{Status: "completed", Conclusion: "failure", App: clients.CheckRunApp{Slug: "sonarcloud"}},
+				{Status: "in_progress", App: clients.CheckRunApp{Slug: "lgtm-com"}},
+			},
+			want: []checker.SASTTool{checker.SASTToolCodeScanning},
+		},
+		{
+			name: "statuses",
+			statuses: []clients.Status{
+				{State: "success", Context: "code/snyk (example)"},
+				{State: "success", Context: "security/snyk (example)"},
+				{State: "success", Context: "semgrep-cloud-platform/scan"},
+				{State: "success", Context: "brakeman-sast"},
+				{State: "success", Context: "nodejs-scan-sast"},
+				{State: "failed", Context: "gosec"},
+				{State: "success", Context: "SonarCloud Code Analysis"},
+			},
+			want: []checker.SASTTool{
+				checker.SASTToolSnykCode,
+				checker.SASTToolSemgrep,
+				checker.SASTToolGitLabSAST,
+				checker.SASTToolSonar,
+			},
+		},
+		{
+			name: "same tool in check runs and statuses",
+			checkRuns: []clients.CheckRun{
+				{Status: "completed", Conclusion: "success", App: clients.CheckRunApp{Slug: "github-advanced-security"}},
+			},
+			statuses: []clients.Status{
+				{State: "success", Context: "CodeQL"},
+			},
+			want: []checker.SASTTool{checker.SASTToolCodeQL},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := sastToolsInChecks(tt.checkRuns, tt.statuses)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
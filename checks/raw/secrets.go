@@ -0,0 +1,334 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/checks/fileparser"
+	"github.com/ossf/scorecard/v4/clients"
+	"github.com/ossf/scorecard/v4/finding"
+)
+
+const (
+	// redactedSecret replaces the value of the secrets in the snippets.
+	redactedSecret = "[REDACTED]"
+	// maxSecretsFileSize is the size above which files are not scanned.
+	maxSecretsFileSize = 1 << 20
+	// maxSecretSnippetLength is the maximum length of a snippet.
+	maxSecretSnippetLength = 200
+	// minEntropySecretLength is the minimum length of the
+	// strings tested by the entropy heuristic.
+	minEntropySecretLength = 20
+	// minSecretEntropy is the Shannon entropy, in bits per character,
+	// above which a string assigned to a secret-like name is reported.
+	minSecretEntropy = 3.5
+	// minSecretTokenLength is the minimum length of the other tokens
+	// redacted from a line with a secret.
+	minSecretTokenLength = 16
+	// minSecretTokenEntropy is the entropy above which a token without digits
+	// is redacted, which is higher than the entropy of long identifiers.
+	minSecretTokenEntropy = 4.0
+)
+
+type secretPattern struct {
+	secretType checker.SecretType
+	regex      *regexp.Regexp
+}
+
+// secretPatterns are high-confidence patterns: the format of
+// these secrets is specific enough to report them on their own.
+var secretPatterns = []secretPattern{
+	{
+		secretType: checker.SecretAWSAccessKey,
+		regex:      regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`),
+	},
+	{
+		secretType: checker.SecretGoogleAPIKey,
+		regex:      regexp.MustCompile(`\bAIza[0-9A-Za-z_\-]{35}\b`),
+	},
+	{
+		// The whole remainder of the line is redacted, because
+		// the key may be inlined in the line, e.g., in a JSON string.
+		secretType: checker.SecretPrivateKey,
+		regex: regexp.MustCompile(
+			`-----BEGIN (?:(?:RSA|EC|DSA|OPENSSH|PGP|ENCRYPTED) )?PRIVATE KEY(?: BLOCK)?-----.*`),
+	},
+	{
+		secretType: checker.SecretGitHubToken,
+		regex:      regexp.MustCompile(`\b(?:gh[pousr]_[A-Za-z0-9]{36}|github_pat_[A-Za-z0-9_]{82})\b`),
+	},
+	{
+		secretType: checker.SecretGitLabToken,
+		regex:      regexp.MustCompile(`\bglpat-[A-Za-z0-9_\-]{20}\b`),
+	},
+	{
+		secretType: checker.SecretSlackToken,
+		regex:      regexp.MustCompile(`\bxox[abposr]-[0-9]{10,13}-[0-9A-Za-z\-]{10,}\b`),
+	},
+	{
+		secretType: checker.SecretStripeKey,
+		regex:      regexp.MustCompile(`\b[rs]k_live_[0-9A-Za-z]{24,}\b`),
+	},
+	{
+		secretType: checker.SecretNpmToken,
+		regex:      regexp.MustCompile(`\bnpm_[A-Za-z0-9]{36}\b`),
+	},
+	{
+		secretType: checker.SecretPyPIToken,
+		regex:      regexp.MustCompile(`\bpypi-AgEIcHlwaS5vcmc[A-Za-z0-9_\-]{50,}`),
+	},
+	{
+		secretType: checker.SecretJWT,
+		regex:      regexp.MustCompile(`\beyJ[A-Za-z0-9_\-]{10,}\.eyJ[A-Za-z0-9_\-]{10,}\.[A-Za-z0-9_\-]{10,}`),
+	},
+}
+
+// secretAssignmentRegex matches quoted strings assigned to a secret-like name,
+// e.g., `api_key = "..."` or `"password": "..."`. The first group is the value.
+var secretAssignmentRegex = regexp.MustCompile(
+	`(?i)(?:passw(?:or)?d|secret|token|api[_\-]?key|access[_\-]?key|auth[_\-]?key|credentials?)` +
+		`[a-z0-9_\-]*["']?\s*(?::=|=>|=|:)\s*["']([A-Za-z0-9+/=_\-.]{20,})["']`)
+
+// assignmentValueRegex matches the values assigned on a line, quoted or not, e.g., `KEY=value`,
+// `key: value` or `"key":"value"`. Only one of the groups is set: the value without its quotes.
+var assignmentValueRegex = regexp.MustCompile(
+	`[\w.\-]["']?\s*(?:(?::=|=>|=|:\s)\s*(?:"([^"]*)"|'([^']*)'|([^\s"',;\[\]{}()]+))|:\s*(?:"([^"]*)"|'([^']*)'))`)
+
+// secretTokenRegex matches the tokens of a line that may be secrets, e.g., base64 or hex strings.
+var secretTokenRegex = regexp.MustCompile(`[A-Za-z0-9+/_\-.~]{16,}={0,2}`)
+
+// secretPlaceholders are substrings of values that are not real secrets.
+var secretPlaceholders = []string{"example", "xxxx", "changeme", "placeholder", "dummy", "fake", "redacted"}
+
+// Secrets retrieves the raw data for the Secrets check.
+func Secrets(c clients.RepoClient) (checker.SecretsData, error) {
+	var secrets []checker.Secret
+	err := fileparser.OnMatchingFileContentDo(c, fileparser.PathMatcher{
+		Pattern:       "*",
+		CaseSensitive: false,
+	}, checkSecretsInFileContent, &secrets)
+	if err != nil {
+		return checker.SecretsData{}, fmt.Errorf("%w", err)
+	}
+	return checker.SecretsData{Secrets: secrets}, nil
+}
+
+var checkSecretsInFileContent fileparser.DoWhileTrueOnFileContent = func(pathfn string, content []byte,
+	args ...interface{},
+) (bool, error) {
+	if len(args) != 1 {
+		return false, fmt.Errorf(
+			"checkSecretsInFileContent requires exactly one argument: %w", errInvalidArgLength)
+	}
+	psecrets, ok := args[0].(*[]checker.Secret)
+	if !ok {
+		return false, fmt.Errorf(
+			"checkSecretsInFileContent requires argument of type *[]checker.Secret: %w", errInvalidArgType)
+	}
+
+	if isVendoredPath(pathfn) || isTestOrFixturePath(pathfn) ||
+		len(content) > maxSecretsFileSize || bytes.IndexByte(content, 0) != -1 {
+		return true, nil
+	}
+
+	for i, line := range bytes.Split(content, []byte("\n")) {
+		*psecrets = append(*psecrets, secretsInLine(pathfn, uint(i+1), string(line))...)
+	}
+	return true, nil
+}
+
+type secretMatch struct {
+	secretType     checker.SecretType
+	highConfidence bool
+	start, end     int
+}
+
+// secretsInLine returns the secrets of a line. The snippet of every
+// secret is the line with all the secrets of the line redacted.
+func secretsInLine(pathfn string, lineNumber uint, line string) []checker.Secret {
+	var matches []secretMatch
+	for _, p := range secretPatterns {
+		for _, loc := range p.regex.FindAllStringIndex(line, -1) {
+			matches = append(matches, secretMatch{
+				secretType:     p.secretType,
+				highConfidence: true,
+				start:          loc[0],
+				end:            loc[1],
+			})
+		}
+	}
+	for _, loc := range secretAssignmentRegex.FindAllStringSubmatchIndex(line, -1) {
+		start, end := loc[2], loc[3]
+		if overlapsSecretMatch(matches, start, end) || !isHighEntropySecret(line[start:end]) {
+			continue
+		}
+		matches = append(matches, secretMatch{
+			secretType: checker.SecretHighEntropy,
+			start:      start,
+			end:        end,
+		})
+	}
+	if len(matches) == 0 {
+		return nil
+	}
+
+	// The other values of the line may be secrets not matched by the patterns, e.g.,
+	// `AWS_SECRET_ACCESS_KEY=...` next to an access key ID, or the secret access key
+	// following the key ID in a CSV file of AWS credentials.
+	spans := matches
+	for _, loc := range assignmentValueRegex.FindAllStringSubmatchIndex(line, -1) {
+		for g := 2; g+1 < len(loc); g += 2 {
+			if loc[g] >= 0 {
+				spans = append(spans, secretMatch{start: loc[g], end: loc[g+1]})
+				break
+			}
+		}
+	}
+	for _, loc := range secretTokenRegex.FindAllStringIndex(line, -1) {
+		if looksLikeSecret(line[loc[0]:loc[1]]) {
+			spans = append(spans, secretMatch{start: loc[0], end: loc[1]})
+		}
+	}
+	snippet := redactSecrets(line, spans)
+	secrets := make([]checker.Secret, 0, len(matches))
+	for _, m := range matches {
+		secrets = append(secrets, checker.Secret{
+			Type:           m.secretType,
+			HighConfidence: m.highConfidence,
+			File: checker.File{
+				Path:      pathfn,
+				Type:      finding.FileTypeSource,
+				Offset:    lineNumber,
+				EndOffset: lineNumber,
+				Snippet:   snippet,
+			},
+		})
+	}
+	return secrets
+}
+
+func overlapsSecretMatch(matches []secretMatch, start, end int) bool {
+	for _, m := range matches {
+		if start < m.end && m.start < end {
+			return true
+		}
+	}
+	return false
+}
+
+// redactSecrets replaces all the spans of a line with a marker, overlapping spans
+// being replaced with a single marker, and trims the resulting line to a reasonable length.
+func redactSecrets(line string, spans []secretMatch) string {
+	sorted := make([]secretMatch, len(spans))
+	copy(sorted, spans)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].start < sorted[j].start
+	})
+
+	var sb strings.Builder
+	last := 0
+	for _, s := range sorted {
+		if s.end <= last || s.start == s.end {
+			continue
+		}
+		if s.start >= last {
+			sb.WriteString(line[last:s.start])
+			sb.WriteString(redactedSecret)
+		}
+		last = s.end
+	}
+	sb.WriteString(line[last:])
+
+	snippet := []rune(strings.TrimSpace(sb.String()))
+	if len(snippet) > maxSecretSnippetLength {
+		return string(snippet[:maxSecretSnippetLength]) + "..."
+	}
+	return string(snippet)
+}
+
+func isHighEntropySecret(value string) bool {
+	if len(value) < minEntropySecretLength {
+		return false
+	}
+	lower := strings.ToLower(value)
+	for _, p := range secretPlaceholders {
+		if strings.Contains(lower, p) {
+			return false
+		}
+	}
+	// Require both letters and digits, which excludes identifiers
+	// and sentences that happen to be long enough.
+	if !strings.ContainsAny(value, "0123456789") ||
+		!strings.ContainsAny(lower, "abcdefghijklmnopqrstuvwxyz") {
+		return false
+	}
+	return shannonEntropy(value) >= minSecretEntropy
+}
+
+// looksLikeSecret returns whether a token of a line with a secret is redacted as well:
+// long tokens mixing letters and digits, or random enough to not be identifiers.
+// Unlike isHighEntropySecret, placeholders are redacted too.
+func looksLikeSecret(token string) bool {
+	if len(token) < minSecretTokenLength {
+		return false
+	}
+	if strings.ContainsAny(token, "0123456789") &&
+		strings.ContainsAny(strings.ToLower(token), "abcdefghijklmnopqrstuvwxyz") {
+		return true
+	}
+	return shannonEntropy(token) >= minSecretTokenEntropy
+}
+
+// shannonEntropy returns the entropy of a string, in bits per character.
+func shannonEntropy(s string) float64 {
+	counts := make(map[rune]int)
+	total := 0
+	for _, r := range s {
+		counts[r]++
+		total++
+	}
+	var entropy float64
+	for _, c := range counts {
+		p := float64(c) / float64(total)
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
+
+// isTestOrFixturePath returns true for the files of tests and their fixtures,
+// which commonly contain fake secrets. The testdata directories are already
+// excluded by the fileparser.
+func isTestOrFixturePath(pathfn string) bool {
+	for _, dir := range []string{"test", "tests", "__tests__", "spec", "fixtures", "__fixtures__", "mocks"} {
+		if strings.HasPrefix(pathfn, dir+"/") || strings.Contains(pathfn, "/"+dir+"/") {
+			return true
+		}
+	}
+	base := strings.ToLower(path.Base(pathfn))
+	ext := path.Ext(base)
+	name := strings.TrimSuffix(base, ext)
+	return strings.HasSuffix(name, "_test") ||
+		strings.HasSuffix(name, ".test") ||
+		strings.HasSuffix(name, ".spec") ||
+		strings.HasPrefix(name, "test_")
+}
@@ -21,6 +21,7 @@ import (
 	"path"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/ossf/scorecard/v4/checker"
 	"github.com/ossf/scorecard/v4/checks/fileparser"
@@ -29,6 +30,46 @@ import (
 	"github.com/ossf/scorecard/v4/finding"
 )
 
+// securityPolicyRubric are the patterns of the content a security
+// policy is evaluated on. A pattern with a line filter only
+// matches lines which also match the filter.
+var securityPolicyRubric = []struct {
+	re              *regexp.Regexp
+	lineFilter      *regexp.Regexp
+	informationType checker.SecurityPolicyInformationType
+}{
+	{
+		informationType: checker.SecurityPolicyInformationTypeSupportedVersions,
+		re: regexp.MustCompile(`(?i)\bsupported\s+(versions?|releases?)\b|` +
+			`\bversions?\s+(that\s+)?(are\s+)?(currently\s+)?supported\b|` +
+			`\bsecurity\s+(updates|fixes|patches)\s+(are\s+)?(provided|released|backported)\b`),
+	},
+	{
+		informationType: checker.SecurityPolicyInformationTypePrivateReporting,
+		re: regexp.MustCompile(`(?i)\b[a-z0-9._%+-]*(security|secure|psirt|vuln|disclos)[a-z0-9._%+-]*@[a-z0-9.-]+\.[a-z]{2,}\b|` +
+			`/security/advisories/new\b|` +
+			`\bprivate(ly)?\s+(vulnerability\s+)?report(ing)?\b|` +
+			`https?://[^\s)>\]]*(\bforms?\b|hackerone\.com|bugcrowd\.com|huntr\.(dev|com)|intigriti\.com|yeswehack\.com|` +
+			`report[^\s)>\]]*(vulnerab|security)|(vulnerab|security)[^\s)>\]]*report)[^\s)>\]]*`),
+	},
+	{
+		informationType: checker.SecurityPolicyInformationTypeResponseTime,
+		re: regexp.MustCompile(`(?i)\b(within|in|under|up\s+to)\s+(\d+|one|two|three|four|five|seven|ten|fourteen|thirty|a|an)` +
+			`(\s*-\s*\d+)?\s+(business\s+|working\s+|calendar\s+)?(hours?|days?|weeks?)\b`),
+		lineFilter: regexp.MustCompile(`(?i)respon|acknowledg|reply|triage|get\s+back`),
+	},
+	{
+		informationType: checker.SecurityPolicyInformationTypeEmbargo,
+		re: regexp.MustCompile(`(?i)\bembargo\w*|\bcoordinated\s+(vulnerability\s+)?disclosure\b|` +
+			`\bresponsible\s+(vulnerability\s+)?disclosure\b|\bdisclosure\s+(deadline|timeline|date|period)\b`),
+	},
+	{
+		informationType: checker.SecurityPolicyInformationTypeEncryptionKey,
+		re: regexp.MustCompile(`(?i)\b(open)?pgp\b|\bgpg\b|-----BEGIN PGP PUBLIC KEY BLOCK-----|` +
+			`https?://[^\s)>\]]+\.asc\b|\bkeys\.openpgp\.org\b`),
+	},
+}
+
 type securityPolicyFilesWithURI struct {
 	uri   string
 	files []checker.SecurityPolicyFile
@@ -44,6 +85,16 @@ func SecurityPolicy(c *checker.CheckRequest) (checker.SecurityPolicyData, error)
 	if err != nil {
 		return checker.SecurityPolicyData{}, err
 	}
+	securityTxtFiles, err := securityTxtFiles(c.RepoClient)
+	if err != nil {
+		return checker.SecurityPolicyData{}, err
+	}
+	// The setting is best-effort: it requires admin access on GitHub.
+	var privateReporting *bool
+	if settings, err := c.RepoClient.GetSecuritySettings(); err == nil {
+		privateReporting = settings.PrivateVulnerabilityReporting
+	}
+
 	// If we found files in the repo, return immediately.
 	if len(data.files) > 0 {
 		for idx := range data.files {
@@ -55,7 +106,11 @@ func SecurityPolicy(c *checker.CheckRequest) (checker.SecurityPolicyData, error)
 				return checker.SecurityPolicyData{}, err
 			}
 		}
-		return checker.SecurityPolicyData{PolicyFiles: data.files}, nil
+		return checker.SecurityPolicyData{
+			PolicyFiles:                   data.files,
+			SecurityTxtFiles:              securityTxtFiles,
+			PrivateVulnerabilityReporting: privateReporting,
+		}, nil
 	}
 
 	// Check if present in parent org.
@@ -95,7 +150,138 @@ func SecurityPolicy(c *checker.CheckRequest) (checker.SecurityPolicyData, error)
 			}
 		}
 	}
-	return checker.SecurityPolicyData{PolicyFiles: data.files}, nil
+	return checker.SecurityPolicyData{
+		PolicyFiles:                   data.files,
+		SecurityTxtFiles:              securityTxtFiles,
+		PrivateVulnerabilityReporting: privateReporting,
+	}, nil
+}
+
+// securityTxtFiles returns the security.txt files of the repository.
+func securityTxtFiles(c clients.RepoClient) ([]checker.SecurityTxt, error) {
+	var paths []string
+	if err := fileparser.OnAllFilesDo(c, isSecurityTxtFile, &paths); err != nil {
+		return nil, err
+	}
+	files := make([]checker.SecurityTxt, 0, len(paths))
+	for _, p := range paths {
+		err := fileparser.OnMatchingFileContentDo(c, fileparser.PathMatcher{
+			Pattern:       p,
+			CaseSensitive: true,
+		}, parseSecurityTxtContent, &files)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+var isSecurityTxtFile fileparser.DoWhileTrueOnFilename = func(name string, args ...interface{}) (bool, error) {
+	if len(args) != 1 {
+		return false, fmt.Errorf("isSecurityTxtFile requires exactly one argument: %w", errInvalidArgLength)
+	}
+	paths, ok := args[0].(*[]string)
+	if !ok {
+		return false, fmt.Errorf("invalid arg type: %w", errInvalidArgType)
+	}
+	if isSecurityTxtFilename(name) {
+		*paths = append(*paths, name)
+	}
+	return true, nil
+}
+
+// isSecurityTxtFilename returns whether the file is a security.txt file,
+// which is served from the /.well-known/ directory of a website (RFC 9116),
+// or from its root for compatibility.
+func isSecurityTxtFilename(name string) bool {
+	name = strings.ToLower(name)
+	return name == "security.txt" || name == ".well-known/security.txt" ||
+		strings.HasSuffix(name, "/.well-known/security.txt")
+}
+
+var parseSecurityTxtContent fileparser.DoWhileTrueOnFileContent = func(path string, content []byte,
+	args ...interface{},
+) (bool, error) {
+	if len(args) != 1 {
+		return false, fmt.Errorf(
+			"parseSecurityTxtContent requires exactly one argument: %w", errInvalidArgLength)
+	}
+	files, ok := args[0].(*[]checker.SecurityTxt)
+	if !ok {
+		return false, fmt.Errorf(
+			"parseSecurityTxtContent requires argument of type *[]checker.SecurityTxt: %w", errInvalidArgType)
+	}
+	txt := parseSecurityTxt(content)
+	txt.File = checker.File{
+		Path:     path,
+		Type:     finding.FileTypeText,
+		Offset:   checker.OffsetDefault,
+		FileSize: uint(len(content)),
+	}
+	*files = append(*files, txt)
+	return true, nil
+}
+
+// parseSecurityTxt parses the fields of a security.txt file (RFC 9116).
+// The signature of a signed file is not verified.
+func parseSecurityTxt(content []byte) checker.SecurityTxt {
+	var txt checker.SecurityTxt
+	expires := 0
+	signed := false
+	inHeader := false
+	scanner := bufio.NewScanner(strings.NewReader(string(content)))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "-----BEGIN PGP SIGNED MESSAGE-----" {
+			signed, inHeader = true, true
+			continue
+		}
+		if inHeader {
+			// The armor headers end with an empty line.
+			inHeader = line != ""
+			continue
+		}
+		if signed && line == "-----BEGIN PGP SIGNATURE-----" {
+			break
+		}
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		// Dash-escaped lines of a signed message (RFC 4880).
+		line = strings.TrimPrefix(line, "- ")
+		field, value, found := strings.Cut(line, ":")
+		if !found {
+			txt.Errors = append(txt.Errors, fmt.Sprintf("invalid line: %s", line))
+			continue
+		}
+		value = strings.TrimSpace(value)
+		switch strings.ToLower(strings.TrimSpace(field)) {
+		case "contact":
+			txt.Contacts = append(txt.Contacts, value)
+		case "encryption":
+			txt.Encryption = append(txt.Encryption, value)
+		case "policy":
+			txt.Policies = append(txt.Policies, value)
+		case "expires":
+			expires++
+			t, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				txt.Errors = append(txt.Errors, fmt.Sprintf("invalid Expires field: %s", value))
+				continue
+			}
+			txt.Expires = &t
+		}
+	}
+	if len(txt.Contacts) == 0 {
+		txt.Errors = append(txt.Errors, "missing Contact field")
+	}
+	switch {
+	case expires == 0:
+		txt.Errors = append(txt.Errors, "missing Expires field")
+	case expires > 1:
+		txt.Errors = append(txt.Errors, "multiple Expires fields")
+	}
+	return txt
 }
 
 // Check repository for repository-specific policy.
@@ -244,6 +430,21 @@ func collectPolicyHits(policyContent []byte) []checker.SecurityPolicyInformation
 					},
 				})
 			}
+			for _, rubric := range securityPolicyRubric {
+				if rubric.lineFilter != nil && !rubric.lineFilter.Match(token) {
+					continue
+				}
+				for _, indexes := range rubric.re.FindAllIndex(token, -1) {
+					hits = append(hits, checker.SecurityPolicyInformation{
+						InformationType: rubric.informationType,
+						InformationValue: checker.SecurityPolicyValueType{
+							Match:      string(token[indexes[0]:indexes[1]]), // Snippet of match
+							LineNumber: uint(lineNum),                        // line number in file
+							Offset:     uint(indexes[0]),                     // Offset in the line
+						},
+					})
+				}
+			}
 		}
 		if advance <= len(policyContent) {
 			policyContent = policyContent[advance:]
@@ -18,10 +18,13 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
 
 	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/clients"
 	mockrepo "github.com/ossf/scorecard/v4/clients/mockclients"
 	scut "github.com/ossf/scorecard/v4/utests"
 )
@@ -138,6 +141,7 @@ func TestSecurityPolicy(t *testing.T) {
 			mockRepo := mockrepo.NewMockRepo(ctrl)
 
 			mockRepoClient.EXPECT().ListFiles(gomock.Any()).Return(tt.files, nil).AnyTimes()
+			mockRepoClient.EXPECT().GetSecuritySettings().Return(clients.SecuritySettings{}, clients.ErrUnsupportedFeature).AnyTimes()
 			// the revised Security Policy will immediate go for the
 			// file contents once found. This test will return that
 			// mock file, but this specific unit test is not testing
@@ -180,3 +184,224 @@ func TestSecurityPolicy(t *testing.T) {
 		})
 	}
 }
+
+func Test_collectPolicyHitsRubric(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name     string
+		content  string
+		expected []checker.SecurityPolicyInformationType
+	}{
+		{
+			name:     "supported versions",
+			content:  "## Supported Versions\nOnly the versions currently supported receive fixes.",
+			expected: []checker.SecurityPolicyInformationType{checker.SecurityPolicyInformationTypeSupportedVersions},
+		},
+		{
+			name:     "security address",
+			content:  "Email psirt@example.com.",
+			expected: []checker.SecurityPolicyInformationType{checker.SecurityPolicyInformationTypePrivateReporting},
+		},
+		{
+			name:     "personal address",
+			content:  "Email jane@example.com.",
+			expected: nil,
+		},
+		{
+			name:     "private vulnerability reporting",
+			content:  "Use https://github.com/owner/repo/security/advisories/new to report.",
+			expected: []checker.SecurityPolicyInformationType{checker.SecurityPolicyInformationTypePrivateReporting},
+		},
+		{
+			name:     "reporting form",
+			content:  "Fill https://forms.gle/abc.",
+			expected: []checker.SecurityPolicyInformationType{checker.SecurityPolicyInformationTypePrivateReporting},
+		},
+		{
+			name:     "response time",
+			content:  "We will respond within 48 hours.",
+			expected: []checker.SecurityPolicyInformationType{checker.SecurityPolicyInformationTypeResponseTime},
+		},
+		{
+			name:     "duration without a response",
+			content:  "Releases happen within 2 weeks.",
+			expected: nil,
+		},
+		{
+			name:     "embargo",
+			content:  "We practice coordinated disclosure.",
+			expected: []checker.SecurityPolicyInformationType{checker.SecurityPolicyInformationTypeEmbargo},
+		},
+		{
+			name:     "pgp key",
+			content:  "Our PGP key is at https://example.com/key.asc",
+			expected: []checker.SecurityPolicyInformationType{checker.SecurityPolicyInformationTypeEncryptionKey},
+		},
+	}
+	rubric := map[checker.SecurityPolicyInformationType]bool{
+		checker.SecurityPolicyInformationTypeSupportedVersions: true,
+		checker.SecurityPolicyInformationTypePrivateReporting:  true,
+		checker.SecurityPolicyInformationTypeResponseTime:      true,
+		checker.SecurityPolicyInformationTypeEmbargo:           true,
+		checker.SecurityPolicyInformationTypeEncryptionKey:     true,
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			found := map[checker.SecurityPolicyInformationType]bool{}
+			var got []checker.SecurityPolicyInformationType
+			for _, hit := range collectPolicyHits([]byte(tt.content)) {
+				if rubric[hit.InformationType] && !found[hit.InformationType] {
+					found[hit.InformationType] = true
+					got = append(got, hit.InformationType)
+				}
+			}
+			if diff := cmp.Diff(tt.expected, got); diff != "" {
+				t.Errorf("collectPolicyHits() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_isSecurityTxtFilename(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		filename string
+		expected bool
+	}{
+		{filename: "security.txt", expected: true},
+		{filename: ".well-known/security.txt", expected: true},
+		{filename: "public/.well-known/security.txt", expected: true},
+		{filename: "docs/security.txt", expected: false},
+		{filename: "security.md", expected: false},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.filename, func(t *testing.T) {
+			t.Parallel()
+			if got := isSecurityTxtFilename(tt.filename); got != tt.expected {
+				t.Errorf("isSecurityTxtFilename() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func Test_parseSecurityTxt(t *testing.T) {
+	t.Parallel()
+	expires := time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		content  string
+		expected checker.SecurityTxt
+	}{
+		{
+			name: "valid",
+			content: "# Comment\n" +
+				"Contact: mailto:security@example.com\n" +
+				"Contact: https://example.com/report\n" +
+				"Expires: 2030-01-01T00:00:00Z\n" +
+				"Encryption: https://example.com/pgp-key.txt\n" +
+				"Policy: https://example.com/security-policy.html\n" +
+				"Preferred-Languages: en\n",
+			expected: checker.SecurityTxt{
+				Expires:    &expires,
+				Contacts:   []string{"mailto:security@example.com", "https://example.com/report"},
+				Encryption: []string{"https://example.com/pgp-key.txt"},
+				Policies:   []string{"https://example.com/security-policy.html"},
+			},
+		},
+		{
+			name: "signed",
+			content: "-----BEGIN PGP SIGNED MESSAGE-----\n" +
+				"Hash: SHA256\n" +
+				"\n" +
+				"Contact: mailto:security@example.com\n" +
+				"Expires: 2030-01-01T00:00:00Z\n" +
+				"-----BEGIN PGP SIGNATURE-----\n" +
+				"iQIzBAEBCAAdFiEE\n" +
+				"-----END PGP SIGNATURE-----\n",
+			expected: checker.SecurityTxt{
+				Expires:  &expires,
+				Contacts: []string{"mailto:security@example.com"},
+			},
+		},
+		{
+			name:    "missing fields",
+			content: "Policy: https://example.com/security-policy.html\n",
+			expected: checker.SecurityTxt{
+				Policies: []string{"https://example.com/security-policy.html"},
+				Errors:   []string{"missing Contact field", "missing Expires field"},
+			},
+		},
+		{
+			name: "invalid expires",
+			content: "Contact: mailto:security@example.com\n" +
+				"Expires: Jan 1 2030\n",
+			expected: checker.SecurityTxt{
+				Contacts: []string{"mailto:security@example.com"},
+				Errors:   []string{"invalid Expires field: Jan 1 2030"},
+			},
+		},
+		{
+			name: "multiple expires",
+			content: "Contact: mailto:security@example.com\n" +
+				"Expires: 2030-01-01T00:00:00Z\n" +
+				"Expires: 2030-01-01T00:00:00Z\n",
+			expected: checker.SecurityTxt{
+				Expires:  &expires,
+				Contacts: []string{"mailto:security@example.com"},
+				Errors:   []string{"multiple Expires fields"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := parseSecurityTxt([]byte(tt.content))
+			if diff := cmp.Diff(tt.expected, got); diff != "" {
+				t.Errorf("parseSecurityTxt() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSecurityPolicySecurityTxt(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+	enabled := true
+	mockRepoClient.EXPECT().ListFiles(gomock.Any()).DoAndReturn(func(predicate func(string) (bool, error)) ([]string, error) {
+		var files []string
+		for _, fn := range []string{"SECURITY.md", "site/.well-known/security.txt"} {
+			if ok, err := predicate(fn); err == nil && ok {
+				files = append(files, fn)
+			}
+		}
+		return files, nil
+	}).AnyTimes()
+	mockRepoClient.EXPECT().GetSecuritySettings().Return(clients.SecuritySettings{PrivateVulnerabilityReporting: &enabled}, nil)
+	mockRepoClient.EXPECT().GetFileContent(gomock.Any()).DoAndReturn(func(fn string) ([]byte, error) {
+		if fn == "site/.well-known/security.txt" {
+			return []byte("Contact: mailto:security@example.com\nExpires: 2030-01-01T00:00:00Z\n"), nil
+		}
+		return []byte("Report vulnerabilities to security@example.com."), nil
+	}).AnyTimes()
+
+	c := checker.CheckRequest{
+		RepoClient: mockRepoClient,
+		Dlogger:    &scut.TestDetailLogger{},
+	}
+	res, err := SecurityPolicy(&c)
+	if err != nil {
+		t.Fatalf("SecurityPolicy() error = %v", err)
+	}
+	if res.PrivateVulnerabilityReporting == nil || !*res.PrivateVulnerabilityReporting {
+		t.Errorf("expected private vulnerability reporting to be enabled")
+	}
+	if len(res.SecurityTxtFiles) != 1 || res.SecurityTxtFiles[0].File.Path != "site/.well-known/security.txt" ||
+		len(res.SecurityTxtFiles[0].Errors) != 0 {
+		t.Errorf("unexpected security.txt files: %+v", res.SecurityTxtFiles)
+	}
+}
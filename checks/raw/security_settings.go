@@ -0,0 +1,49 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/clients"
+)
+
+// SecuritySettings retrieves the raw data for the Security-Settings check.
+// Settings which are not supported by the repository host are left unset.
+func SecuritySettings(c *checker.CheckRequest) (checker.SecuritySettingsData, error) {
+	var data checker.SecuritySettingsData
+
+	settings, err := c.RepoClient.GetSecuritySettings()
+	switch {
+	case errors.Is(err, clients.ErrUnsupportedFeature):
+	case err != nil:
+		return data, fmt.Errorf("%w", err)
+	default:
+		data.Settings = settings
+	}
+
+	permissions, err := c.RepoClient.GetWorkflowPermissions()
+	switch {
+	case errors.Is(err, clients.ErrUnsupportedFeature):
+	case err != nil:
+		return data, fmt.Errorf("%w", err)
+	default:
+		data.WorkflowPermissions = permissions
+	}
+
+	return data, nil
+}
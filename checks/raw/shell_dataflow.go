@@ -0,0 +1,809 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"mvdan.cc/sh/v3/syntax"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/clients"
+	"github.com/ossf/scorecard/v4/finding"
+)
+
+// maxShellCallDepth limits the nesting of function calls and sourced scripts
+// followed by the data-flow analysis.
+const maxShellCallDepth = 5
+
+var (
+	checksumUtils    = []string{"sha256sum", "sha384sum", "sha512sum", "shasum", "b2sum"}
+	printUtils       = []string{"echo", "printf", "cat"}
+	passThroughUtils = []string{"cat", "tee", "sed", "tr", "head", "tail"}
+	copyUtils        = []string{"cp", "mv", "install"}
+	sourceBuiltins   = []string{"source", "."}
+)
+
+type shellFunc struct {
+	decl      *syntax.FuncDecl
+	pathfn    string
+	startLine uint
+	endLine   uint
+}
+
+// shellDataFlow tracks the values flowing through the variables, functions and
+// sourced scripts of a shell script, so that a download and the execution of its
+// content can be matched even when they are not part of the same command.
+type shellDataFlow struct {
+	repoClient clients.RepoClient
+	// vars holds the value of the variables. Values that cannot be
+	// resolved statically are replaced by a placeholder.
+	vars map[string]string
+	// taintedVars are the variables holding downloaded content,
+	// e.g., `SCRIPT=$(curl -fsSL https://example.com/install.sh)`.
+	taintedVars map[string]bool
+	funcs       map[string]*shellFunc
+	// downloadFuncs are the functions printing downloaded content,
+	// e.g., `fetch() { curl -fsSL "$1"; }`.
+	downloadFuncs map[string]bool
+	// active holds the functions and scripts being analyzed.
+	active map[string]bool
+	// scriptPath is the value of `$0`, sourcePath the one of `$BASH_SOURCE`.
+	scriptPath string
+	sourcePath string
+	depth      int
+}
+
+func newShellDataFlow(pathfn string, repoClient clients.RepoClient) *shellDataFlow {
+	return &shellDataFlow{
+		repoClient:    repoClient,
+		vars:          make(map[string]string),
+		taintedVars:   make(map[string]bool),
+		funcs:         make(map[string]*shellFunc),
+		downloadFuncs: make(map[string]bool),
+		active:        make(map[string]bool),
+		scriptPath:    pathfn,
+		sourcePath:    pathfn,
+	}
+}
+
+// resolveWord returns the value of the word, with the variables
+// replaced by their value.
+func (df *shellDataFlow) resolveWord(w *syntax.Word) string {
+	if w == nil {
+		return ""
+	}
+	return df.resolveParts(w.Parts)
+}
+
+func (df *shellDataFlow) resolveParts(parts []syntax.WordPart) string {
+	var sb strings.Builder
+	for _, part := range parts {
+		switch v := part.(type) {
+		case *syntax.Lit:
+			sb.WriteString(v.Value)
+		case *syntax.SglQuoted:
+			sb.WriteString(v.Value)
+		case *syntax.DblQuoted:
+			sb.WriteString(df.resolveParts(v.Parts))
+		case *syntax.ParamExp:
+			sb.WriteString(df.resolveParamExp(v))
+		case *syntax.CmdSubst:
+			sb.WriteString(df.resolveCmdSubst(v))
+		default:
+			sb.WriteString(placeholder(part))
+		}
+	}
+	return sb.String()
+}
+
+func (df *shellDataFlow) resolveParamExp(pe *syntax.ParamExp) string {
+	if pe.Param == nil || pe.Excl || pe.Length || pe.Width || pe.Slice != nil || pe.Repl != nil {
+		return placeholder(pe)
+	}
+	name := pe.Param.Value
+	value, ok := df.vars[name]
+	switch {
+	case name == "0":
+		value, ok = df.scriptPath, true
+	case name == "BASH_SOURCE":
+		value, ok = df.sourcePath, true
+	case pe.Index != nil:
+		return placeholder(pe)
+	case !ok:
+		value = "$" + name
+	}
+
+	if pe.Exp == nil {
+		return value
+	}
+	switch pe.Exp.Op {
+	case syntax.DefaultUnset, syntax.DefaultUnsetOrNull:
+		if ok {
+			return value
+		}
+		return df.resolveWord(pe.Exp.Word)
+	case syntax.RemSmallSuffix:
+		if df.resolveWord(pe.Exp.Word) == "/*" {
+			return path.Dir(value)
+		}
+	}
+	return placeholder(pe)
+}
+
+// resolveCmdSubst resolves the command substitutions used to compute paths,
+// e.g., `$(dirname "$0")` or `$(cd "$(dirname "$0")" && pwd)`.
+func (df *shellDataFlow) resolveCmdSubst(cs *syntax.CmdSubst) string {
+	if len(cs.Stmts) == 1 {
+		if bc, ok := cs.Stmts[0].Cmd.(*syntax.BinaryCmd); ok && bc.Op == syntax.AndStmt {
+			if cd := df.resolveCommand(bc.X.Cmd); len(cd) == 2 && cd[0] == "cd" && isPwd(df.resolveCommand(bc.Y.Cmd)) {
+				return cd[1]
+			}
+		}
+		cmd := df.resolveCommand(cs.Stmts[0].Cmd)
+		switch {
+		case len(cmd) == 2 && cmd[0] == "dirname":
+			return path.Dir(cmd[1])
+		case len(cmd) == 2 && cmd[0] == "realpath",
+			len(cmd) == 3 && cmd[0] == "readlink" && cmd[1] == "-f":
+			return cmd[len(cmd)-1]
+		}
+	}
+	if len(cs.Stmts) == 2 {
+		if cd := df.resolveCommand(cs.Stmts[0].Cmd); len(cd) == 2 && cd[0] == "cd" &&
+			isPwd(df.resolveCommand(cs.Stmts[1].Cmd)) {
+			return cd[1]
+		}
+	}
+	return placeholder(cs)
+}
+
+func isPwd(cmd []string) bool {
+	return len(cmd) > 0 && cmd[0] == "pwd"
+}
+
+// placeholder returns a value unique to the node, for values
+// that cannot be resolved statically.
+func placeholder(node syntax.Node) string {
+	return fmt.Sprintf("${%d:%d}", node.Pos().Line(), node.Pos().Col())
+}
+
+// resolveCommand returns the arguments of a command, with the variables
+// replaced by their value. Like extractCommand, `sudo` is ignored.
+func (df *shellDataFlow) resolveCommand(cmd syntax.Command) []string {
+	ce, ok := cmd.(*syntax.CallExpr)
+	if !ok {
+		return nil
+	}
+	var ret []string
+	for _, w := range ce.Args {
+		if lit := w.Lit(); strings.EqualFold(lit, "sudo") {
+			continue
+		}
+		ret = append(ret, df.resolveWord(w))
+	}
+	return ret
+}
+
+// isTaintedWord returns whether the word expands to downloaded content.
+func (df *shellDataFlow) isTaintedWord(w *syntax.Word, files map[string]bool) bool {
+	return w != nil && df.isTaintedParts(w.Parts, files)
+}
+
+func (df *shellDataFlow) isTaintedParts(parts []syntax.WordPart, files map[string]bool) bool {
+	for _, part := range parts {
+		switch v := part.(type) {
+		case *syntax.DblQuoted:
+			if df.isTaintedParts(v.Parts, files) {
+				return true
+			}
+		case *syntax.ParamExp:
+			if v.Param != nil && !v.Length && df.taintedVars[v.Param.Value] {
+				return true
+			}
+		case *syntax.CmdSubst:
+			for _, stmt := range v.Stmts {
+				if df.printsDownload(stmt, files) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// printsDownload returns whether the statement prints downloaded content on stdout.
+func (df *shellDataFlow) printsDownload(stmt *syntax.Stmt, files map[string]bool) bool {
+	if bc, ok := stmt.Cmd.(*syntax.BinaryCmd); ok {
+		switch bc.Op {
+		case syntax.Pipe, syntax.PipeAll:
+			if df.printsDownload(bc.Y, files) {
+				return true
+			}
+			y := df.resolveCommand(bc.Y.Cmd)
+			return len(y) > 0 && isOneOf(y[0], passThroughUtils) && df.printsDownload(bc.X, files)
+		case syntax.AndStmt, syntax.OrStmt:
+			return df.printsDownload(bc.X, files) || df.printsDownload(bc.Y, files)
+		}
+		return false
+	}
+	ce, ok := stmt.Cmd.(*syntax.CallExpr)
+	if !ok || hasOutputRedirect(stmt.Redirs) {
+		return false
+	}
+	cmd := df.resolveCommand(ce)
+	if len(cmd) == 0 {
+		return false
+	}
+	if isDownloadUtility(cmd) {
+		return isStdoutDownload(cmd)
+	}
+	if df.downloadFuncs[cmd[0]] {
+		return true
+	}
+	if !isOneOf(cmd[0], printUtils) {
+		return false
+	}
+	if isBinaryName("cat", cmd[0]) {
+		for _, arg := range cmd[1:] {
+			if isTaintedFile(arg, files) {
+				return true
+			}
+		}
+	}
+	for _, w := range ce.Args[1:] {
+		if df.isTaintedWord(w, files) {
+			return true
+		}
+	}
+	return false
+}
+
+// isStdoutDownload returns whether the download utility prints the file on stdout.
+func isStdoutDownload(cmd []string) bool {
+	switch {
+	case isBinaryName("curl", cmd[0]):
+		for i, arg := range cmd[1:] {
+			switch {
+			case arg == "-o" || arg == "--output":
+				return i+2 < len(cmd) && cmd[i+2] == "-"
+			case arg == "-O" || arg == "--remote-name" || arg == "--remote-name-all" ||
+				strings.HasPrefix(arg, "--output="):
+				return false
+			case strings.HasPrefix(arg, "-") && !strings.HasPrefix(arg, "--") &&
+				(strings.HasSuffix(arg, "o") || strings.Contains(arg, "O")):
+				return false
+			}
+		}
+		return true
+	case isBinaryName("wget", cmd[0]):
+		for i, arg := range cmd[1:] {
+			if arg == "--output-document=-" || (strings.HasPrefix(arg, "-") && strings.HasSuffix(arg, "O-")) {
+				return true
+			}
+			if (arg == "-O" || arg == "--output-document" ||
+				(strings.HasPrefix(arg, "-") && !strings.HasPrefix(arg, "--") && strings.HasSuffix(arg, "O"))) &&
+				i+2 < len(cmd) && cmd[i+2] == "-" {
+				return true
+			}
+		}
+		return false
+	case isBinaryName("gsutil", cmd[0]):
+		return len(cmd) > 1 && cmd[1] == "cat"
+	}
+	return false
+}
+
+// getCurlOutputFile returns the file written by `curl -o FILE` or `curl -O`.
+func getCurlOutputFile(cmd []string) (string, bool) {
+	if !isBinaryName("curl", cmd[0]) {
+		return "", false
+	}
+	remoteName := false
+	for i := 1; i < len(cmd); i++ {
+		arg := cmd[i]
+		isShortFlag := strings.HasPrefix(arg, "-") && !strings.HasPrefix(arg, "--")
+		switch {
+		case strings.HasPrefix(arg, "--output="):
+			return strings.TrimPrefix(arg, "--output="), true
+		case arg == "--output" || (isShortFlag && strings.HasSuffix(arg, "o")):
+			if i+1 < len(cmd) && cmd[i+1] != "-" {
+				return cmd[i+1], true
+			}
+			return "", false
+		case arg == "--remote-name" || (isShortFlag && strings.Contains(arg, "O")):
+			remoteName = true
+		}
+	}
+	if !remoteName {
+		return "", false
+	}
+	for _, arg := range cmd[1:] {
+		if strings.HasPrefix(arg, "http") {
+			return path.Base(arg), true
+		}
+	}
+	return "", false
+}
+
+func hasOutputRedirect(redirs []*syntax.Redirect) bool {
+	for _, r := range redirs {
+		if (r.N == nil || r.N.Value == "1") &&
+			(r.Op == syntax.RdrOut || r.Op == syntax.AppOut || r.Op == syntax.ClbOut || r.Op == syntax.RdrAll) {
+			return true
+		}
+	}
+	return false
+}
+
+func isOneOf(name string, names []string) bool {
+	for _, n := range names {
+		if isBinaryName(n, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// isTaintedFile returns whether fn is a downloaded file that was not verified.
+func isTaintedFile(fn string, files map[string]bool) bool {
+	for f, tainted := range files {
+		if tainted && strings.EqualFold(filepath.Clean(f), filepath.Clean(fn)) {
+			return true
+		}
+	}
+	return false
+}
+
+// recordAssign records the value of a variable assignment.
+func (df *shellDataFlow) recordAssign(as *syntax.Assign, files map[string]bool) {
+	if as.Name == nil || as.Naked || as.Array != nil || as.Index != nil {
+		return
+	}
+	name := as.Name.Value
+	value := df.resolveWord(as.Value)
+	tainted := df.isTaintedWord(as.Value, files)
+	if as.Append {
+		value = df.vars[name] + value
+		tainted = tainted || df.taintedVars[name]
+	}
+	df.vars[name] = value
+	df.taintedVars[name] = tainted
+}
+
+// recordFunc records a function definition.
+func (df *shellDataFlow) recordFunc(fd *syntax.FuncDecl, pathfn string, startLine, endLine uint,
+	files map[string]bool,
+) {
+	if fd.Name == nil || fd.Body == nil {
+		return
+	}
+	df.funcs[fd.Name.Value] = &shellFunc{
+		decl:      fd,
+		pathfn:    pathfn,
+		startLine: startLine,
+		endLine:   endLine,
+	}
+	df.downloadFuncs[fd.Name.Value] = df.bodyPrintsDownload(fd.Body, files)
+}
+
+func (df *shellDataFlow) bodyPrintsDownload(body *syntax.Stmt, files map[string]bool) bool {
+	found := false
+	syntax.Walk(body, func(node syntax.Node) bool {
+		if found {
+			return false
+		}
+		switch v := node.(type) {
+		case *syntax.CmdSubst, *syntax.ProcSubst, *syntax.FuncDecl:
+			return false
+		case *syntax.Stmt:
+			if _, isBlock := v.Cmd.(*syntax.Block); isBlock {
+				return true
+			}
+			if bc, ok := v.Cmd.(*syntax.BinaryCmd); ok && (bc.Op == syntax.Pipe || bc.Op == syntax.PipeAll) {
+				found = df.printsDownload(v, files)
+				return false
+			}
+			if _, ok := v.Cmd.(*syntax.CallExpr); ok {
+				found = df.printsDownload(v, files)
+				return false
+			}
+		}
+		return true
+	})
+	return found
+}
+
+// recordFileFlow records the files downloaded, copied or verified by the statement.
+func (df *shellDataFlow) recordFileFlow(stmt *syntax.Stmt, files map[string]bool) {
+	cmd := df.resolveCommand(stmt.Cmd)
+	if len(cmd) == 0 {
+		return
+	}
+
+	switch {
+	case isDownloadUtility(cmd):
+		for _, r := range stmt.Redirs {
+			if r.Op == syntax.RdrOut || r.Op == syntax.ClbOut {
+				files[df.resolveWord(r.Word)] = true
+				return
+			}
+		}
+		if fn, ok := getCurlOutputFile(cmd); ok {
+			files[fn] = true
+			return
+		}
+		//nolint:errcheck // The URL parsing errors are reported by recordFetchFileFromNode.
+		if fn, ok, _ := getOutputFile(cmd); ok && fn != "-" {
+			files[fn] = true
+		}
+
+	case isOneOf(cmd[0], copyUtils):
+		args := nonFlagArgs(cmd[1:])
+		if len(args) < 2 {
+			return
+		}
+		dst := args[len(args)-1]
+		for _, src := range args[:len(args)-1] {
+			if !isTaintedFile(src, files) {
+				continue
+			}
+			if strings.HasSuffix(dst, "/") || len(args) > 2 {
+				files[path.Join(dst, path.Base(src))] = true
+			} else {
+				files[dst] = true
+			}
+		}
+
+	case isChecksumVerification(cmd):
+		var text []string
+		for _, r := range stmt.Redirs {
+			if r.Op == syntax.WordHdoc {
+				text = append(text, df.resolveWord(r.Word))
+			}
+		}
+		args := nonFlagArgs(cmd[1:])
+		if len(text) == 0 && len(args) > 0 && args[0] != "-" {
+			verifyChecksumFile(args[0], files)
+			return
+		}
+		verifyChecksums(strings.Join(text, " "), files)
+	}
+}
+
+// recordPipedChecksums records the files verified by a checksum read from stdin,
+// e.g., `echo "${SHA256}  install.sh" | sha256sum -c`.
+func (df *shellDataFlow) recordPipedChecksums(bc *syntax.BinaryCmd, files map[string]bool) {
+	if bc.Op != syntax.Pipe {
+		return
+	}
+	cmd := df.resolveCommand(bc.Y.Cmd)
+	if !isChecksumVerification(cmd) {
+		return
+	}
+	x := df.resolveCommand(bc.X.Cmd)
+	if len(x) == 0 || !isOneOf(x[0], printUtils) {
+		return
+	}
+	verifyChecksums(strings.Join(x[1:], " "), files)
+}
+
+func isChecksumVerification(cmd []string) bool {
+	if len(cmd) == 0 || !isOneOf(cmd[0], checksumUtils) {
+		return false
+	}
+	for _, arg := range cmd[1:] {
+		if arg == "--check" ||
+			(strings.HasPrefix(arg, "-") && !strings.HasPrefix(arg, "--") && strings.Contains(arg, "c")) {
+			return true
+		}
+	}
+	return false
+}
+
+// verifyChecksums marks the files listed in the checksums as verified.
+func verifyChecksums(checksums string, files map[string]bool) {
+	for _, field := range strings.Fields(checksums) {
+		field = strings.TrimPrefix(field, "*")
+		for fn, tainted := range files {
+			if tainted && (filepath.Clean(fn) == filepath.Clean(field) || path.Base(fn) == path.Base(field)) {
+				files[fn] = false
+			}
+		}
+	}
+}
+
+// verifyChecksumFile marks the files verified by a checksum file as verified,
+// e.g., `sha256sum -c install.sh.sha256` or `sha256sum -c SHA256SUMS`.
+// A checksum file not named after a file, e.g., `SHA256SUMS`, is only linked to the
+// downloaded file when there is exactly one: other files are left tainted.
+func verifyChecksumFile(checksumFile string, files map[string]bool) {
+	var matching, others []string
+	for fn, tainted := range files {
+		switch {
+		case !tainted || filepath.Clean(fn) == filepath.Clean(checksumFile):
+		case strings.HasPrefix(path.Base(checksumFile), path.Base(fn)+"."):
+			matching = append(matching, fn)
+		default:
+			others = append(others, fn)
+		}
+	}
+	if len(matching) == 0 && len(others) == 1 {
+		matching = others
+	}
+	for _, fn := range matching {
+		files[fn] = false
+	}
+}
+
+func nonFlagArgs(args []string) []string {
+	var ret []string
+	for _, arg := range args {
+		if !strings.HasPrefix(arg, "-") || arg == "-" {
+			ret = append(ret, arg)
+		}
+	}
+	return ret
+}
+
+// collectDataFlowExecute detects the execution of downloaded content
+// which flows through variables, functions, `eval` or stdin.
+func (df *shellDataFlow) collectDataFlowExecute(startLine, endLine uint, node syntax.Node, cmdStr, pathfn string,
+	files map[string]bool, r *checker.PinningDependenciesData,
+) {
+	switch v := node.(type) {
+	case *syntax.BinaryCmd:
+		// `fetch https://example.com/install.sh | bash`, `echo "$SCRIPT" | bash`.
+		if v.Op != syntax.Pipe && v.Op != syntax.PipeAll {
+			return
+		}
+		if y := df.resolveCommand(v.Y.Cmd); !isInterpreter(y) {
+			return
+		}
+		if df.printsDownload(v.X, files) {
+			recordDownloadThenRun(startLine, endLine, node, cmdStr, pathfn, r)
+		}
+
+	case *syntax.Stmt:
+		// `bash <<< "$SCRIPT"`, `bash < install.sh`.
+		cmd := df.resolveCommand(v.Cmd)
+		if !isInterpreter(cmd) {
+			return
+		}
+		for _, redir := range v.Redirs {
+			if (redir.Op == syntax.WordHdoc && df.isTaintedWord(redir.Word, files)) ||
+				(redir.Op == syntax.RdrIn && isTaintedFile(df.resolveWord(redir.Word), files)) {
+				recordDownloadThenRun(startLine, endLine, node, cmdStr, pathfn, r)
+				return
+			}
+		}
+
+	case *syntax.CallExpr:
+		cmd := df.resolveCommand(v)
+		if len(cmd) == 0 {
+			return
+		}
+		switch {
+		case cmd[0] == "eval", isInterpreterWithInlineCode(cmd):
+			// `eval "$(curl -fsSL https://example.com/install.sh)"`, `bash -c "$SCRIPT"`.
+			for _, w := range v.Args[1:] {
+				if df.isTaintedWord(w, files) {
+					recordDownloadThenRun(startLine, endLine, node, cmdStr, pathfn, r)
+					return
+				}
+			}
+		case isOneOf(cmd[0], sourceBuiltins) && len(v.Args) > 1:
+			// `source <(curl -fsSL https://example.com/env.sh)`.
+			for _, part := range v.Args[1].Parts {
+				p, ok := part.(*syntax.ProcSubst)
+				if !ok || p.Op != syntax.CmdIn {
+					continue
+				}
+				for _, stmt := range p.Stmts {
+					if df.printsDownload(stmt, files) {
+						recordDownloadThenRun(startLine, endLine, node, cmdStr, pathfn, r)
+						return
+					}
+				}
+			}
+		}
+	}
+}
+
+// isInterpreterWithInlineCode returns whether the interpreter runs code passed
+// as argument, e.g., `bash -c CMD`, `python -c CMD` or `node -e CMD`.
+func isInterpreterWithInlineCode(cmd []string) bool {
+	if !isInterpreter(cmd) {
+		return false
+	}
+	flags := "c"
+	if !isOneOf(cmd[0], shellInterpreters) {
+		flags = "ce"
+	}
+	for _, arg := range cmd[1:] {
+		if strings.HasPrefix(arg, "-") && !strings.HasPrefix(arg, "--") && strings.ContainsAny(arg[1:], flags) {
+			return true
+		}
+	}
+	return false
+}
+
+// followCall analyzes the body of the functions and the repository scripts sourced by a command.
+func (df *shellDataFlow) followCall(startLine, endLine uint, ce *syntax.CallExpr, cmdStr, pathfn string,
+	files map[string]bool, r *checker.PinningDependenciesData,
+) error {
+	cmd := df.resolveCommand(ce)
+	if len(cmd) == 0 || df.depth >= maxShellCallDepth {
+		return nil
+	}
+
+	if fn, ok := df.funcs[cmd[0]]; ok {
+		key := "func:" + cmd[0]
+		if df.active[key] {
+			return nil
+		}
+		df.active[key] = true
+		df.depth++
+		restore := df.bindArgs(cmd[1:])
+		var found checker.PinningDependenciesData
+		err := walkShellNode(fn.pathfn, fn.startLine, fn.endLine, fn.decl.Body, files, df, &found)
+		restore()
+		df.depth--
+		delete(df.active, key)
+		if err != nil {
+			return err
+		}
+		// Findings in a function defined in another file are reported at the call.
+		sameFile := fn.pathfn == pathfn && fn.startLine == startLine
+		for i := range found.Dependencies {
+			dep := found.Dependencies[i]
+			if !sameFile {
+				start, end := getLines(startLine, endLine, ce)
+				dep.Location = &checker.File{
+					Path:      pathfn,
+					Type:      finding.FileTypeSource,
+					Offset:    start,
+					EndOffset: end,
+					Snippet:   cmdStr,
+				}
+			}
+			recordDependency(r, dep)
+		}
+		return nil
+	}
+
+	if !isOneOf(cmd[0], sourceBuiltins) || len(cmd) < 2 || df.repoClient == nil {
+		return nil
+	}
+	df.followSource(cmd[1], files)
+	return nil
+}
+
+// followSource analyzes a repository script sourced by the script, to record
+// the functions and variables it defines. Its own findings are reported
+// when the script itself is analyzed.
+func (df *shellDataFlow) followSource(target string, files map[string]bool) {
+	if strings.Contains(target, "$") || path.IsAbs(target) {
+		return
+	}
+	for _, fn := range []string{
+		path.Join(path.Dir(df.sourcePath), target),
+		path.Clean(target),
+	} {
+		if strings.HasPrefix(fn, "../") || df.active["source:"+fn] {
+			continue
+		}
+		content, err := df.repoClient.GetFileContent(fn)
+		if err != nil {
+			continue
+		}
+		key := "source:" + fn
+		df.active[key] = true
+		df.depth++
+		sourcePath := df.sourcePath
+		df.sourcePath = fn
+		var found checker.PinningDependenciesData
+		// Scripts our parser does not understand are ignored.
+		//nolint:errcheck
+		validateShellFileAndRecordWithFlow(fn, 0, 0, content, files, df, &found)
+		df.sourcePath = sourcePath
+		df.depth--
+		delete(df.active, key)
+		return
+	}
+}
+
+// bindArgs binds the positional parameters of a function call,
+// and returns a function restoring their previous value.
+func (df *shellDataFlow) bindArgs(args []string) func() {
+	names := []string{"@", "*", "#"}
+	for i := 1; i <= 9; i++ {
+		names = append(names, strconv.Itoa(i))
+	}
+	saved := make(map[string]string)
+	savedTaint := make(map[string]bool)
+	for _, name := range names {
+		if v, ok := df.vars[name]; ok {
+			saved[name] = v
+		}
+		savedTaint[name] = df.taintedVars[name]
+		delete(df.vars, name)
+		df.taintedVars[name] = false
+	}
+	for i, arg := range args {
+		if i >= 9 {
+			break
+		}
+		df.vars[strconv.Itoa(i+1)] = arg
+	}
+	df.vars["@"] = strings.Join(args, " ")
+	df.vars["*"] = df.vars["@"]
+	df.vars["#"] = strconv.Itoa(len(args))
+
+	return func() {
+		for _, name := range names {
+			delete(df.vars, name)
+			if v, ok := saved[name]; ok {
+				df.vars[name] = v
+			}
+			df.taintedVars[name] = savedTaint[name]
+		}
+	}
+}
+
+// getLines returns the first and last lines of the node.
+func getLines(startLine, endLine uint, node syntax.Node) (uint, uint) {
+	start, end := getLine(startLine, endLine, node)
+	if span := node.End().Line() - node.Pos().Line(); node.End().Line() > node.Pos().Line() && end < start+span {
+		end = start + span
+	}
+	return start, end
+}
+
+func recordDownloadThenRun(startLine, endLine uint, node syntax.Node, cmdStr, pathfn string,
+	r *checker.PinningDependenciesData,
+) {
+	start, end := getLines(startLine, endLine, node)
+	recordDependency(r, checker.Dependency{
+		Location: &checker.File{
+			Path:      pathfn,
+			Type:      finding.FileTypeSource,
+			Offset:    start,
+			EndOffset: end,
+			Snippet:   cmdStr,
+		},
+		Pinned: asBoolPointer(false),
+		Type:   checker.DependencyUseTypeDownloadThenRun,
+	})
+}
+
+// recordDependency records the dependency unless it was already found at the same location.
+func recordDependency(r *checker.PinningDependenciesData, dep checker.Dependency) {
+	for i := range r.Dependencies {
+		d := &r.Dependencies[i]
+		if d.Type != dep.Type || d.Location == nil || dep.Location == nil {
+			continue
+		}
+		if d.Location.Path == dep.Location.Path && d.Location.Offset == dep.Location.Offset &&
+			d.Location.Snippet == dep.Location.Snippet {
+			return
+		}
+	}
+	r.Dependencies = append(r.Dependencies, dep)
+}
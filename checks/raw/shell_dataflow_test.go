@@ -0,0 +1,166 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v4/checker"
+)
+
+type shellDownloadResult struct {
+	Snippet   string
+	StartLine uint
+	EndLine   uint
+}
+
+func TestShellScriptDataFlow(t *testing.T) {
+	t.Parallel()
+
+	filename := "testdata/shell-download-dataflow.sh"
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("cannot read file: %v", err)
+	}
+
+	var r checker.PinningDependenciesData
+	if _, err := validateShellScriptIsFreeOfInsecureDownloads(filename, content, &r,
+		&stubRepoClient{dir: "."}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got []shellDownloadResult
+	for _, dep := range r.Dependencies {
+		if dep.Type != checker.DependencyUseTypeDownloadThenRun {
+			t.Errorf("unexpected dependency type: %v", dep.Type)
+			continue
+		}
+		got = append(got, shellDownloadResult{
+			Snippet:   dep.Location.Snippet,
+			StartLine: dep.Location.Offset,
+			EndLine:   dep.Location.EndOffset,
+		})
+	}
+
+	want := []shellDownloadResult{
+		{Snippet: `"$INSTALLER"`, StartLine: 25, EndLine: 25},
+		{Snippet: `eval "$SCRIPT"`, StartLine: 29, EndLine: 29},
+		{Snippet: `bash -c "$SCRIPT"`, StartLine: 30, EndLine: 30},
+		{Snippet: `echo "$SCRIPT" | sh`, StartLine: 31, EndLine: 31},
+		{Snippet: `fetch "$INSTALLER_URL" | bash`, StartLine: 37, EndLine: 37},
+		{Snippet: `bash /tmp/setup.sh`, StartLine: 43, EndLine: 43},
+		{Snippet: "fetch_script \"$INSTALLER_URL\" |\n\tbash", StartLine: 46, EndLine: 47},
+		{Snippet: `curl -fsSL https://example.com/install.sh | bash`, StartLine: 50, EndLine: 50},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestShellScriptDataFlowSourceNotFound(t *testing.T) {
+	t.Parallel()
+
+	filename := "testdata/shell-download-dataflow.sh"
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("cannot read file: %v", err)
+	}
+
+	// Without the sourced script, `fetch_script` is not known to download content.
+	var r checker.PinningDependenciesData
+	if _, err := validateShellScriptIsFreeOfInsecureDownloads(filename, content, &r,
+		&stubRepoClient{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, dep := range r.Dependencies {
+		if dep.Location.Offset == 46 {
+			t.Errorf("unexpected dependency: %v", dep.Location.Snippet)
+		}
+	}
+	if len(r.Dependencies) != 7 {
+		t.Errorf("expected 7 dependencies. Got %d", len(r.Dependencies))
+	}
+}
+
+func TestIsStdoutDownload(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		cmd  []string
+		want bool
+	}{
+		{name: "curl", cmd: []string{"curl", "-fsSL", "https://example.com/install.sh"}, want: true},
+		{name: "curl -o", cmd: []string{"curl", "-fsSLo", "install.sh", "https://example.com/install.sh"}},
+		{name: "curl -o -", cmd: []string{"curl", "-o", "-", "https://example.com/install.sh"}, want: true},
+		{name: "curl -O", cmd: []string{"curl", "-O", "https://example.com/install.sh"}},
+		{name: "wget", cmd: []string{"wget", "https://example.com/install.sh"}},
+		{name: "wget -qO-", cmd: []string{"wget", "-qO-", "https://example.com/install.sh"}, want: true},
+		{name: "wget -O -", cmd: []string{"wget", "-O", "-", "https://example.com/install.sh"}, want: true},
+		{name: "gsutil cat", cmd: []string{"gsutil", "cat", "gs://bucket/install.sh"}, want: true},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := isStdoutDownload(tt.cmd); got != tt.want {
+				t.Errorf("isStdoutDownload() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVerifyChecksumFile(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		files        map[string]bool
+		want         map[string]bool
+		name         string
+		checksumFile string
+	}{
+		{
+			name:         "checksum file named after the download",
+			checksumFile: "install.sh.sha256",
+			files:        map[string]bool{"install.sh": true, "install.sh.sha256": true, "other.sh": true},
+			want:         map[string]bool{"install.sh": false, "install.sh.sha256": true, "other.sh": true},
+		},
+		{
+			name:         "one download",
+			checksumFile: "SHA256SUMS",
+			files:        map[string]bool{"tool.tar.gz": true, "SHA256SUMS": true},
+			want:         map[string]bool{"tool.tar.gz": false, "SHA256SUMS": true},
+		},
+		{
+			name:         "several downloads",
+			checksumFile: "SHA256SUMS",
+			files:        map[string]bool{"tool.tar.gz": true, "install.sh": true, "SHA256SUMS": true},
+			want:         map[string]bool{"tool.tar.gz": true, "install.sh": true, "SHA256SUMS": true},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			verifyChecksumFile(tt.checksumFile, tt.files)
+			if diff := cmp.Diff(tt.want, tt.files); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -244,6 +244,11 @@ func isInterpreterWithFile(cmd []string, fn string) bool {
 			continue
 		}
 		for _, arg := range cmd[1:] {
+			// `python -m pip install file` runs a module, not the file.
+			// `bash -c "file"` is validated as a command.
+			if arg == "-m" || arg == "-c" {
+				return false
+			}
 			if strings.EqualFold(filepath.Clean(arg), filepath.Clean(fn)) {
 				return true
 			}
@@ -365,21 +370,25 @@ func getRedirectFile(red []*syntax.Redirect) (string, bool) {
 }
 
 func collectExecuteFiles(startLine, endLine uint, node syntax.Node, cmd, pathfn string, files map[string]bool,
-	r *checker.PinningDependenciesData,
+	df *shellDataFlow, r *checker.PinningDependenciesData,
 ) {
 	ce, ok := node.(*syntax.CallExpr)
 	if !ok {
 		return
 	}
 
-	c, ok := extractCommand(ce)
-	if !ok {
+	c := df.resolveCommand(ce)
+	if len(c) == 0 {
 		return
 	}
 
 	startLine, endLine = getLine(startLine, endLine, node)
-	for fn := range files {
-		if isInterpreterWithFile(c, fn) || isExecuteFile(c, fn) {
+	for fn, tainted := range files {
+		// Files verified against a checksum are not tainted.
+		if !tainted {
+			continue
+		}
+		if isInterpreterWithFile(c, fn) || isExecuteFile(c, fn) || isSourceFile(c, fn) {
 			r.Dependencies = append(r.Dependencies,
 				checker.Dependency{
 					Location: &checker.File{
@@ -397,6 +406,11 @@ func collectExecuteFiles(startLine, endLine uint, node syntax.Node, cmd, pathfn
 	}
 }
 
+func isSourceFile(cmd []string, fn string) bool {
+	return len(cmd) > 1 && isOneOf(cmd[0], sourceBuiltins) &&
+		strings.EqualFold(filepath.Clean(cmd[1]), filepath.Clean(fn))
+}
+
 // Npm install docs are here.
 // https://docs.npmjs.com/cli/v7/commands/npm-install
 func isNpmDownload(cmd []string) bool {
@@ -782,7 +796,14 @@ func collectUnpinnedPakageManagerDownload(startLine, endLine uint, node syntax.N
 	}
 
 	startLine, endLine = getLine(startLine, endLine, node)
+	collectUnpinnedPackageManagerCommand(startLine, endLine, c, cmd, pathfn, r)
+}
 
+// collectUnpinnedPackageManagerCommand records the package manager's installs
+// of a command found between startLine and endLine.
+func collectUnpinnedPackageManagerCommand(startLine, endLine uint, c []string,
+	cmd, pathfn string, r *checker.PinningDependenciesData,
+) {
 	if len(c) == 0 {
 		return
 	}
@@ -1051,6 +1072,13 @@ func nodeToString(p *syntax.Printer, node syntax.Node) (string, error) {
 
 func validateShellFileAndRecord(pathfn string, startLine, endLine uint, content []byte, files map[string]bool,
 	r *checker.PinningDependenciesData,
+) error {
+	return validateShellFileAndRecordWithFlow(pathfn, startLine, endLine, content, files,
+		newShellDataFlow(pathfn, nil), r)
+}
+
+func validateShellFileAndRecordWithFlow(pathfn string, startLine, endLine uint, content []byte,
+	files map[string]bool, df *shellDataFlow, r *checker.PinningDependenciesData,
 ) error {
 	in := strings.NewReader(string(content))
 	f, err := syntax.NewParser().Parse(in, pathfn)
@@ -1061,15 +1089,32 @@ func validateShellFileAndRecord(pathfn string, startLine, endLine uint, content
 		return sce.WithMessage(sce.ErrorShellParsing, err.Error())
 	}
 
+	return walkShellNode(pathfn, startLine, endLine, f, files, df, r)
+}
+
+func walkShellNode(pathfn string, startLine, endLine uint, root syntax.Node, files map[string]bool,
+	df *shellDataFlow, r *checker.PinningDependenciesData,
+) error {
+	var err error
 	printer := syntax.NewPrinter()
 
-	syntax.Walk(f, func(node syntax.Node) bool {
+	syntax.Walk(root, func(node syntax.Node) bool {
 		cmdStr, e := nodeToString(printer, node)
 		if e != nil {
 			err = e
 			return false
 		}
 
+		// Track the variables and functions.
+		switch v := node.(type) {
+		case *syntax.Assign:
+			df.recordAssign(v, files)
+		case *syntax.FuncDecl:
+			df.recordFunc(v, pathfn, startLine, endLine, files)
+		case *syntax.BinaryCmd:
+			df.recordPipedChecksums(v, files)
+		}
+
 		// interpreter -c "CMD".
 		i, c, ok := extractInterpreterAndCommandFromNode(node)
 		// TODO: support other interpreters.
@@ -1078,30 +1123,45 @@ func validateShellFileAndRecord(pathfn string, startLine, endLine uint, content
 		// nolint
 		if ok && isShellInterpreterOrCommand([]string{i}) {
 			start, end := getLine(startLine, endLine, node)
-			e := validateShellFileAndRecord(pathfn, start, end,
-				[]byte(c), files, r)
+			e := validateShellFileAndRecordWithFlow(pathfn, start, end,
+				[]byte(c), files, df, r)
 			if e != nil {
 				err = e
 				return true
 			}
 		}
 
+		// eval "CMD".
+		if ce, ok := node.(*syntax.CallExpr); ok && df.depth < maxShellCallDepth {
+			if cmd := df.resolveCommand(ce); len(cmd) > 1 && cmd[0] == "eval" {
+				// The first line of the command is the line of `eval`.
+				start, end := getLine(startLine, endLine, node)
+				df.depth++
+				//nolint:errcheck // Like for scripts, eval'd commands our parser does not understand are ignored.
+				validateShellFileAndRecordWithFlow(pathfn, start-1, end-1,
+					[]byte(strings.Join(cmd[1:], " ")), files, df, r)
+				df.depth--
+			}
+		}
+
 		// `curl | bash` (supports `sudo`).
 		collectFetchPipeExecute(startLine, endLine, node, cmdStr, pathfn, r)
 
 		// Check if we're calling a file we previously downloaded.
 		// Includes `curl > /tmp/file [&&|;] [bash] /tmp/file`
-		collectExecuteFiles(startLine, endLine, node, cmdStr, pathfn, files, r)
+		collectExecuteFiles(startLine, endLine, node, cmdStr, pathfn, files, df, r)
 
 		// `bash <(wget -qO- http://website.com/my-script.sh)`. (supports `sudo`).
 		collectFetchProcSubsExecute(startLine, endLine, node, cmdStr, pathfn, r)
 
+		// Downloaded content flowing through variables, functions, `eval` or stdin.
+		df.collectDataFlowExecute(startLine, endLine, node, cmdStr, pathfn, files, r)
+
 		// Package manager's unpinned installs.
 		collectUnpinnedPakageManagerDownload(startLine, endLine, node, cmdStr, pathfn, r)
 
 		// TODO(laurent): add check for cat file | bash.
 		// TODO(laurent): detect downloads of zip/tar files containing scripts.
-		// TODO(laurent): detect command being an env variable.
 		// TODO(laurent): detect unpinned git clone.
 
 		// Record the file that is downloaded, if any.
@@ -1112,6 +1172,17 @@ func validateShellFileAndRecord(pathfn string, startLine, endLine uint, content
 		} else if b {
 			files[fn] = true
 		}
+		if ss, ok := node.(*syntax.Stmt); ok {
+			df.recordFileFlow(ss, files)
+		}
+
+		// Follow the functions called and the repository scripts sourced.
+		if ce, ok := node.(*syntax.CallExpr); ok {
+			if e := df.followCall(startLine, endLine, ce, cmdStr, pathfn, files, r); e != nil {
+				err = e
+				return false
+			}
+		}
 
 		// Continue walking the node graph.
 		return true
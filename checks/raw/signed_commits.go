@@ -0,0 +1,62 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/clients"
+)
+
+// SignedCommits retrieves the raw data for the Signed-Commits check.
+func SignedCommits(c *checker.CheckRequest) (checker.SignedCommitsData, error) {
+	var data checker.SignedCommitsData
+
+	commits, err := c.RepoClient.ListCommits()
+	if err != nil && !errors.Is(err, clients.ErrUnsupportedFeature) {
+		return data, fmt.Errorf("%w", err)
+	}
+	data.Commits = commits
+
+	tags, err := c.RepoClient.ListTags()
+	if errors.Is(err, clients.ErrUnsupportedFeature) {
+		return data, nil
+	}
+	if err != nil {
+		return data, fmt.Errorf("%w", err)
+	}
+
+	releases, err := c.RepoClient.ListReleases()
+	if errors.Is(err, clients.ErrUnsupportedFeature) {
+		// Without releases, e.g., for local repositories, all the tags are considered.
+		data.Tags = tags
+		return data, nil
+	}
+	if err != nil {
+		return data, fmt.Errorf("%w", err)
+	}
+	releaseTags := make(map[string]bool, len(releases))
+	for i := range releases {
+		releaseTags[releases[i].TagName] = true
+	}
+	for i := range tags {
+		if releaseTags[tags[i].Name] {
+			data.Tags = append(data.Tags, tags[i])
+		}
+	}
+	return data, nil
+}
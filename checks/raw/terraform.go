@@ -0,0 +1,378 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/checks/fileparser"
+	"github.com/ossf/scorecard/v4/finding"
+)
+
+const terraformLockfile = ".terraform.lock.hcl"
+
+var (
+	gitCommitSHARegex = regexp.MustCompile(`^[a-fA-F\d]{40}$`)
+	// https://developer.hashicorp.com/terraform/language/expressions/version-constraints.
+	terraformExactVersionRegex = regexp.MustCompile(`^=?\s*v?\d+\.\d+\.\d+(-[0-9A-Za-z-.]+)?(\+[0-9A-Za-z-.]+)?$`)
+	// <NAMESPACE>/<NAME>/<PROVIDER> or <HOSTNAME>/<NAMESPACE>/<NAME>/<PROVIDER>,
+	// https://developer.hashicorp.com/terraform/language/modules/sources#terraform-registry.
+	terraformRegistrySourceRegex = regexp.MustCompile(`^([\w.-]+\.[a-z]+/)?[\w-]+/[\w-]+/[\w-]+$`)
+)
+
+// terraformProvider is a provider used by a Terraform or OpenTofu root module.
+type terraformProvider struct {
+	location   checker.File
+	source     string
+	constraint string
+}
+
+// terraformDir is the state of a directory containing Terraform or OpenTofu files.
+type terraformDir struct {
+	// Locked providers, keyed by their source without registry hostname.
+	locked map[string]terraformLockedProvider
+	// Providers, keyed by their local name.
+	providers map[string]*terraformProvider
+	// Only root modules are expected to have a lockfile.
+	isRoot bool
+}
+
+type terraformLockedProvider struct {
+	version string
+	hashes  int
+}
+
+type terraformData map[string]*terraformDir
+
+func (t terraformData) dir(pathfn string) *terraformDir {
+	d := path.Dir(pathfn)
+	if _, ok := t[d]; !ok {
+		t[d] = &terraformDir{
+			locked:    map[string]terraformLockedProvider{},
+			providers: map[string]*terraformProvider{},
+		}
+	}
+	return t[d]
+}
+
+// Check pinning of Terraform and OpenTofu modules and providers.
+func collectTerraformPinning(c *checker.CheckRequest, r *checker.PinningDependenciesData) error {
+	tf := terraformData{}
+	if err := fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
+		Pattern:       terraformLockfile,
+		CaseSensitive: true,
+	}, recordTerraformLockfile, tf); err != nil {
+		return err
+	}
+
+	for _, pattern := range []string{"*.tf", "*.tofu"} {
+		if err := fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
+			Pattern:       pattern,
+			CaseSensitive: true,
+		}, validateTerraformPinning, r, tf); err != nil {
+			return err
+		}
+	}
+
+	recordTerraformProviders(tf, r)
+	return nil
+}
+
+func dataAsTerraformData(data interface{}) (terraformData, error) {
+	tf, ok := data.(terraformData)
+	if !ok {
+		return nil, fmt.Errorf("expected type terraformData: %w", errInvalidArgType)
+	}
+	return tf, nil
+}
+
+var recordTerraformLockfile fileparser.DoWhileTrueOnFileContent = func(
+	pathfn string,
+	content []byte,
+	args ...interface{},
+) (bool, error) {
+	if len(args) != 1 {
+		return false, fmt.Errorf(
+			"recordTerraformLockfile requires exactly 1 arguments: got %v: %w", len(args), errInvalidArgLength)
+	}
+	tf, err := dataAsTerraformData(args[0])
+	if err != nil {
+		return false, err
+	}
+
+	d := tf.dir(pathfn)
+	d.isRoot = true
+
+	body, err := parseHCL(pathfn, content)
+	if err != nil {
+		// The lockfile is present, but we cannot tell what it locks.
+		return true, nil
+	}
+	for _, p := range hclBlocksOfType(body, "provider") {
+		if len(p.Labels) != 1 {
+			continue
+		}
+		version, _ := hclStringAttribute(p.Body, "version")
+		var hashes []hcl.Expression
+		if attr, ok := p.Body.Attributes["hashes"]; ok {
+			hashes, _ = hcl.ExprList(attr.Expr)
+		}
+		d.locked[terraformProviderKey(p.Labels[0])] = terraformLockedProvider{
+			version: version,
+			hashes:  len(hashes),
+		}
+	}
+	return true, nil
+}
+
+// validateTerraformPinning records the modules used by a Terraform file, and the providers
+// it requires. Returns true if the check should continue executing after this file.
+var validateTerraformPinning fileparser.DoWhileTrueOnFileContent = func(
+	pathfn string,
+	content []byte,
+	args ...interface{},
+) (bool, error) {
+	if len(args) != 2 {
+		return false, fmt.Errorf(
+			"validateTerraformPinning requires exactly 2 arguments: got %v: %w", len(args), errInvalidArgLength)
+	}
+	pdata := dataAsPinnedDependenciesPointer(args[0])
+	tf, err := dataAsTerraformData(args[1])
+	if err != nil {
+		return false, err
+	}
+
+	if !fileparser.CheckFileContainsCommands(content, "#") {
+		return true, nil
+	}
+
+	body, err := parseHCL(pathfn, content)
+	if err != nil {
+		pdata.Dependencies = append(pdata.Dependencies, checker.Dependency{
+			Location: &checker.File{
+				Path: pathfn,
+				Type: finding.FileTypeSource,
+			},
+			Msg:  asPointer(err.Error()),
+			Type: checker.DependencyUseTypeTerraformModule,
+		})
+		return true, nil
+	}
+
+	for _, m := range hclBlocksOfType(body, "module") {
+		if dep, ok := terraformModuleDependency(pathfn, m); ok {
+			pdata.Dependencies = append(pdata.Dependencies, dep)
+		}
+	}
+
+	d := tf.dir(pathfn)
+	for _, t := range hclBlocksOfType(body, "terraform") {
+		// https://developer.hashicorp.com/terraform/language/settings/backends/configuration.
+		if len(hclBlocksOfType(t.Body, "backend")) > 0 || len(hclBlocksOfType(t.Body, "cloud")) > 0 {
+			d.isRoot = true
+		}
+		for _, rp := range hclBlocksOfType(t.Body, "required_providers") {
+			for name, attr := range rp.Body.Attributes {
+				source := terraformProviderKey(name)
+				// Legacy `aws = "~> 5.0"` syntax.
+				constraint, legacy := hclString(attr.Expr)
+				if !legacy {
+					elems := hclObjectElements(attr.Expr)
+					if s, ok := elems["source"]; ok {
+						if v, ok := hclString(s); ok {
+							source = terraformProviderKey(v)
+						}
+					}
+					if v, ok := elems["version"]; ok {
+						constraint, _ = hclString(v)
+					}
+				}
+				line := hclLine(attr.SrcRange)
+				d.providers[name] = &terraformProvider{
+					source:     source,
+					constraint: constraint,
+					location: checker.File{
+						Path:      pathfn,
+						Type:      finding.FileTypeSource,
+						Offset:    line,
+						EndOffset: line,
+						Snippet:   fmt.Sprintf("%s = { source = %q }", name, source),
+					},
+				}
+			}
+		}
+	}
+
+	// Provider configurations are only allowed in root modules.
+	for _, p := range hclBlocksOfType(body, "provider") {
+		if len(p.Labels) != 1 {
+			continue
+		}
+		d.isRoot = true
+		name := p.Labels[0]
+		if _, ok := d.providers[name]; ok {
+			continue
+		}
+		d.providers[name] = &terraformProvider{
+			source: terraformProviderKey(name),
+			location: checker.File{
+				Path:      pathfn,
+				Type:      finding.FileTypeSource,
+				Offset:    hclLine(p.TypeRange),
+				EndOffset: hclLine(p.TypeRange),
+				Snippet:   fmt.Sprintf("provider %q", p.Labels[0]),
+			},
+		}
+	}
+
+	return true, nil
+}
+
+// terraformProviderKey normalizes a provider source address by removing the registry
+// hostname, so that `registry.terraform.io/hashicorp/aws` and `registry.opentofu.org/hashicorp/aws`
+// both match `hashicorp/aws`. Providers without a namespace default to `hashicorp`.
+func terraformProviderKey(source string) string {
+	parts := strings.Split(strings.ToLower(source), "/")
+	switch len(parts) {
+	case 1:
+		return "hashicorp/" + parts[0]
+	case 3:
+		return parts[1] + "/" + parts[2]
+	default:
+		return strings.ToLower(source)
+	}
+}
+
+// https://developer.hashicorp.com/terraform/language/modules/sources.
+func terraformModuleDependency(pathfn string, m *hclsyntax.Block) (checker.Dependency, bool) {
+	source, ok := hclStringAttribute(m.Body, "source")
+	if !ok {
+		return checker.Dependency{}, false
+	}
+	// Local paths are part of the repository.
+	if strings.HasPrefix(source, "./") || strings.HasPrefix(source, "../") {
+		return checker.Dependency{}, false
+	}
+
+	line := hclLine(m.Body.Attributes["source"].SrcRange)
+	dep := checker.Dependency{
+		Location: &checker.File{
+			Path:      pathfn,
+			Type:      finding.FileTypeSource,
+			Offset:    line,
+			EndOffset: line,
+			Snippet:   fmt.Sprintf("source = %q", source),
+		},
+		Name: asPointer(source),
+		Type: checker.DependencyUseTypeTerraformModule,
+	}
+
+	if isTerraformRegistrySource(source) {
+		// Registry modules cannot be pinned by hash: the best we can
+		// ask for is an exact version.
+		version, _ := hclStringAttribute(m.Body, "version")
+		if version != "" {
+			dep.PinnedAt = asPointer(version)
+		}
+		dep.Pinned = asBoolPointer(terraformExactVersionRegex.MatchString(strings.TrimSpace(version)))
+		return dep, true
+	}
+
+	name, ref, checksum := splitTerraformModuleSource(source)
+	dep.Name = asPointer(name)
+	if ref != "" {
+		dep.PinnedAt = asPointer(ref)
+	}
+	dep.Pinned = asBoolPointer(gitCommitSHARegex.MatchString(ref) || checksum)
+	return dep, true
+}
+
+func isTerraformRegistrySource(source string) bool {
+	// GitHub and Bitbucket shorthands are fetched with git.
+	if strings.HasPrefix(source, "github.com/") || strings.HasPrefix(source, "bitbucket.org/") {
+		return false
+	}
+	// Registry modules may reference a sub-directory, e.g. `hashicorp/consul/aws//modules/consul-cluster`.
+	if i := strings.Index(source, "//"); i > 0 && !strings.Contains(source, "::") && source[i-1] != ':' {
+		source = source[:i]
+	}
+	return terraformRegistrySourceRegex.MatchString(source)
+}
+
+// splitTerraformModuleSource returns the source without its query string, the git
+// `ref` argument, and whether the archive is verified with a `checksum` argument.
+func splitTerraformModuleSource(source string) (name, ref string, checksum bool) {
+	i := strings.Index(source, "?")
+	if i < 0 {
+		return source, "", false
+	}
+	name = source[:i]
+	query, err := url.ParseQuery(source[i+1:])
+	if err != nil {
+		return name, "", false
+	}
+	return name, query.Get("ref"), query.Get("checksum") != ""
+}
+
+// recordTerraformProviders records the providers of root modules, which are
+// pinned if the lockfile contains hashes for them.
+// https://developer.hashicorp.com/terraform/language/files/dependency-lock.
+func recordTerraformProviders(tf terraformData, r *checker.PinningDependenciesData) {
+	dirs := make([]string, 0, len(tf))
+	for d := range tf {
+		dirs = append(dirs, d)
+	}
+	sort.Strings(dirs)
+
+	for _, dn := range dirs {
+		d := tf[dn]
+		if !d.isRoot {
+			continue
+		}
+		names := make([]string, 0, len(d.providers))
+		for n := range d.providers {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+
+		for _, n := range names {
+			p := d.providers[n]
+			location := p.location
+			dep := checker.Dependency{
+				Location: &location,
+				Name:     asPointer(p.source),
+				Type:     checker.DependencyUseTypeTerraformProvider,
+			}
+			locked, ok := d.locked[p.source]
+			switch {
+			case ok && locked.version != "":
+				dep.PinnedAt = asPointer(locked.version)
+			case p.constraint != "":
+				dep.PinnedAt = asPointer(p.constraint)
+			}
+			dep.Pinned = asBoolPointer(ok && locked.hashes > 0)
+			r.Dependencies = append(r.Dependencies, dep)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/hcl/v2"
+
+	"github.com/ossf/scorecard/v4/checker"
+)
+
+type terraformDependencyResult struct {
+	Name   string
+	Type   checker.DependencyUseType
+	Line   uint
+	Pinned bool
+}
+
+func TestTerraformPinning(t *testing.T) {
+	t.Parallel()
+
+	lockfiles := []string{
+		"./testdata/terraform/root/.terraform.lock.hcl",
+	}
+	files := []string{
+		"./testdata/terraform/root/main.tf",
+		"./testdata/terraform/module/main.tf",
+	}
+
+	tf := terraformData{}
+	var r checker.PinningDependenciesData
+	for _, fn := range lockfiles {
+		content, err := os.ReadFile(fn)
+		if err != nil {
+			t.Fatalf("cannot read file: %v", err)
+		}
+		if _, err := recordTerraformLockfile(strings.TrimPrefix(fn, "./testdata/"), content, tf); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	for _, fn := range files {
+		content, err := os.ReadFile(fn)
+		if err != nil {
+			t.Fatalf("cannot read file: %v", err)
+		}
+		if _, err := validateTerraformPinning(strings.TrimPrefix(fn, "./testdata/"), content, &r, tf); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	recordTerraformProviders(tf, &r)
+
+	var got []terraformDependencyResult
+	for _, d := range r.Dependencies {
+		if d.Msg != nil {
+			t.Errorf("unexpected message: %s", *d.Msg)
+			continue
+		}
+		got = append(got, terraformDependencyResult{
+			Name:   *d.Name,
+			Type:   d.Type,
+			Line:   d.Location.Offset,
+			Pinned: *d.Pinned,
+		})
+	}
+
+	want := []terraformDependencyResult{
+		{Name: "terraform-aws-modules/vpc/aws", Type: checker.DependencyUseTypeTerraformModule, Line: 21, Pinned: true},
+		{Name: "terraform-aws-modules/eks/aws", Type: checker.DependencyUseTypeTerraformModule, Line: 26},
+		{
+			Name: "git::https://example.com/network.git//modules/vpc",
+			Type: checker.DependencyUseTypeTerraformModule, Line: 31, Pinned: true,
+		},
+		{Name: "github.com/example/terraform-module", Type: checker.DependencyUseTypeTerraformModule, Line: 35},
+		{Name: "app.terraform.io/example/nested/aws", Type: checker.DependencyUseTypeTerraformModule, Line: 11},
+		// Only root modules are expected to lock their providers.
+		{Name: "hashicorp/aws", Type: checker.DependencyUseTypeTerraformProvider, Line: 9, Pinned: true},
+		{Name: "hashicorp/google", Type: checker.DependencyUseTypeTerraformProvider, Line: 57},
+		{Name: "hashicorp/random", Type: checker.DependencyUseTypeTerraformProvider, Line: 13},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestParseHCL(t *testing.T) {
+	t.Parallel()
+	//nolint
+	tests := []struct {
+		name    string
+		content string
+		wantErr bool
+	}{
+		{
+			name:    "valid",
+			content: "a = \"b\" # comment\nblock \"label\" {\n  c = [\"d\", \"e\"]\n  obj = { f = \"${var.x}\" }\n}\n",
+		},
+		{
+			name:    "unterminated block",
+			content: "block {\n  a = 1\n",
+			wantErr: true,
+		},
+		{
+			name:    "unterminated string",
+			content: "a = \"b\n",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			body, err := parseHCL("main.tf", []byte(tt.content))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err != nil {
+				return
+			}
+			if v, _ := hclStringAttribute(body, "a"); v != "b" {
+				t.Errorf("expected attribute a = b. Got %q", v)
+			}
+			blocks := hclBlocksOfType(body, "block")
+			if len(blocks) != 1 || len(blocks[0].Labels) != 1 || blocks[0].Labels[0] != "label" ||
+				hclLine(blocks[0].TypeRange) != 2 {
+				t.Fatalf("unexpected blocks: %+v", blocks)
+			}
+			list, diags := hcl.ExprList(blocks[0].Body.Attributes["c"].Expr)
+			if diags.HasErrors() || len(list) != 2 {
+				t.Errorf("unexpected list: %v", diags)
+			}
+			f, ok := hclObjectElements(blocks[0].Body.Attributes["obj"].Expr)["f"]
+			if !ok {
+				t.Fatalf("missing object element")
+			}
+			if _, ok := hclString(f); ok {
+				t.Errorf("expected a template referencing a variable not to be a string literal")
+			}
+		})
+	}
+}
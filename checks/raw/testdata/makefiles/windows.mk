@@ -0,0 +1,6 @@
+SHELL := pwsh.exe
+.SHELLFLAGS := -NoProfile -Command
+
+install:
+	iwr https://example.com/install.ps1 | iex
+	choco install -y nodejs
@@ -0,0 +1,41 @@
+#Requires -Version 5.1
+<#
+.SYNOPSIS
+  Installs the build dependencies.
+#>
+$ErrorActionPreference = 'Stop'
+
+# Download then execute.
+iwr https://example.com/install.ps1 -UseBasicParsing | iex
+Invoke-Expression ((New-Object System.Net.WebClient).DownloadString('https://example.com/install.ps1'))
+$script = Invoke-RestMethod https://example.com/install.ps1
+iex $script
+
+Invoke-WebRequest -Uri https://example.com/setup.exe -OutFile "$env:TEMP\setup.exe"
+Start-Process -FilePath "$env:TEMP\setup.exe" -Wait
+Invoke-WebRequest -Uri https://example.com/tool.ps1 `
+  -OutFile .\tool.ps1
+& .\tool.ps1 -Force
+powershell -NoProfile -Command "irm https://example.com/install.ps1 | iex"
+
+# Verified downloads are not reported.
+Invoke-WebRequest -Uri https://example.com/verified.ps1 -OutFile verified.ps1
+if ((Get-FileHash verified.ps1 -Algorithm SHA256).Hash -ne $env:VERIFIED_SHA256) { throw "hash mismatch" }
+& .\verified.ps1
+
+# Package managers.
+choco install -y git
+choco install -y git --requirechecksums
+pip install requests
+pip install --require-hashes -r requirements.txt
+python -m pip install -r requirements.txt
+npm ci
+npm install -g typescript
+nuget.exe install Newtonsoft.Json -Version 13.0.1
+dotnet add package Newtonsoft.Json
+foreach ($tool in $tools) { go install golang.org/x/tools/cmd/goimports@latest }
+
+# Downloads which are not executed are not reported.
+Invoke-WebRequest -Uri https://example.com/data.json -OutFile data.json
+$json = Get-Content data.json | ConvertFrom-Json
+Write-Host "iwr https://example.com/install.ps1 | iex"
@@ -0,0 +1,60 @@
+#!/bin/bash
+# Copyright 2023 OpenSSF Scorecard Authors
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#      http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+source "$(dirname "$0")/scripts/download-helpers.sh"
+
+INSTALLER_URL="https://example.com/install.sh"
+INSTALLER="${RUNNER_TEMP}/install.sh"
+
+# Downloaded file executed several lines later.
+curl -fsSL -o "$INSTALLER" "$INSTALLER_URL"
+chmod +x "$INSTALLER"
+echo "installing"
+"$INSTALLER"
+
+# Downloaded content stored in a variable.
+SCRIPT=$(curl -fsSL "$INSTALLER_URL")
+eval "$SCRIPT"
+bash -c "$SCRIPT"
+echo "$SCRIPT" | sh
+
+# Downloads through functions.
+fetch() {
+  curl -fsSL "$1"
+}
+fetch "$INSTALLER_URL" | bash
+
+download_to() {
+  wget -q -O "$2" "$1"
+}
+download_to "$INSTALLER_URL" /tmp/setup.sh
+bash /tmp/setup.sh
+
+# Function defined in a sourced repository script.
+fetch_script "$INSTALLER_URL" |
+  bash
+
+# Command evaluated from a string.
+eval "curl -fsSL $INSTALLER_URL | bash"
+
+# Checksums verified before execution.
+curl -fsSL -o /tmp/verified.sh "$INSTALLER_URL"
+echo "${CHECKSUM}  /tmp/verified.sh" | sha256sum -c
+bash /tmp/verified.sh
+
+curl -fsSLO "$INSTALLER_URL"
+curl -fsSLO "${INSTALLER_URL}.sha256"
+sha256sum --check install.sh.sha256
+./install.sh
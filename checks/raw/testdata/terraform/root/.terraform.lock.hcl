@@ -0,0 +1,15 @@
+# This file is maintained automatically by "terraform init".
+# Manual edits may be lost in future updates.
+
+provider "registry.terraform.io/hashicorp/aws" {
+  version     = "5.1.0"
+  constraints = "~> 5.0"
+  hashes = [
+    "h1:3VW7x9j+Wt+PKm8mWaDqEK6M1s9b7D0Yq+6b3UVxV6o=",
+    "zh:0c7b1ffeb1a2d4bd0d4fa6d3a0b1d4dd5f4ef0f8fa2c4d0d2c4bb0d2ad8df3b2",
+  ]
+}
+
+provider "registry.opentofu.org/hashicorp/random" {
+  version = "3.5.1"
+}
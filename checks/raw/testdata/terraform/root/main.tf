@@ -0,0 +1,59 @@
+terraform {
+  required_version = ">= 1.5"
+
+  backend "s3" {
+    bucket = "state"
+  }
+
+  required_providers {
+    aws = {
+      source  = "hashicorp/aws"
+      version = "~> 5.0"
+    }
+    random = {
+      source = "hashicorp/random"
+    }
+  }
+}
+
+/* Modules used by the root module. */
+module "vpc" {
+  source  = "terraform-aws-modules/vpc/aws"
+  version = "5.1.2"
+}
+
+module "eks" {
+  source  = "terraform-aws-modules/eks/aws"
+  version = "~> 19.0"
+}
+
+module "git_pinned" {
+  source = "git::https://example.com/network.git//modules/vpc?ref=a81bbbf8298c0fa03ea29cdc473d45769f953675"
+}
+
+module "git_tag" {
+  source = "github.com/example/terraform-module?ref=v1.2.0"
+}
+
+module "local" {
+  source = "../module"
+}
+
+locals {
+  tags = merge(var.tags, {
+    Name = "example-${var.env}"
+  })
+  policy = <<EOT
+{
+  "Version": "2012-10-17"
+}
+EOT
+}
+
+provider "aws" {
+  region = "us-east-1"
+}
+
+provider "google" {
+  project = "example"
+}
@@ -15,7 +15,10 @@
 package raw
 
 import (
+	"strings"
+
 	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/clients"
 	sce "github.com/ossf/scorecard/v4/errors"
 )
 
@@ -26,6 +29,18 @@ func WebHook(c *checker.CheckRequest) (checker.WebhooksData, error) {
 		return checker.WebhooksData{},
 			sce.WithMessage(sce.ErrScorecardInternal, "Client.Repositories.ListWebhooks")
 	}
+	// Webhook URLs may carry credentials: only keep their host in case
+	// the client did not redact them.
+	for i := range hooksResp {
+		hook := &hooksResp[i]
+		if strings.Contains(hook.Path, "://") {
+			scheme, host := clients.RedactWebhookURL(hook.Path)
+			hook.Path = host
+			if hook.Scheme == "" {
+				hook.Scheme = scheme
+			}
+		}
+	}
 	return checker.WebhooksData{
 		Webhooks: hooksResp,
 	}, nil
@@ -0,0 +1,257 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/rhysd/actionlint"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/checks/fileparser"
+	"github.com/ossf/scorecard/v4/clients"
+	"github.com/ossf/scorecard/v4/finding"
+)
+
+var (
+	// printCommandRegex matches the commands printing their arguments to the logs.
+	printCommandRegex = regexp.MustCompile(
+		`(?i)(?:^|[\s;&(])(?:echo|printf|print|Write-Host|Write-Output|console\.log)\b`)
+	// forkCheckRegex matches the conditions restricting a job to the
+	// pull requests and workflow runs of the repository itself.
+	forkCheckRegex = regexp.MustCompile(`head\.repo\.(?:full_name|fork)|head_repository\.(?:full_name|fork)`)
+)
+
+type workflowSecretsArgs struct {
+	data  *checker.WorkflowSecretsData
+	owner string
+}
+
+// WorkflowSecrets retrieves the raw data for the Workflow-Secrets check.
+func WorkflowSecrets(c *checker.CheckRequest) (checker.WorkflowSecretsData, error) {
+	var data checker.WorkflowSecretsData
+	err := fileparser.OnMatchingFileContentDo(c.RepoClient, fileparser.PathMatcher{
+		Pattern:       ".github/workflows/*",
+		CaseSensitive: false,
+	}, checkWorkflowSecrets, workflowSecretsArgs{data: &data, owner: repoOwner(c.Repo)})
+	if err != nil {
+		return checker.WorkflowSecretsData{}, fmt.Errorf("%w", err)
+	}
+	return data, nil
+}
+
+// repoOwner returns the owner of the repository, or an empty string
+// for repositories without an owner, e.g. local directories.
+func repoOwner(repo clients.Repo) string {
+	if repo == nil || repo.Host() == "" {
+		return ""
+	}
+	parts := strings.Split(repo.URI(), "/")
+	if len(parts) < 3 {
+		return ""
+	}
+	return parts[1]
+}
+
+var checkWorkflowSecrets fileparser.DoWhileTrueOnFileContent = func(path string, content []byte,
+	args ...interface{},
+) (bool, error) {
+	if !fileparser.IsWorkflowFile(path) {
+		return true, nil
+	}
+	if len(args) != 1 {
+		return false, fmt.Errorf(
+			"checkWorkflowSecrets requires exactly one argument: %w", errInvalidArgLength)
+	}
+	a, ok := args[0].(workflowSecretsArgs)
+	if !ok {
+		return false, fmt.Errorf(
+			"checkWorkflowSecrets requires argument of type workflowSecretsArgs: %w", errInvalidArgType)
+	}
+	if !fileparser.CheckFileContainsCommands(content, "#") {
+		return true, nil
+	}
+
+	workflow, errs := actionlint.Parse(content)
+	if len(errs) > 0 && workflow == nil {
+		return false, fileparser.FormatActionlintError(errs)
+	}
+	a.data.NumWorkflows++
+
+	forkTriggered := usesEventTrigger(workflow, triggerPullRequestTarget) ||
+		usesEventTrigger(workflow, triggerWorkflowRun)
+	for _, usage := range fileparser.GetSecretUsage(workflow) {
+		a.data.Exposures = append(a.data.Exposures,
+			jobSecretExposures(path, a.owner, forkTriggered, &usage)...)
+	}
+	return true, nil
+}
+
+func jobSecretExposures(path, owner string, forkTriggered bool,
+	usage *fileparser.JobSecretUsage,
+) []checker.WorkflowSecretExposure {
+	var ret []checker.WorkflowSecretExposure
+	job := createJob(usage.Job)
+	add := func(t checker.WorkflowSecretExposureType, use *fileparser.SecretUse, snippet string) {
+		line := fileparser.GetLineNumber(use.Pos)
+		ret = append(ret, checker.WorkflowSecretExposure{
+			Job:        job,
+			Secret:     use.Secret,
+			Receiver:   use.Receiver,
+			Type:       t,
+			ThirdParty: use.Receiver != "" && !isFirstPartyUses(use.Receiver, owner),
+			File: checker.File{
+				Path:      path,
+				Type:      finding.FileTypeSource,
+				Offset:    line,
+				EndOffset: line,
+				Snippet:   snippet,
+			},
+		})
+	}
+
+	restricted := usage.Job.If != nil && forkCheckRegex.MatchString(usage.Job.If.Value)
+	// The secrets of the workflow and job environments are used by every step:
+	// only report them once per job when the job is triggered by forks.
+	type forkKey struct {
+		secret string
+		line   uint
+	}
+	forkSeen := make(map[forkKey]bool)
+	for i := range usage.Uses {
+		use := &usage.Uses[i]
+		// The permissions of the GITHUB_TOKEN are analyzed by the Token-Permissions check.
+		if strings.EqualFold(use.Secret, "GITHUB_TOKEN") {
+			continue
+		}
+		snippet := use.Receiver
+		if snippet == "" {
+			snippet = secretSnippet(use.Secret)
+		}
+		switch use.Kind {
+		case fileparser.SecretUseInherit:
+			add(checker.WorkflowSecretInherited, use, snippet)
+		case fileparser.SecretUseActionInput, fileparser.SecretUseActionEnv, fileparser.SecretUseWorkflowCall:
+			if !isActionDependencyPinned(use.Receiver) {
+				add(checker.WorkflowSecretUnpinnedReceiver, use, snippet)
+			}
+		case fileparser.SecretUseRunEnv, fileparser.SecretUseRunScript:
+		}
+		if k := (forkKey{secret: use.Secret, line: fileparser.GetLineNumber(use.Pos)}); forkTriggered &&
+			!restricted && !forkSeen[k] {
+			forkSeen[k] = true
+			add(checker.WorkflowSecretForkTrigger, use, snippet)
+		}
+	}
+
+	for _, step := range usage.Job.Steps {
+		for _, logged := range loggedSecrets(step, usage.Uses) {
+			add(checker.WorkflowSecretLogged, &logged.use, logged.line)
+		}
+	}
+	return ret
+}
+
+type loggedSecret struct {
+	use  fileparser.SecretUse
+	line string
+}
+
+// loggedSecrets returns the secrets printed by the script of a `run` step, either
+// directly or through the environment variables holding them.
+func loggedSecrets(step *actionlint.Step, uses []fileparser.SecretUse) []loggedSecret {
+	if step == nil {
+		return nil
+	}
+	run, ok := step.Exec.(*actionlint.ExecRun)
+	if !ok || run.Run == nil {
+		return nil
+	}
+
+	byLine := make(map[int][]fileparser.SecretUse)
+	var envUses []fileparser.SecretUse
+	for _, u := range uses {
+		if u.Step != step || strings.EqualFold(u.Secret, "GITHUB_TOKEN") {
+			continue
+		}
+		switch u.Kind {
+		case fileparser.SecretUseRunScript:
+			if u.Pos != nil {
+				byLine[u.Pos.Line] = append(byLine[u.Pos.Line], u)
+			}
+		case fileparser.SecretUseRunEnv:
+			envUses = append(envUses, u)
+		}
+	}
+
+	var ret []loggedSecret
+	for i, line := range strings.Split(run.Run.Value, "\n") {
+		if !printsToLogs(line) {
+			continue
+		}
+		pos := fileparser.ScriptLinePos(run.Run, i)
+		if pos == nil {
+			continue
+		}
+		printed := byLine[pos.Line]
+		for _, u := range envUses {
+			if referencesEnvVar(line, u.Key) {
+				printed = append(printed, u)
+			}
+		}
+		for _, u := range printed {
+			u.Pos = pos
+			ret = append(ret, loggedSecret{use: u, line: strings.TrimSpace(line)})
+		}
+	}
+	return ret
+}
+
+// printsToLogs returns true if the line of a script prints its arguments to the logs.
+// The output redirected to files or piped to other commands, and the masking of
+// values, are not logged.
+func printsToLogs(line string) bool {
+	return printCommandRegex.MatchString(line) &&
+		!strings.Contains(line, "::add-mask::") &&
+		!strings.ContainsAny(line, ">|")
+}
+
+func referencesEnvVar(line, name string) bool {
+	n := regexp.QuoteMeta(name)
+	return regexp.MustCompile(`\$(?:\{` + n + `\}|` + n + `\b)|(?i:\$env:)` + n + `\b|%` + n + `%`).
+		MatchString(line)
+}
+
+func secretSnippet(secret string) string {
+	if secret == "" {
+		return "${{ toJSON(secrets) }}"
+	}
+	return fmt.Sprintf("${{ secrets.%s }}", secret)
+}
+
+// isFirstPartyUses returns true if the action or reusable workflow is
+// owned by the owner of the repository.
+func isFirstPartyUses(uses, owner string) bool {
+	if fileparser.IsLocalUses(uses) {
+		return true
+	}
+	if owner == "" || strings.HasPrefix(uses, "docker://") {
+		return false
+	}
+	o, _, found := strings.Cut(uses, "/")
+	return found && strings.EqualFold(o, owner)
+}
@@ -0,0 +1,165 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package raw
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v4/checker"
+	mockrepo "github.com/ossf/scorecard/v4/clients/mockclients"
+)
+
+func TestWorkflowSecrets(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	mockRepoClient := mockrepo.NewMockRepoClient(ctrl)
+	mockRepoClient.EXPECT().ListFiles(gomock.Any()).Return([]string{
+		".github/workflows/github-workflow-secrets.yaml",
+		"script.sh",
+	}, nil)
+	mockRepoClient.EXPECT().GetFileContent(gomock.Any()).DoAndReturn(func(file string) ([]byte, error) {
+		content, err := os.ReadFile("../testdata/" + file)
+		if err != nil {
+			return content, fmt.Errorf("%w", err)
+		}
+		return content, nil
+	}).AnyTimes()
+	mockRepo := mockrepo.NewMockRepo(ctrl)
+	mockRepo.EXPECT().Host().Return("github.com").AnyTimes()
+	mockRepo.EXPECT().URI().Return("github.com/ossf/scorecard").AnyTimes()
+
+	data, err := WorkflowSecrets(&checker.CheckRequest{
+		RepoClient: mockRepoClient,
+		Repo:       mockRepo,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if data.NumWorkflows != 1 {
+		t.Errorf("unexpected number of workflows: %d", data.NumWorkflows)
+	}
+
+	type exposure struct {
+		Type       checker.WorkflowSecretExposureType
+		Job        string
+		Secret     string
+		Receiver   string
+		Offset     uint
+		ThirdParty bool
+	}
+	want := []exposure{
+		{
+			Type: checker.WorkflowSecretUnpinnedReceiver, Job: "build", Secret: "NPM_TOKEN",
+			Receiver: "actions/checkout@v4", Offset: 19, ThirdParty: true,
+		},
+		{
+			Type: checker.WorkflowSecretForkTrigger, Job: "build", Secret: "NPM_TOKEN",
+			Receiver: "actions/checkout@v4", Offset: 19, ThirdParty: true,
+		},
+		{
+			Type: checker.WorkflowSecretUnpinnedReceiver, Job: "build", Secret: "DEPLOY_KEY",
+			Receiver: "ossf/action@v1", Offset: 35,
+		},
+		{
+			Type: checker.WorkflowSecretForkTrigger, Job: "build", Secret: "DEPLOY_KEY",
+			Receiver: "ossf/action@v1", Offset: 35,
+		},
+		{
+			Type: checker.WorkflowSecretUnpinnedReceiver, Job: "build", Secret: "NPM_TOKEN",
+			Receiver: "ossf/action@v1", Offset: 19,
+		},
+		// A secret passed to a pinned action is only reported because the job is triggered by forks.
+		{
+			Type: checker.WorkflowSecretForkTrigger, Job: "build", Secret: "SIGNING_KEY",
+			Receiver: "other-org/action@8e5e7e5ab8b370d6c329ec480221332ada57f0ab", Offset: 38, ThirdParty: true,
+		},
+		{
+			Type: checker.WorkflowSecretForkTrigger, Job: "build", Secret: "API_KEY", Offset: 41,
+		},
+		{
+			Type: checker.WorkflowSecretForkTrigger, Job: "build", Secret: "API_KEY", Offset: 42,
+		},
+		{
+			Type: checker.WorkflowSecretLogged, Job: "build", Secret: "NPM_TOKEN", Offset: 40,
+		},
+		{
+			Type: checker.WorkflowSecretInherited, Job: "call-first-party",
+			Receiver: "ossf/workflows/.github/workflows/release.yml@v1", Offset: 25,
+		},
+		{
+			Type: checker.WorkflowSecretForkTrigger, Job: "call-first-party",
+			Receiver: "ossf/workflows/.github/workflows/release.yml@v1", Offset: 25,
+		},
+		{
+			Type: checker.WorkflowSecretInherited, Job: "call-third-party",
+			Receiver: "other-org/workflows/.github/workflows/release.yml@v1", Offset: 22, ThirdParty: true,
+		},
+		{
+			Type: checker.WorkflowSecretForkTrigger, Job: "call-third-party",
+			Receiver: "other-org/workflows/.github/workflows/release.yml@v1", Offset: 22, ThirdParty: true,
+		},
+		// The job guarded by a head.repo condition is not triggered by forks,
+		// but its secrets are still passed to an unpinned action.
+		{
+			Type: checker.WorkflowSecretUnpinnedReceiver, Job: "restricted", Secret: "DEPLOY_KEY",
+			Receiver: "other-org/deploy@v2", Offset: 52, ThirdParty: true,
+		},
+		{
+			Type: checker.WorkflowSecretUnpinnedReceiver, Job: "restricted", Secret: "NPM_TOKEN",
+			Receiver: "other-org/deploy@v2", Offset: 19, ThirdParty: true,
+		},
+	}
+	var got []exposure
+	for _, e := range data.Exposures {
+		got = append(got, exposure{
+			Type:       e.Type,
+			Job:        *e.Job.ID,
+			Secret:     e.Secret,
+			Receiver:   e.Receiver,
+			Offset:     e.File.Offset,
+			ThirdParty: e.ThirdParty,
+		})
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestPrintsToLogs(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		line string
+		want bool
+	}{
+		{line: `echo "$TOKEN"`, want: true},
+		{line: `Write-Host $env:TOKEN`, want: true},
+		{line: `if [ -n "$TOKEN" ]; then printf '%s' "$TOKEN"; fi`, want: true},
+		{line: `echo "$TOKEN" > token.txt`, want: false},
+		{line: `echo "$TOKEN" | docker login --password-stdin`, want: false},
+		{line: `echo "::add-mask::$TOKEN"`, want: false},
+		{line: `./deploy.sh --token "$TOKEN"`, want: false},
+	}
+	for _, tt := range tests {
+		if got := printsToLogs(tt.line); got != tt.want {
+			t.Errorf("printsToLogs(%q) = %v, want %v", tt.line, got, tt.want)
+		}
+	}
+}
@@ -41,6 +41,7 @@ func Test_SAST(t *testing.T) {
 		err           error
 		searchresult  clients.SearchResponse
 		checkRuns     []clients.CheckRun
+		statuses      []clients.Status
 		searchRequest clients.SearchRequest
 		path          string
 		expected      checker.CheckResult
@@ -151,6 +152,44 @@ func Test_SAST(t *testing.T) {
 				Score: 10,
 			},
 		},
+		{
+			name: "Successful SAST checker should return success status for Snyk Code",
+			commits: []clients.Commit{
+				{
+					AssociatedMergeRequest: clients.PullRequest{
+						MergedAt: time.Now().Add(time.Hour - 1),
+					},
+				},
+			},
+			statuses: []clients.Status{
+				{
+					State:   "success",
+					Context: "code/snyk (example)",
+				},
+			},
+			expected: checker.CheckResult{
+				Score: 10,
+			},
+		},
+		{
+			name: "Failed SAST checker should return failure status for failed GitLab SAST jobs",
+			commits: []clients.Commit{
+				{
+					AssociatedMergeRequest: clients.PullRequest{
+						MergedAt: time.Now().Add(time.Hour - 1),
+					},
+				},
+			},
+			statuses: []clients.Status{
+				{
+					State:   "failed",
+					Context: "semgrep-sast",
+				},
+			},
+			expected: checker.CheckResult{
+				Score: 0,
+			},
+		},
 		{
 			name: "Failed SAST checker should return success status",
 			commits: []clients.Commit{
@@ -299,6 +338,7 @@ func Test_SAST(t *testing.T) {
 				return tt.commits, tt.err
 			})
 			mockRepoClient.EXPECT().ListCheckRunsForRef("").Return(tt.checkRuns, nil).AnyTimes()
+			mockRepoClient.EXPECT().ListStatuses("").Return(tt.statuses, nil).AnyTimes()
 			mockRepoClient.EXPECT().Search(searchRequest).Return(tt.searchresult, nil).AnyTimes()
 			mockRepoClient.EXPECT().ListFiles(gomock.Any()).DoAndReturn(
 				func(predicate func(string) (bool, error)) ([]string, error) {
@@ -333,126 +373,3 @@ func Test_SAST(t *testing.T) {
 		})
 	}
 }
-
-func Test_validateSonarConfig(t *testing.T) {
-	t.Parallel()
-
-	//nolint: govet
-	tests := []struct {
-		name      string
-		path      string
-		offset    uint
-		endOffset uint
-		url       string
-		score     int
-	}{
-		{
-			name:      "sonartype config 1 line",
-			path:      "./testdata/pom-1line.xml",
-			offset:    2,
-			endOffset: 2,
-			url:       "https://sonarqube.private.domain",
-		},
-		{
-			name:      "sonartype config 2 lines",
-			path:      "./testdata/pom-2lines.xml",
-			offset:    2,
-			endOffset: 4,
-			url:       "https://sonarqube.private.domain",
-		},
-		{
-			name: "wrong filename",
-		},
-	}
-	for _, tt := range tests {
-		tt := tt
-
-		t.Run(tt.name, func(t *testing.T) {
-			t.Parallel()
-
-			var config []sonarConfig
-			var content []byte
-			var err error
-			var path string
-			if tt.path != "" {
-				content, err = os.ReadFile(tt.path)
-				if err != nil {
-					t.Errorf("ReadFile: %v", err)
-				}
-				path = "pom.xml"
-			}
-			_, err = validateSonarConfig(path, content, &config)
-			if err != nil {
-				t.Errorf("Caught error: %v", err)
-			}
-
-			if path == "" {
-				if len(config) != 0 {
-					t.Errorf("Expected no result, got %d for %v", len(config), tt.name)
-				}
-				return
-			}
-			if len(config) != 1 {
-				t.Errorf("Expected 1 result, got %d for %v", len(config), tt.name)
-			}
-
-			if config[0].file.Offset != tt.offset {
-				t.Errorf("Expected offset %d, got %d for %v", tt.offset,
-					config[0].file.Offset, tt.name)
-			}
-
-			if config[0].file.EndOffset != tt.endOffset {
-				t.Errorf("Expected offset %d, got %d for %v", tt.endOffset,
-					config[0].file.EndOffset, tt.name)
-			}
-
-			if config[0].url != tt.url {
-				t.Errorf("Expected offset %v, got %v for %v", tt.url,
-					config[0].url, tt.name)
-			}
-		})
-	}
-}
-
-func Test_searchGitHubActionWorkflowCodeQL_invalid(t *testing.T) {
-	t.Parallel()
-
-	//nolint: govet
-	tests := []struct {
-		name string
-		path string
-		args []any
-	}{
-		{
-			name: "too few arguments",
-			path: ".github/workflows/github-workflow-sast-codeql.yaml",
-			args: []any{},
-		},
-		{
-			name: "wrong arguments",
-			path: ".github/workflows/github-workflow-sast-codeql.yaml",
-			args: []any{
-				&[]int{},
-			},
-		},
-	}
-
-	for _, tt := range tests {
-		tt := tt
-		t.Run(tt.name, func(t *testing.T) {
-			t.Parallel()
-			var content []byte
-			var err error
-			if tt.path != "" {
-				content, err = os.ReadFile("./testdata/" + tt.path)
-				if err != nil {
-					t.Errorf("ReadFile: %v", err)
-				}
-			}
-			_, err = searchGitHubActionWorkflowCodeQL(tt.path, content, tt.args...)
-			if err == nil {
-				t.Errorf("Expected error but err was nil")
-			}
-		})
-	}
-}
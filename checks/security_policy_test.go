@@ -22,6 +22,7 @@ import (
 	"github.com/golang/mock/gomock"
 
 	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/clients"
 	mockrepo "github.com/ossf/scorecard/v4/clients/mockclients"
 	scut "github.com/ossf/scorecard/v4/utests"
 )
@@ -43,9 +44,10 @@ func TestSecurityPolicy(t *testing.T) {
 				"security.md",
 			},
 			want: scut.TestReturn{
-				Score:        10,
-				NumberOfInfo: 4,
-				NumberOfWarn: 0,
+				Score:         5,
+				NumberOfInfo:  6,
+				NumberOfWarn:  3,
+				NumberOfDebug: 1,
 			},
 		},
 		{
@@ -55,9 +57,10 @@ func TestSecurityPolicy(t *testing.T) {
 				".github/security.md",
 			},
 			want: scut.TestReturn{
-				Score:        10,
-				NumberOfInfo: 4,
-				NumberOfWarn: 0,
+				Score:         7,
+				NumberOfInfo:  7,
+				NumberOfWarn:  2,
+				NumberOfDebug: 1,
 			},
 		},
 		{
@@ -67,9 +70,10 @@ func TestSecurityPolicy(t *testing.T) {
 				"docs/security.md",
 			},
 			want: scut.TestReturn{
-				Score:        4,
-				NumberOfInfo: 3,
-				NumberOfWarn: 1,
+				Score:         0,
+				NumberOfInfo:  3,
+				NumberOfWarn:  6,
+				NumberOfDebug: 1,
 			},
 		},
 		{
@@ -79,9 +83,10 @@ func TestSecurityPolicy(t *testing.T) {
 				"security.rst",
 			},
 			want: scut.TestReturn{
-				Score:        3,
-				NumberOfInfo: 2,
-				NumberOfWarn: 2,
+				Score:         0,
+				NumberOfInfo:  2,
+				NumberOfWarn:  7,
+				NumberOfDebug: 1,
 			},
 		},
 		{
@@ -91,9 +96,10 @@ func TestSecurityPolicy(t *testing.T) {
 				".github/security.rst",
 			},
 			want: scut.TestReturn{
-				Score:        6,
-				NumberOfInfo: 2,
-				NumberOfWarn: 2,
+				Score:         0,
+				NumberOfInfo:  2,
+				NumberOfWarn:  7,
+				NumberOfDebug: 1,
 			},
 		},
 		{
@@ -103,9 +109,10 @@ func TestSecurityPolicy(t *testing.T) {
 				"docs/security.rst",
 			},
 			want: scut.TestReturn{
-				Score:        6,
-				NumberOfInfo: 2,
-				NumberOfWarn: 2,
+				Score:         3,
+				NumberOfInfo:  3,
+				NumberOfWarn:  6,
+				NumberOfDebug: 1,
 			},
 		},
 		{
@@ -115,9 +122,10 @@ func TestSecurityPolicy(t *testing.T) {
 				"doc/security.rst",
 			},
 			want: scut.TestReturn{
-				Score:        6,
-				NumberOfInfo: 2,
-				NumberOfWarn: 2,
+				Score:         3,
+				NumberOfInfo:  3,
+				NumberOfWarn:  6,
+				NumberOfDebug: 1,
 			},
 		},
 		{
@@ -127,9 +135,10 @@ func TestSecurityPolicy(t *testing.T) {
 				"security.adoc",
 			},
 			want: scut.TestReturn{
-				Score:        9,
-				NumberOfInfo: 3,
-				NumberOfWarn: 1,
+				Score:         3,
+				NumberOfInfo:  4,
+				NumberOfWarn:  5,
+				NumberOfDebug: 1,
 			},
 		},
 		{
@@ -139,9 +148,10 @@ func TestSecurityPolicy(t *testing.T) {
 				".github/security.adoc",
 			},
 			want: scut.TestReturn{
-				Score:        10,
-				NumberOfInfo: 4,
-				NumberOfWarn: 0,
+				Score:         3,
+				NumberOfInfo:  5,
+				NumberOfWarn:  4,
+				NumberOfDebug: 1,
 			},
 		},
 		{
@@ -151,9 +161,10 @@ func TestSecurityPolicy(t *testing.T) {
 				"docs/security.adoc",
 			},
 			want: scut.TestReturn{
-				Score:        0,
-				NumberOfInfo: 1,
-				NumberOfWarn: 3,
+				Score:         0,
+				NumberOfInfo:  1,
+				NumberOfWarn:  8,
+				NumberOfDebug: 1,
 			},
 		},
 		{
@@ -163,9 +174,23 @@ func TestSecurityPolicy(t *testing.T) {
 				"dOCs/SeCuRIty.rsT",
 			},
 			want: scut.TestReturn{
-				Score:        0,
-				NumberOfInfo: 1,
-				NumberOfWarn: 3,
+				Score:         0,
+				NumberOfInfo:  1,
+				NumberOfWarn:  8,
+				NumberOfDebug: 1,
+			},
+		},
+		{
+			name: "SECURITY.md meeting the rubric",
+			path: "./testdata/securitypolicy/10_rubric",
+			files: []string{
+				"SECURITY.md",
+			},
+			want: scut.TestReturn{
+				Score:         10,
+				NumberOfInfo:  9,
+				NumberOfWarn:  0,
+				NumberOfDebug: 1,
 			},
 		},
 	}
@@ -178,6 +203,7 @@ func TestSecurityPolicy(t *testing.T) {
 			mockRepo := mockrepo.NewMockRepoClient(ctrl)
 
 			mockRepo.EXPECT().ListFiles(gomock.Any()).Return(tt.files, nil).AnyTimes()
+			mockRepo.EXPECT().GetSecuritySettings().Return(clients.SecuritySettings{}, clients.ErrUnsupportedFeature).AnyTimes()
 
 			mockRepo.EXPECT().GetFileContent(gomock.Any()).DoAndReturn(func(fn string) ([]byte, error) {
 				if tt.path == "" {
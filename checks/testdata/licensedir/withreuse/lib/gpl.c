@@ -0,0 +1,3 @@
+/* SPDX-License-Identifier: GPL-3.0-or-later */
+
+int gpl(void) { return 0; }
@@ -53,8 +53,11 @@ func TestWebhooks(t *testing.T) {
 			err: nil,
 			webhooks: []clients.Webhook{
 				{
-					ID:             12345,
-					UsesAuthSecret: true,
+					ID:              12345,
+					Scheme:          "https",
+					SSLVerification: true,
+					Active:          true,
+					UsesAuthSecret:  true,
 				},
 			},
 		},
@@ -62,13 +65,16 @@ func TestWebhooks(t *testing.T) {
 			name: "With Webhooks and no secret set",
 			uri:  "github.com/owner/repo",
 			expected: checker.CheckResult{
-				Score: 0,
+				Score: 7,
 			},
 			err: nil,
 			webhooks: []clients.Webhook{
 				{
-					ID:             12345,
-					UsesAuthSecret: false,
+					ID:              12345,
+					Scheme:          "https",
+					SSLVerification: true,
+					Active:          true,
+					UsesAuthSecret:  false,
 				},
 			},
 		},
@@ -76,17 +82,23 @@ func TestWebhooks(t *testing.T) {
 			name: "With 2 Webhooks with and whitout secrets configured",
 			uri:  "github.com/owner/repo",
 			expected: checker.CheckResult{
-				Score: 5,
+				Score: 8,
 			},
 			err: nil,
 			webhooks: []clients.Webhook{
 				{
-					ID:             12345,
-					UsesAuthSecret: false,
+					ID:              12345,
+					Scheme:          "https",
+					SSLVerification: true,
+					Active:          true,
+					UsesAuthSecret:  false,
 				},
 				{
-					ID:             54321,
-					UsesAuthSecret: true,
+					ID:              54321,
+					Scheme:          "https",
+					SSLVerification: true,
+					Active:          true,
+					UsesAuthSecret:  true,
 				},
 			},
 		},
@@ -19,6 +19,29 @@ type BranchRef struct {
 	Name                 *string
 	Protected            *bool
 	BranchProtectionRule BranchProtectionRule
+	// RuleSources lists the rules applying to the branch, including the ones that are not enforced.
+	RuleSources []RuleSource
+}
+
+// RuleSourceType is the kind of rule a branch protection setting comes from.
+type RuleSourceType string
+
+const (
+	// RuleSourceBranchProtection is a classic branch protection rule.
+	RuleSourceBranchProtection RuleSourceType = "branchProtectionRule"
+	// RuleSourceRepositoryRuleset is a ruleset configured on the repository.
+	RuleSourceRepositoryRuleset RuleSourceType = "repositoryRuleset"
+	// RuleSourceOrganizationRuleset is a ruleset configured on the organization of the repository.
+	RuleSourceOrganizationRuleset RuleSourceType = "organizationRuleset"
+)
+
+// RuleSource describes a rule applying to a branch.
+type RuleSource struct {
+	Name         *string
+	Type         RuleSourceType
+	BypassActors []string
+	// Enforced is false for rules which are only evaluated, e.g., rulesets in "evaluate" mode.
+	Enforced bool
 }
 
 // BranchProtectionRule captures the settings enabled on a branch for security.
@@ -19,7 +19,10 @@ type CheckRun struct {
 	Status     string
 	Conclusion string
 	URL        string
-	App        CheckRunApp
+	// Name is the name of the check run, e.g. the name of the CI job.
+	// It may be empty if the VCS only reports the app running the check.
+	Name string
+	App  CheckRunApp
 }
 
 // CheckRunApp is the app running the Check.
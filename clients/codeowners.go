@@ -0,0 +1,30 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clients
+
+// CodeOwnerAccess is the access of a code owner to a repository.
+type CodeOwnerAccess string
+
+const (
+	// CodeOwnerAccessUnknown means the access could not be determined, e.g., for owners
+	// identified by email, or tokens which cannot read the members of the organization.
+	CodeOwnerAccessUnknown CodeOwnerAccess = "unknown"
+	// CodeOwnerAccessNotFound means the user or team does not exist.
+	CodeOwnerAccessNotFound CodeOwnerAccess = "notFound"
+	// CodeOwnerAccessRead means the owner exists, but cannot write to the repository.
+	CodeOwnerAccessRead CodeOwnerAccess = "read"
+	// CodeOwnerAccessWrite means the owner can write to the repository.
+	CodeOwnerAccessWrite CodeOwnerAccess = "write"
+)
@@ -23,4 +23,44 @@ type Commit struct {
 	SHA                    string
 	AssociatedMergeRequest PullRequest
 	Committer              User
+	// Author is the author recorded in the commit.
+	Author CommitAuthor
+	// Signature is nil if the commit is not signed.
+	Signature *Signature
+}
+
+// CommitAuthor represents the author recorded in a commit.
+type CommitAuthor struct {
+	Name  string
+	Email string
+	// Login is the account the forge linked the email to, if any.
+	// Forges only link the emails their users have verified.
+	Login string
+}
+
+// SignatureType is the format of a commit or tag signature.
+type SignatureType string
+
+const (
+	// SignatureTypeGPG is an OpenPGP signature.
+	SignatureTypeGPG SignatureType = "gpg"
+	// SignatureTypeSSH is an SSH signature.
+	SignatureTypeSSH SignatureType = "ssh"
+	// SignatureTypeX509 is an S/MIME signature, e.g., created by gitsign.
+	SignatureTypeX509 SignatureType = "x509"
+	// SignatureTypeUnknown is a signature in an unrecognized format.
+	SignatureTypeUnknown SignatureType = "unknown"
+)
+
+// Signature represents the signature of a commit or tag.
+type Signature struct {
+	Type SignatureType
+	// Signer identifies the key or user which created the signature, if known.
+	Signer string
+	// Reason explains why the signature could not be verified.
+	Reason   string
+	Verified bool
+	// SignedByForge is true if the forge signed the commit on behalf of the user,
+	// e.g., for commits created in the web interface.
+	SignedByForge bool
 }
@@ -25,6 +25,7 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/ProtonMail/go-crypto/openpgp"
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
 	cp "github.com/otiai10/copy"
@@ -39,16 +40,50 @@ var (
 	errEmptyQuery     = errors.New("query is empty")
 )
 
+// Headers of the signature formats supported by git.
+var (
+	gpgSignatureHeaders  = []string{"-----BEGIN PGP SIGNATURE-----", "-----BEGIN PGP MESSAGE-----"}
+	sshSignatureHeaders  = []string{"-----BEGIN SSH SIGNATURE-----"}
+	x509SignatureHeaders = []string{"-----BEGIN SIGNED MESSAGE-----", "-----BEGIN CERTIFICATE-----"}
+)
+
 type Client struct {
 	gitRepo        *git.Repository
 	worktree       *git.Worktree
 	listCommits    *sync.Once
 	tempDir        string
+	keyring        string
 	errListCommits error
 	commits        []clients.Commit
 	commitDepth    int
 }
 
+// SetKeyring sets the armored OpenPGP keyring used to verify the signatures of commits and tags.
+func (c *Client) SetKeyring(armoredKeyRing string) {
+	c.keyring = armoredKeyRing
+}
+
+// OpenRepo opens the repository containing path in place, without cloning it.
+func (c *Client) OpenRepo(path string, commitDepth int) error {
+	// cleanup previous state, if any.
+	c.Close()
+	c.listCommits = new(sync.Once)
+	c.commits = nil
+	c.tempDir = ""
+
+	c.commitDepth = commitDepth
+	var err error
+	c.gitRepo, err = git.PlainOpenWithOptions(path, &git.PlainOpenOptions{DetectDotGit: true})
+	if err != nil {
+		return fmt.Errorf("git.PlainOpen: %w", err)
+	}
+	c.worktree, err = c.gitRepo.Worktree()
+	if err != nil {
+		return fmt.Errorf("git.Worktree: %w", err)
+	}
+	return nil
+}
+
 func (c *Client) InitRepo(uri, commitSHA string, commitDepth int) error {
 	// cleanup previous state, if any.
 	c.Close()
@@ -134,12 +169,100 @@ func (c *Client) ListCommits() ([]clients.Commit, error) {
 				Committer: clients.User{
 					Login: commit.Committer.Email,
 				},
+				Author: clients.CommitAuthor{
+					Name:  commit.Author.Name,
+					Email: commit.Author.Email,
+				},
+				Signature: c.verifySignature(commit.PGPSignature, commit.Verify),
 			})
 		}
 	})
 	return c.commits, c.errListCommits
 }
 
+// ListTags returns the tags of the repository.
+func (c *Client) ListTags() ([]clients.Tag, error) {
+	refs, err := c.gitRepo.Tags()
+	if err != nil {
+		return nil, fmt.Errorf("git.Tags: %w", err)
+	}
+	var tags []clients.Tag
+	err = refs.ForEach(func(ref *plumbing.Reference) error {
+		tag := clients.Tag{
+			Name:      ref.Name().Short(),
+			CommitSHA: ref.Hash().String(),
+		}
+		tagObject, err := c.gitRepo.TagObject(ref.Hash())
+		switch {
+		case errors.Is(err, plumbing.ErrObjectNotFound):
+			// Lightweight tag.
+		case err != nil:
+			return fmt.Errorf("git.TagObject: %w", err)
+		default:
+			tag.CommitSHA = tagObject.Target.String()
+			signature := tagObject.PGPSignature
+			// Signatures created by gitsign are not split from the message.
+			if i := indexOfAny(tagObject.Message, x509SignatureHeaders); signature == "" && i >= 0 {
+				signature = tagObject.Message[i:]
+			}
+			tag.Signature = c.verifySignature(signature, tagObject.Verify)
+		}
+		tags = append(tags, tag)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("refs.ForEach: %w", err)
+	}
+	return tags, nil
+}
+
+// verifySignature returns the signature of a commit or tag, or nil if it is not signed.
+// Only OpenPGP signatures can be verified, against the keyring of the client.
+func (c *Client) verifySignature(signature string, verify func(string) (*openpgp.Entity, error)) *clients.Signature {
+	signature = strings.TrimSpace(signature)
+	if signature == "" {
+		return nil
+	}
+	ret := &clients.Signature{}
+	switch {
+	case indexOfAny(signature, gpgSignatureHeaders) == 0:
+		ret.Type = clients.SignatureTypeGPG
+	case indexOfAny(signature, sshSignatureHeaders) == 0:
+		ret.Type = clients.SignatureTypeSSH
+	case indexOfAny(signature, x509SignatureHeaders) == 0:
+		ret.Type = clients.SignatureTypeX509
+	default:
+		ret.Type = clients.SignatureTypeUnknown
+	}
+
+	switch {
+	case ret.Type != clients.SignatureTypeGPG:
+		ret.Reason = fmt.Sprintf("verification of %s signatures is not supported", ret.Type)
+	case c.keyring == "":
+		ret.Reason = "no keyring to verify the signature"
+	default:
+		entity, err := verify(c.keyring)
+		if err != nil {
+			ret.Reason = err.Error()
+			break
+		}
+		ret.Verified = true
+		if entity != nil && entity.PrimaryKey != nil {
+			ret.Signer = entity.PrimaryKey.KeyIdString()
+		}
+	}
+	return ret
+}
+
+func indexOfAny(s string, substrs []string) int {
+	for _, substr := range substrs {
+		if i := strings.Index(s, substr); i >= 0 {
+			return i
+		}
+	}
+	return -1
+}
+
 func (c *Client) Search(request clients.SearchRequest) (clients.SearchResponse, error) {
 	// Pattern
 	if request.Query == "" {
@@ -15,12 +15,15 @@
 package git
 
 import (
+	"bytes"
 	"fmt"
 	"os"
 	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
 	gitV5 "github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/google/go-cmp/cmp"
@@ -233,3 +236,168 @@ func TestSearch(t *testing.T) {
 		})
 	}
 }
+
+func armoredPublicKey(t *testing.T, entity *openpgp.Entity) string {
+	t.Helper()
+	var buf bytes.Buffer
+	w, err := armor.Encode(&buf, openpgp.PublicKeyType, nil)
+	if err != nil {
+		t.Fatalf("armor.Encode() failed: %v", err)
+	}
+	if err := entity.Serialize(w); err != nil {
+		t.Fatalf("Serialize() failed: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close() failed: %v", err)
+	}
+	return buf.String()
+}
+
+//nolint:paralleltest
+func TestSignatures(t *testing.T) {
+	signer, err := openpgp.NewEntity("Test Author", "", "author@example.com", nil)
+	if err != nil {
+		t.Fatalf("NewEntity() failed: %v", err)
+	}
+	other, err := openpgp.NewEntity("Other Author", "", "other@example.com", nil)
+	if err != nil {
+		t.Fatalf("NewEntity() failed: %v", err)
+	}
+
+	// Make a signed commit, and tag it with lightweight, annotated and signed tags.
+	repoPath := createTestRepo(t)
+	r, err := gitV5.PlainOpen(repoPath)
+	if err != nil {
+		t.Fatalf("PlainOpen() failed: %v", err)
+	}
+	w, err := r.Worktree()
+	if err != nil {
+		t.Fatalf("Worktree() failed: %v", err)
+	}
+	author := &object.Signature{
+		Name:  "Test Author",
+		Email: "author@example.com",
+		When:  time.Now(),
+	}
+	head, err := w.Commit("Signed commit", &gitV5.CommitOptions{
+		Author:            author,
+		SignKey:           signer,
+		AllowEmptyCommits: true,
+	})
+	if err != nil {
+		t.Fatalf("Commit() failed: %v", err)
+	}
+	if _, err := r.CreateTag("lightweight", head, nil); err != nil {
+		t.Fatalf("CreateTag() failed: %v", err)
+	}
+	if _, err := r.CreateTag("annotated", head, &gitV5.CreateTagOptions{
+		Tagger:  author,
+		Message: "annotated",
+	}); err != nil {
+		t.Fatalf("CreateTag() failed: %v", err)
+	}
+	if _, err := r.CreateTag("signed", head, &gitV5.CreateTagOptions{
+		Tagger:  author,
+		Message: "signed",
+		SignKey: signer,
+	}); err != nil {
+		t.Fatalf("CreateTag() failed: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		keyring     string
+		wantCommits []*clients.Signature
+		wantTags    []clients.Tag
+	}{
+		{
+			name:    "signer in keyring",
+			keyring: armoredPublicKey(t, signer),
+			wantCommits: []*clients.Signature{
+				{Type: clients.SignatureTypeGPG, Signer: signer.PrimaryKey.KeyIdString(), Verified: true},
+				nil,
+			},
+			wantTags: []clients.Tag{
+				{Name: "annotated", CommitSHA: head.String()},
+				{Name: "lightweight", CommitSHA: head.String()},
+				{
+					Name:      "signed",
+					CommitSHA: head.String(),
+					Signature: &clients.Signature{
+						Type:     clients.SignatureTypeGPG,
+						Signer:   signer.PrimaryKey.KeyIdString(),
+						Verified: true,
+					},
+				},
+			},
+		},
+		{
+			name:    "signer not in keyring",
+			keyring: armoredPublicKey(t, other),
+			wantCommits: []*clients.Signature{
+				{Type: clients.SignatureTypeGPG, Reason: "openpgp: signature made by unknown entity"},
+				nil,
+			},
+			wantTags: []clients.Tag{
+				{Name: "annotated", CommitSHA: head.String()},
+				{Name: "lightweight", CommitSHA: head.String()},
+				{
+					Name:      "signed",
+					CommitSHA: head.String(),
+					Signature: &clients.Signature{
+						Type:   clients.SignatureTypeGPG,
+						Reason: "openpgp: signature made by unknown entity",
+					},
+				},
+			},
+		},
+		{
+			name: "no keyring",
+			wantCommits: []*clients.Signature{
+				{Type: clients.SignatureTypeGPG, Reason: "no keyring to verify the signature"},
+				nil,
+			},
+			wantTags: []clients.Tag{
+				{Name: "annotated", CommitSHA: head.String()},
+				{Name: "lightweight", CommitSHA: head.String()},
+				{
+					Name:      "signed",
+					CommitSHA: head.String(),
+					Signature: &clients.Signature{
+						Type:   clients.SignatureTypeGPG,
+						Reason: "no keyring to verify the signature",
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &Client{}
+			if err := client.OpenRepo(repoPath, 2); err != nil {
+				t.Fatalf("OpenRepo(%s) failed: %v", repoPath, err)
+			}
+			client.SetKeyring(tt.keyring)
+
+			commits, err := client.ListCommits()
+			if err != nil {
+				t.Fatalf("ListCommits() failed: %v", err)
+			}
+			var gotCommits []*clients.Signature
+			for _, c := range commits {
+				gotCommits = append(gotCommits, c.Signature)
+			}
+			if diff := cmp.Diff(tt.wantCommits, gotCommits); diff != "" {
+				t.Errorf("ListCommits() returned diff (-want +got):\n%s", diff)
+			}
+
+			tags, err := client.ListTags()
+			if err != nil {
+				t.Fatalf("ListTags() failed: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantTags, tags); diff != "" {
+				t.Errorf("ListTags() returned diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
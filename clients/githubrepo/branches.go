@@ -62,12 +62,15 @@ query {
         }
       }
     }
-    rulesets(first: 100) {
+    rulesets(first: 100, includeParents: true) {
       edges {
         node {
           name
           enforcement
           target
+          source {
+            __typename
+          }
           conditions {
             refName {
               exclude
@@ -82,6 +85,9 @@ query {
                   name
                   databaseId
                 }
+                ... on Team {
+                  slug
+                }
               }
               bypassMode
               organizationAdmin
@@ -118,6 +124,7 @@ query {
 
 // Used for non-admin settings.
 type refUpdateRule struct {
+	Pattern                      *string
 	AllowsDeletions              *bool
 	AllowsForcePushes            *bool
 	RequiredApprovingReviewCount *int32
@@ -129,6 +136,7 @@ type refUpdateRule struct {
 // Used for all settings, both admin and non-admin ones.
 // This only works with an admin token.
 type branchProtectionRule struct {
+	Pattern                      *string
 	DismissesStaleReviews        *bool
 	IsAdminEnforced              *bool
 	RequiresStrictStatusChecks   *bool
@@ -188,14 +196,29 @@ type ruleSetConditionRefs struct {
 type ruleSetCondition struct {
 	RefName ruleSetConditionRefs
 }
+type ruleSetBypassActor struct {
+	Typename *string `graphql:"__typename"`
+	App      struct {
+		Name *string
+	} `graphql:"... on App"`
+	Team struct {
+		Slug *string
+	} `graphql:"... on Team"`
+}
 type ruleSetBypass struct {
 	BypassMode         *string
 	OrganizationAdmin  *bool
 	RepositoryRoleName *string
+	Actor              ruleSetBypassActor
+}
+type ruleSetSource struct {
+	Typename *string `graphql:"__typename"`
 }
 type repoRuleSet struct {
 	Name         *string
 	Enforcement  *string
+	Target       *string
+	Source       ruleSetSource
 	Conditions   ruleSetCondition
 	BypassActors struct {
 		Nodes []*ruleSetBypass
@@ -211,7 +234,7 @@ type ruleSetData struct {
 		}
 		Rulesets struct {
 			Nodes []*repoRuleSet
-		} `graphql:"rulesets(first: 100)"`
+		} `graphql:"rulesets(first: 100, includeParents: true)"`
 	} `graphql:"repository(owner: $owner, name: $name)"`
 }
 
@@ -256,20 +279,21 @@ func (handler *branchesHandler) setup() error {
 			"name":  githubv4.String(handler.repourl.repo),
 		}
 
-		// Fetch default branch name and any repository rulesets, which are available with basic read permission.
+		// Fetch default branch name and the repository and organization rulesets,
+		// which are available with basic read permission.
 		rulesData := new(ruleSetData)
 		if err := handler.graphClient.Query(handler.ctx, rulesData, vars); err != nil {
 			handler.errSetup = sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("githubv4.Query: %v", err))
 			return
 		}
 		handler.defaultBranchName = getDefaultBranchNameFrom(rulesData)
-		handler.ruleSets = getActiveRuleSetsFrom(rulesData)
+		handler.ruleSets = getBranchRuleSetsFrom(rulesData)
 
 		// Attempt to fetch branch protection rules, which require admin permission.
 		// Ignore permissions errors if we know the repository is using rulesets, so non-admins can still get a score.
 		handler.data = new(defaultBranchData)
 		if err := handler.graphClient.Query(handler.ctx, handler.data, vars); err != nil &&
-			(!isPermissionsError(err) || len(handler.ruleSets) == 0) {
+			(!isPermissionsError(err) || len(enforcedRuleSets(handler.ruleSets)) == 0) {
 			handler.errSetup = sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("githubv4.Query: %v", err))
 			return
 		}
@@ -370,10 +394,17 @@ func getDefaultBranchNameFrom(data *ruleSetData) string {
 	return *data.Repository.DefaultBranchRef.Name
 }
 
-func getActiveRuleSetsFrom(data *ruleSetData) []*repoRuleSet {
+// getBranchRuleSetsFrom returns the rulesets targeting branches, which are either
+// enforced or evaluated. Evaluated rulesets are only reported, as they do not block anything.
+func getBranchRuleSetsFrom(data *ruleSetData) []*repoRuleSet {
 	ret := make([]*repoRuleSet, 0)
 	for _, rule := range data.Repository.Rulesets.Nodes {
-		if rule.Enforcement == nil || *rule.Enforcement != "ACTIVE" {
+		if rule.Enforcement == nil ||
+			(*rule.Enforcement != ruleEnforcementActive && *rule.Enforcement != ruleEnforcementEvaluate) {
+			continue
+		}
+		// Tag and push rulesets do not protect branches.
+		if rule.Target != nil && *rule.Target != ruleTargetBranch {
 			continue
 		}
 		ret = append(ret, rule)
@@ -381,6 +412,68 @@ func getActiveRuleSetsFrom(data *ruleSetData) []*repoRuleSet {
 	return ret
 }
 
+func enforcedRuleSets(rules []*repoRuleSet) []*repoRuleSet {
+	ret := make([]*repoRuleSet, 0)
+	for _, rule := range rules {
+		if rule.Enforcement != nil && *rule.Enforcement == ruleEnforcementActive {
+			ret = append(ret, rule)
+		}
+	}
+	return ret
+}
+
+func getRuleSourcesFrom(data *branch, rules []*repoRuleSet) []clients.RuleSource {
+	var sources []clients.RuleSource
+	switch {
+	case data.BranchProtectionRule != nil:
+		sources = append(sources, clients.RuleSource{
+			Name:     data.BranchProtectionRule.Pattern,
+			Type:     clients.RuleSourceBranchProtection,
+			Enforced: true,
+		})
+	case data.RefUpdateRule != nil:
+		sources = append(sources, clients.RuleSource{
+			Name:     data.RefUpdateRule.Pattern,
+			Type:     clients.RuleSourceBranchProtection,
+			Enforced: true,
+		})
+	}
+	for _, rule := range rules {
+		source := clients.RuleSource{
+			Name:     rule.Name,
+			Type:     clients.RuleSourceRepositoryRuleset,
+			Enforced: rule.Enforcement != nil && *rule.Enforcement == ruleEnforcementActive,
+		}
+		if readStringPtr(rule.Source.Typename) == "Organization" {
+			source.Type = clients.RuleSourceOrganizationRuleset
+		}
+		for _, bypass := range rule.BypassActors.Nodes {
+			source.BypassActors = append(source.BypassActors, bypass.actorName())
+		}
+		sources = append(sources, source)
+	}
+	return sources
+}
+
+// actorName returns a description of the actor allowed to bypass a ruleset,
+// e.g., `OrganizationAdmin`, `RepositoryRole:maintain` or `App:dependabot`.
+func (b *ruleSetBypass) actorName() string {
+	switch {
+	case readBoolPtr(b.OrganizationAdmin):
+		return "OrganizationAdmin"
+	case b.RepositoryRoleName != nil:
+		return "RepositoryRole:" + *b.RepositoryRoleName
+	case b.Actor.App.Name != nil:
+		return "App:" + *b.Actor.App.Name
+	case b.Actor.Team.Slug != nil:
+		return "Team:" + *b.Actor.Team.Slug
+	case b.Actor.Typename != nil:
+		return *b.Actor.Typename
+	default:
+		return "Unknown"
+	}
+}
+
 func getBranchRefFrom(data *branch, rules []*repoRuleSet) *clients.BranchRef {
 	if data == nil {
 		return nil
@@ -389,6 +482,9 @@ func getBranchRefFrom(data *branch, rules []*repoRuleSet) *clients.BranchRef {
 	if data.Name != nil {
 		branchRef.Name = data.Name
 	}
+	branchRef.RuleSources = getRuleSourcesFrom(data, rules)
+	// Rulesets in "evaluate" mode are reported, but do not protect the branch.
+	rules = enforcedRuleSets(rules)
 
 	// Protected means we found some data,
 	// i.e., there's a rule for the branch.
@@ -434,6 +530,9 @@ func isPermissionsError(err error) bool {
 }
 
 const (
+	ruleEnforcementActive      = "ACTIVE"
+	ruleEnforcementEvaluate    = "EVALUATE"
+	ruleTargetBranch           = "BRANCH"
 	ruleConditionDefaultBranch = "~DEFAULT_BRANCH"
 	ruleConditionAllBranches   = "~ALL"
 	ruleDeletion               = "DELETION"
@@ -593,6 +692,13 @@ func readBoolPtr(b *bool) bool {
 	return *b
 }
 
+func readStringPtr(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
 func readIntPtr(i *int32) int32 {
 	if i == nil {
 		return 0
@@ -357,3 +357,111 @@ func Test_applyRepoRules(t *testing.T) {
 func stringPtr(s string) *string {
 	return &s
 }
+
+func Test_getBranchRuleSetsFrom(t *testing.T) {
+	t.Parallel()
+	active := ruleEnforcementActive
+	evaluate := ruleEnforcementEvaluate
+	disabled := "DISABLED"
+	branchTarget := ruleTargetBranch
+	tagTarget := "TAG"
+
+	data := new(ruleSetData)
+	data.Repository.Rulesets.Nodes = []*repoRuleSet{
+		{Name: stringPtr("active"), Enforcement: &active, Target: &branchTarget},
+		{Name: stringPtr("evaluate"), Enforcement: &evaluate, Target: &branchTarget},
+		{Name: stringPtr("disabled"), Enforcement: &disabled, Target: &branchTarget},
+		{Name: stringPtr("tags"), Enforcement: &active, Target: &tagTarget},
+	}
+
+	var got []string
+	for _, rule := range getBranchRuleSetsFrom(data) {
+		got = append(got, *rule.Name)
+	}
+	if diff := cmp.Diff([]string{"active", "evaluate"}, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_getBranchRefFrom(t *testing.T) {
+	t.Parallel()
+	trueVal := true
+	falseVal := false
+	active := ruleEnforcementActive
+	evaluate := ruleEnforcementEvaluate
+	organization := "Organization"
+
+	orgRuleSet := ruleSet(withRules(&repoRule{Type: ruleDeletion}), withBypass())
+	orgRuleSet.Name = stringPtr("org")
+	orgRuleSet.Enforcement = &active
+	orgRuleSet.Source.Typename = &organization
+	orgRuleSet.BypassActors.Nodes[0].OrganizationAdmin = &trueVal
+
+	evaluatedRuleSet := ruleSet(withRules(&repoRule{Type: ruleForcePush}))
+	evaluatedRuleSet.Name = stringPtr("evaluated")
+	evaluatedRuleSet.Enforcement = &evaluate
+
+	testcases := []struct {
+		data     *branch
+		expected *clients.BranchRef
+		name     string
+		rules    []*repoRuleSet
+	}{
+		{
+			name:  "evaluated ruleset does not protect the branch",
+			data:  &branch{Name: stringPtr("main")},
+			rules: []*repoRuleSet{evaluatedRuleSet},
+			expected: &clients.BranchRef{
+				Name:      stringPtr("main"),
+				Protected: &falseVal,
+				RuleSources: []clients.RuleSource{
+					{Name: stringPtr("evaluated"), Type: clients.RuleSourceRepositoryRuleset},
+				},
+			},
+		},
+		{
+			name: "organization ruleset merged with branch protection rule",
+			data: &branch{
+				Name: stringPtr("main"),
+				RefUpdateRule: &refUpdateRule{
+					Pattern:           stringPtr("main"),
+					AllowsForcePushes: &falseVal,
+				},
+			},
+			rules: []*repoRuleSet{orgRuleSet, evaluatedRuleSet},
+			expected: &clients.BranchRef{
+				Name:      stringPtr("main"),
+				Protected: &trueVal,
+				BranchProtectionRule: clients.BranchProtectionRule{
+					AllowDeletions:   &falseVal,
+					AllowForcePushes: &falseVal,
+					EnforceAdmins:    &falseVal,
+					CheckRules: clients.StatusChecksRule{
+						Contexts: []string{},
+					},
+				},
+				RuleSources: []clients.RuleSource{
+					{Name: stringPtr("main"), Type: clients.RuleSourceBranchProtection, Enforced: true},
+					{
+						Name:         stringPtr("org"),
+						Type:         clients.RuleSourceOrganizationRuleset,
+						BypassActors: []string{"OrganizationAdmin"},
+						Enforced:     true,
+					},
+					{Name: stringPtr("evaluated"), Type: clients.RuleSourceRepositoryRuleset},
+				},
+			},
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+		t.Run(testcase.name, func(t *testing.T) {
+			t.Parallel()
+			got := getBranchRefFrom(testcase.data, testcase.rules)
+			if diff := cmp.Diff(testcase.expected, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -174,6 +174,7 @@ func checkRunsFrom(data *github.ListCheckRunsResults) []clients.CheckRun {
 			Status:     checkRun.GetStatus(),
 			Conclusion: checkRun.GetConclusion(),
 			URL:        checkRun.GetURL(),
+			Name:       checkRun.GetName(),
 			App: clients.CheckRunApp{
 				Slug: checkRun.GetApp().GetSlug(),
 			},
@@ -54,8 +54,11 @@ type Client struct {
 	search        *searchHandler
 	searchCommits *searchCommitsHandler
 	webhook       *webhookHandler
+	security      *securitySettingsHandler
+	codeowners    *codeownersHandler
 	languages     *languagesHandler
 	licenses      *licensesHandler
+	tags          *tagsHandler
 	ctx           context.Context
 	tarball       tarballHandler
 	commitDepth   int
@@ -120,11 +123,20 @@ func (client *Client) InitRepo(inputRepo clients.Repo, commitSHA string, commitD
 	// Setup webhookHandler.
 	client.webhook.init(client.ctx, client.repourl)
 
+	// Setup securitySettingsHandler.
+	client.security.init(client.ctx, client.repourl)
+
+	// Setup codeownersHandler.
+	client.codeowners.init(client.ctx, client.repourl)
+
 	// Setup languagesHandler.
 	client.languages.init(client.ctx, client.repourl)
 
 	// Setup licensesHandler.
 	client.licenses.init(client.ctx, client.repourl)
+
+	// Setup tagsHandler.
+	client.tags.init(client.ctx, client.repourl)
 	return nil
 }
 
@@ -163,6 +175,11 @@ func (client *Client) ListIssues() ([]clients.Issue, error) {
 	return client.graphClient.getIssues()
 }
 
+// ListPullRequests implements RepoClient.ListPullRequests.
+func (client *Client) ListPullRequests() ([]clients.PullRequest, error) {
+	return client.graphClient.getPullRequests()
+}
+
 // ListReleases implements RepoClient.ListReleases.
 func (client *Client) ListReleases() ([]clients.Release, error) {
 	return client.releases.getReleases()
@@ -203,25 +220,55 @@ func (client *Client) GetCreatedAt() (time.Time, error) {
 }
 
 func (client *Client) GetOrgRepoClient(ctx context.Context) (clients.RepoClient, error) {
-	dotGithubRepo, err := MakeGithubRepo(fmt.Sprintf("%s/.github", client.repourl.owner))
+	return client.GetRepoClient(ctx, fmt.Sprintf("%s/.github", client.repourl.owner))
+}
+
+// GetRepoClient implements RepoClient.GetRepoClient.
+func (client *Client) GetRepoClient(ctx context.Context, repo string) (clients.RepoClient, error) {
+	githubRepo, err := MakeGithubRepo(repo)
 	if err != nil {
 		return nil, fmt.Errorf("error during MakeGithubRepo: %w", err)
 	}
 
 	logger := log.NewLogger(log.InfoLevel)
 	c := CreateGithubRepoClient(ctx, logger)
-	if err := c.InitRepo(dotGithubRepo, clients.HeadSHA, 0); err != nil {
+	if err := c.InitRepo(githubRepo, clients.HeadSHA, 0); err != nil {
 		return nil, fmt.Errorf("error during InitRepo: %w", err)
 	}
 
 	return c, nil
 }
 
+// ListTags implements RepoClient.ListTags.
+func (client *Client) ListTags() ([]clients.Tag, error) {
+	return client.tags.listTags()
+}
+
+// IsCommitReachable implements RepoClient.IsCommitReachable.
+func (client *Client) IsCommitReachable(commitSHA string) (bool, error) {
+	return client.tags.isCommitReachable(commitSHA)
+}
+
 // ListWebhooks implements RepoClient.ListWebhooks.
 func (client *Client) ListWebhooks() ([]clients.Webhook, error) {
 	return client.webhook.listWebhooks()
 }
 
+// GetSecuritySettings implements RepoClient.GetSecuritySettings.
+func (client *Client) GetSecuritySettings() (clients.SecuritySettings, error) {
+	return client.security.getSecuritySettings()
+}
+
+// GetWorkflowPermissions implements RepoClient.GetWorkflowPermissions.
+func (client *Client) GetWorkflowPermissions() (clients.WorkflowPermissions, error) {
+	return client.security.getWorkflowPermissions()
+}
+
+// GetCodeOwnerAccess implements RepoClient.GetCodeOwnerAccess.
+func (client *Client) GetCodeOwnerAccess(owner string) (clients.CodeOwnerAccess, error) {
+	return client.codeowners.getCodeOwnerAccess(owner)
+}
+
 // ListSuccessfulWorkflowRuns implements RepoClient.WorkflowRunsByFilename.
 func (client *Client) ListSuccessfulWorkflowRuns(filename string) ([]clients.WorkflowRun, error) {
 	return client.workflows.listSuccessfulWorkflowRuns(filename)
@@ -323,12 +370,22 @@ func CreateGithubRepoClientWithTransport(ctx context.Context, rt http.RoundTripp
 		webhook: &webhookHandler{
 			ghClient: client,
 		},
+		security: &securitySettingsHandler{
+			ghClient: client,
+		},
+		codeowners: &codeownersHandler{
+			ghClient: client,
+		},
 		languages: &languagesHandler{
 			ghclient: client,
 		},
 		licenses: &licensesHandler{
 			ghclient: client,
 		},
+		tags: &tagsHandler{
+			client:      client,
+			graphClient: graphClient,
+		},
 		tarball: tarballHandler{
 			httpClient: httpClient,
 		},
@@ -0,0 +1,139 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githubrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/google/go-github/v53/github"
+
+	"github.com/ossf/scorecard/v4/clients"
+)
+
+type codeownersHandler struct {
+	ghClient *github.Client
+	ctx      context.Context
+	repourl  *repoURL
+	mu       sync.Mutex
+	access   map[string]clients.CodeOwnerAccess
+}
+
+func (handler *codeownersHandler) init(ctx context.Context, repourl *repoURL) {
+	handler.ctx = ctx
+	handler.repourl = repourl
+	handler.access = make(map[string]clients.CodeOwnerAccess)
+}
+
+// getCodeOwnerAccess returns the access of a user (`@user`) or a team (`@org/team`).
+// Owners identified by email cannot be resolved by the API.
+func (handler *codeownersHandler) getCodeOwnerAccess(owner string) (clients.CodeOwnerAccess, error) {
+	if !strings.HasPrefix(owner, "@") {
+		return clients.CodeOwnerAccessUnknown, nil
+	}
+	key := strings.ToLower(owner)
+
+	handler.mu.Lock()
+	defer handler.mu.Unlock()
+	if access, ok := handler.access[key]; ok {
+		return access, nil
+	}
+
+	var access clients.CodeOwnerAccess
+	var err error
+	if org, slug, isTeam := strings.Cut(owner[1:], "/"); isTeam {
+		access, err = handler.getTeamAccess(org, slug)
+	} else {
+		access, err = handler.getUserAccess(owner[1:])
+	}
+	if err != nil {
+		return clients.CodeOwnerAccessUnknown, err
+	}
+	handler.access[key] = access
+	return access, nil
+}
+
+func (handler *codeownersHandler) getUserAccess(login string) (clients.CodeOwnerAccess, error) {
+	_, _, err := handler.ghClient.Users.Get(handler.ctx, login)
+	switch statusCode(err) {
+	case 0:
+	case http.StatusNotFound:
+		return clients.CodeOwnerAccessNotFound, nil
+	default:
+		return clients.CodeOwnerAccessUnknown, fmt.Errorf("error getting user %s: %w", login, err)
+	}
+
+	// Reading the permission of a collaborator requires push access to the repository.
+	level, _, err := handler.ghClient.Repositories.GetPermissionLevel(handler.ctx,
+		handler.repourl.owner, handler.repourl.repo, login)
+	switch statusCode(err) {
+	case 0:
+	case http.StatusForbidden, http.StatusNotFound:
+		return clients.CodeOwnerAccessUnknown, nil
+	default:
+		return clients.CodeOwnerAccessUnknown, fmt.Errorf("error getting permission of %s: %w", login, err)
+	}
+	switch level.GetPermission() {
+	case "admin", "maintain", "write":
+		return clients.CodeOwnerAccessWrite, nil
+	default:
+		return clients.CodeOwnerAccessRead, nil
+	}
+}
+
+func (handler *codeownersHandler) getTeamAccess(org, slug string) (clients.CodeOwnerAccess, error) {
+	repo, _, err := handler.ghClient.Teams.IsTeamRepoBySlug(handler.ctx, org, slug,
+		handler.repourl.owner, handler.repourl.repo)
+	switch statusCode(err) {
+	case 0:
+		perms := repo.GetPermissions()
+		if perms["admin"] || perms["maintain"] || perms["push"] {
+			return clients.CodeOwnerAccessWrite, nil
+		}
+		return clients.CodeOwnerAccessRead, nil
+	case http.StatusForbidden, http.StatusNotFound:
+	default:
+		return clients.CodeOwnerAccessUnknown, fmt.Errorf("error getting repository of team %s/%s: %w", org, slug, err)
+	}
+
+	// The team either has no access to the repository, or is not
+	// visible to the token: only the former can be told apart.
+	_, _, err = handler.ghClient.Teams.GetTeamBySlug(handler.ctx, org, slug)
+	switch statusCode(err) {
+	case 0:
+		return clients.CodeOwnerAccessRead, nil
+	case http.StatusForbidden, http.StatusNotFound:
+		return clients.CodeOwnerAccessUnknown, nil
+	default:
+		return clients.CodeOwnerAccessUnknown, fmt.Errorf("error getting team %s/%s: %w", org, slug, err)
+	}
+}
+
+// statusCode returns the HTTP status code of an API error, 0 for
+// no error, and -1 for errors without a response.
+func statusCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	var errResp *github.ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response != nil {
+		return errResp.Response.StatusCode
+	}
+	return -1
+}
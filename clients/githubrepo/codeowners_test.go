@@ -0,0 +1,107 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githubrepo
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v53/github"
+
+	"github.com/ossf/scorecard/v4/clients"
+)
+
+func Test_getCodeOwnerAccess(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		owner         string
+		responsePaths map[string]string
+		want          clients.CodeOwnerAccess
+	}{
+		{
+			name:  "user with write access",
+			owner: "@maintainer",
+			responsePaths: map[string]string{
+				"/users/maintainer": "./testdata/valid-user.json",
+				"/repos/ossf-tests/foo/collaborators/maintainer/permission": "./testdata/valid-permission-write.json",
+			},
+			want: clients.CodeOwnerAccessWrite,
+		},
+		{
+			name:  "user with read access",
+			owner: "@reader",
+			responsePaths: map[string]string{
+				"/users/reader": "./testdata/valid-user.json",
+				"/repos/ossf-tests/foo/collaborators/reader/permission": "./testdata/valid-permission-read.json",
+			},
+			want: clients.CodeOwnerAccessRead,
+		},
+		{
+			name:  "user permission not readable",
+			owner: "@maintainer",
+			responsePaths: map[string]string{
+				"/users/maintainer": "./testdata/valid-user.json",
+			},
+			want: clients.CodeOwnerAccessUnknown,
+		},
+		{
+			name:  "team with write access",
+			owner: "@ossf-tests/Maintainers",
+			responsePaths: map[string]string{
+				"/orgs/ossf-tests/teams/Maintainers/repos/ossf-tests/foo": "./testdata/valid-team-repo.json",
+			},
+			want: clients.CodeOwnerAccessWrite,
+		},
+		{
+			name:  "team not visible",
+			owner: "@ossf-tests/private-team",
+			want:  clients.CodeOwnerAccessUnknown,
+		},
+		{
+			name:  "email",
+			owner: "dev@example.com",
+			want:  clients.CodeOwnerAccessUnknown,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			client := github.NewClient(&http.Client{
+				Transport: pathTripper{
+					responsePaths: tt.responsePaths,
+				},
+			})
+			handler := &codeownersHandler{
+				ghClient: client,
+			}
+			handler.init(ctx, &repoURL{
+				owner:     "ossf-tests",
+				repo:      "foo",
+				commitSHA: clients.HeadSHA,
+			})
+			got, err := handler.getCodeOwnerAccess(tt.owner)
+			if err != nil {
+				t.Fatalf("getCodeOwnerAccess: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("getCodeOwnerAccess(%q) = %q, want %q", tt.owner, got, tt.want)
+			}
+		})
+	}
+}
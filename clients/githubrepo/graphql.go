@@ -34,8 +34,22 @@ const (
 	issueCommentsToAnalyze = 30
 	reviewsToAnalyze       = 30
 	labelsToAnalyze        = 30
+	// recentPullRequestsToAnalyze is the number of recently updated
+	// pull requests returned by ListPullRequests.
+	recentPullRequestsToAnalyze = 30
 )
 
+// gitSignature is the signature of a commit or tag.
+type gitSignature struct {
+	Typename          string `graphql:"__typename"`
+	State             githubv4.String
+	IsValid           bool
+	WasSignedByGitHub bool
+	Signer            *struct {
+		Login githubv4.String
+	}
+}
+
 //nolint:govet
 type graphqlData struct {
 	Repository struct {
@@ -48,7 +62,9 @@ type graphqlData struct {
 						Message       githubv4.String
 						Oid           githubv4.GitObjectID
 						Author        struct {
-							User struct {
+							Name  *string
+							Email *string
+							User  struct {
 								Login githubv4.String
 							}
 						}
@@ -58,10 +74,7 @@ type graphqlData struct {
 								Login *string
 							}
 						}
-						Signature struct {
-							IsValid           bool
-							WasSignedByGitHub bool
-						}
+						Signature              *gitSignature
 						AssociatedPullRequests struct {
 							Nodes []struct {
 								Repository struct {
@@ -113,6 +126,7 @@ type graphqlData struct {
 					Login githubv4.String
 				}
 				CreatedAt *time.Time
+				ClosedAt  *time.Time
 				Comments  struct {
 					Nodes []struct {
 						AuthorAssociation *string
@@ -124,6 +138,37 @@ type graphqlData struct {
 				} `graphql:"comments(last: $issueCommentsToAnalyze)"`
 			}
 		} `graphql:"issues(first: $issuesToAnalyze, orderBy:{field:UPDATED_AT, direction:DESC})"`
+		PullRequests struct {
+			Nodes []struct {
+				Number            githubv4.Int
+				AuthorAssociation *string
+				Author            struct {
+					Login githubv4.String
+				}
+				CreatedAt githubv4.DateTime
+				ClosedAt  *time.Time
+				MergedAt  *time.Time
+				Comments  struct {
+					Nodes []struct {
+						AuthorAssociation *string
+						CreatedAt         *time.Time
+						Author            struct {
+							Login githubv4.String
+						}
+					}
+				} `graphql:"comments(first: $issueCommentsToAnalyze)"`
+				Reviews struct {
+					Nodes []struct {
+						State             githubv4.String
+						AuthorAssociation *string
+						SubmittedAt       *time.Time
+						Author            struct {
+							Login githubv4.String
+						}
+					}
+				} `graphql:"reviews(first: $reviewsToAnalyze)"`
+			}
+		} `graphql:"pullRequests(first: $recentPullRequestsToAnalyze, orderBy:{field:UPDATED_AT, direction:DESC})"`
 	} `graphql:"repository(owner: $owner, name: $name)"`
 	RateLimit struct {
 		Cost *int
@@ -131,16 +176,17 @@ type graphqlData struct {
 }
 
 type graphqlHandler struct {
-	client      *githubv4.Client
-	data        *graphqlData
-	setupOnce   *sync.Once
-	ctx         context.Context
-	errSetup    error
-	repourl     *repoURL
-	commits     []clients.Commit
-	issues      []clients.Issue
-	archived    bool
-	commitDepth int
+	client       *githubv4.Client
+	data         *graphqlData
+	setupOnce    *sync.Once
+	ctx          context.Context
+	errSetup     error
+	repourl      *repoURL
+	commits      []clients.Commit
+	issues       []clients.Issue
+	pullRequests []clients.PullRequest
+	archived     bool
+	commitDepth  int
 }
 
 func (handler *graphqlHandler) init(ctx context.Context, repourl *repoURL, commitDepth int) {
@@ -152,6 +198,7 @@ func (handler *graphqlHandler) init(ctx context.Context, repourl *repoURL, commi
 	handler.commitDepth = commitDepth
 	handler.commits = nil
 	handler.issues = nil
+	handler.pullRequests = nil
 }
 
 func populateCommits(handler *graphqlHandler, vars map[string]interface{}) ([]clients.Commit, error) {
@@ -193,11 +240,14 @@ func (handler *graphqlHandler) setup() error {
 			"commitsToAnalyze":       githubv4.Int(handler.commitDepth),
 			"commitExpression":       githubv4.String(commitExpression),
 			"historyCursor":          (*githubv4.String)(nil),
+
+			"recentPullRequestsToAnalyze": githubv4.Int(recentPullRequestsToAnalyze),
 		}
 		// if NumberOfCommits set to < 99 we are required by the graphql to page by 100 commits.
 		if handler.commitDepth > 99 {
 			handler.commits, handler.errSetup = populateCommits(handler, vars)
 			handler.issues = issuesFrom(handler.data)
+			handler.pullRequests = pullRequestsFrom(handler.data)
 			handler.archived = bool(handler.data.Repository.IsArchived)
 			return
 		}
@@ -207,6 +257,7 @@ func (handler *graphqlHandler) setup() error {
 		}
 		handler.commits, handler.errSetup = commitsFrom(handler.data, handler.repourl.owner, handler.repourl.repo)
 		handler.issues = issuesFrom(handler.data)
+		handler.pullRequests = pullRequestsFrom(handler.data)
 		handler.archived = bool(handler.data.Repository.IsArchived)
 	})
 	return handler.errSetup
@@ -229,6 +280,16 @@ func (handler *graphqlHandler) getIssues() ([]clients.Issue, error) {
 	return handler.issues, nil
 }
 
+func (handler *graphqlHandler) getPullRequests() ([]clients.PullRequest, error) {
+	if !strings.EqualFold(handler.repourl.commitSHA, clients.HeadSHA) {
+		return nil, fmt.Errorf("%w: ListPullRequests only supported for HEAD queries", clients.ErrUnsupportedFeature)
+	}
+	if err := handler.setup(); err != nil {
+		return nil, fmt.Errorf("error during graphqlHandler.setup: %w", err)
+	}
+	return handler.pullRequests, nil
+}
+
 func (handler *graphqlHandler) isArchived() (bool, error) {
 	if !strings.EqualFold(handler.repourl.commitSHA, clients.HeadSHA) {
 		return false, fmt.Errorf("%w: IsArchived only supported for HEAD queries", clients.ErrUnsupportedFeature)
@@ -251,6 +312,7 @@ func commitsFrom(data *graphqlData, repoOwner, repoName string) ([]clients.Commi
 			// Username "GitHub" may indicate the commit was committed by GitHub.
 			// We verify that the commit is signed by GitHub, because the name can be spoofed.
 			*commit.Committer.Name == "GitHub" &&
+			commit.Signature != nil &&
 			commit.Signature.IsValid &&
 			commit.Signature.WasSignedByGitHub {
 			committer = "github"
@@ -304,11 +366,53 @@ func commitsFrom(data *graphqlData, repoOwner, repoName string) ([]clients.Commi
 				Login: committer,
 			},
 			AssociatedMergeRequest: associatedPR,
+			Author:                 commitAuthorFrom(commit.Author.Name, commit.Author.Email, commit.Author.User.Login),
+			Signature:              signatureFrom(commit.Signature),
 		})
 	}
 	return ret, nil
 }
 
+func commitAuthorFrom(name, email *string, login githubv4.String) clients.CommitAuthor {
+	author := clients.CommitAuthor{
+		Login: string(login),
+	}
+	if name != nil {
+		author.Name = *name
+	}
+	if email != nil {
+		author.Email = *email
+	}
+	return author
+}
+
+func signatureFrom(sig *gitSignature) *clients.Signature {
+	if sig == nil {
+		return nil
+	}
+	ret := &clients.Signature{
+		Verified:      sig.IsValid,
+		SignedByForge: sig.WasSignedByGitHub,
+	}
+	switch sig.Typename {
+	case "GpgSignature":
+		ret.Type = clients.SignatureTypeGPG
+	case "SshSignature":
+		ret.Type = clients.SignatureTypeSSH
+	case "SmimeSignature":
+		ret.Type = clients.SignatureTypeX509
+	default:
+		ret.Type = clients.SignatureTypeUnknown
+	}
+	if sig.Signer != nil {
+		ret.Signer = string(sig.Signer.Login)
+	}
+	if !sig.IsValid {
+		ret.Reason = strings.ToLower(string(sig.State))
+	}
+	return ret
+}
+
 func issuesFrom(data *graphqlData) []clients.Issue {
 	var ret []clients.Issue
 	for _, issue := range data.Repository.Issues.Nodes {
@@ -316,6 +420,7 @@ func issuesFrom(data *graphqlData) []clients.Issue {
 		copyStringPtr(issue.Url, &tmpIssue.URI)
 		copyRepoAssociationPtr(getRepoAssociation(issue.AuthorAssociation), &tmpIssue.AuthorAssociation)
 		copyTimePtr(issue.CreatedAt, &tmpIssue.CreatedAt)
+		copyTimePtr(issue.ClosedAt, &tmpIssue.ClosedAt)
 		if issue.Author.Login != "" {
 			tmpIssue.Author = &clients.User{
 				Login: string(issue.Author.Login),
@@ -337,6 +442,57 @@ func issuesFrom(data *graphqlData) []clients.Issue {
 	return ret
 }
 
+func pullRequestsFrom(data *graphqlData) []clients.PullRequest {
+	var ret []clients.PullRequest
+	for _, pr := range data.Repository.PullRequests.Nodes {
+		tmpPR := clients.PullRequest{
+			Number: int(pr.Number),
+			Author: clients.User{
+				Login: string(pr.Author.Login),
+			},
+			CreatedAt: pr.CreatedAt.Time,
+		}
+		copyRepoAssociationPtr(getRepoAssociation(pr.AuthorAssociation), &tmpPR.AuthorAssociation)
+		if pr.ClosedAt != nil {
+			tmpPR.ClosedAt = *pr.ClosedAt
+		}
+		if pr.MergedAt != nil {
+			tmpPR.MergedAt = *pr.MergedAt
+		}
+		for _, comment := range pr.Comments.Nodes {
+			var tmpComment clients.IssueComment
+			copyRepoAssociationPtr(getRepoAssociation(comment.AuthorAssociation), &tmpComment.AuthorAssociation)
+			copyTimePtr(comment.CreatedAt, &tmpComment.CreatedAt)
+			if comment.Author.Login != "" {
+				tmpComment.Author = &clients.User{
+					Login: string(comment.Author.Login),
+				}
+			}
+			tmpPR.Comments = append(tmpPR.Comments, tmpComment)
+		}
+		for _, review := range pr.Reviews.Nodes {
+			author := &clients.User{
+				Login: string(review.Author.Login),
+			}
+			tmpPR.Reviews = append(tmpPR.Reviews, clients.Review{
+				State:  string(review.State),
+				Author: author,
+			})
+			// Pending reviews are not submitted yet.
+			if review.SubmittedAt == nil {
+				continue
+			}
+			var tmpComment clients.IssueComment
+			copyRepoAssociationPtr(getRepoAssociation(review.AuthorAssociation), &tmpComment.AuthorAssociation)
+			copyTimePtr(review.SubmittedAt, &tmpComment.CreatedAt)
+			tmpComment.Author = author
+			tmpPR.Comments = append(tmpPR.Comments, tmpComment)
+		}
+		ret = append(ret, tmpPR)
+	}
+	return ret
+}
+
 // getRepoAssociation returns the association of the user with the repository.
 func getRepoAssociation(association *string) *clients.RepoAssociation {
 	if association == nil {
@@ -0,0 +1,160 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githubrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/google/go-github/v53/github"
+
+	"github.com/ossf/scorecard/v4/clients"
+)
+
+const (
+	securityFeatureEnabled = "enabled"
+	workflowPermissionRead = "read"
+)
+
+type securityFeatureStatus struct {
+	Status *string `json:"status"`
+}
+
+type repoSecurityAndAnalysis struct {
+	SecurityAndAnalysis *struct {
+		SecretScanning               *securityFeatureStatus `json:"secret_scanning"`
+		SecretScanningPushProtection *securityFeatureStatus `json:"secret_scanning_push_protection"`
+		DependabotSecurityUpdates    *securityFeatureStatus `json:"dependabot_security_updates"`
+	} `json:"security_and_analysis"`
+}
+
+type privateVulnerabilityReporting struct {
+	Enabled *bool `json:"enabled"`
+}
+
+type workflowPermissions struct {
+	DefaultWorkflowPermissions   *string `json:"default_workflow_permissions"`
+	CanApprovePullRequestReviews *bool   `json:"can_approve_pull_request_reviews"`
+}
+
+type securitySettingsHandler struct {
+	ghClient            *github.Client
+	once                *sync.Once
+	ctx                 context.Context
+	errSetup            error
+	repourl             *repoURL
+	settings            clients.SecuritySettings
+	workflowPermissions clients.WorkflowPermissions
+}
+
+func (handler *securitySettingsHandler) init(ctx context.Context, repourl *repoURL) {
+	handler.ctx = ctx
+	handler.repourl = repourl
+	handler.errSetup = nil
+	handler.once = new(sync.Once)
+	handler.settings = clients.SecuritySettings{}
+	handler.workflowPermissions = clients.WorkflowPermissions{}
+}
+
+func (handler *securitySettingsHandler) setup() error {
+	handler.once.Do(func() {
+		if !strings.EqualFold(handler.repourl.commitSHA, clients.HeadSHA) {
+			handler.errSetup = fmt.Errorf("%w: security settings only supported for HEAD queries",
+				clients.ErrUnsupportedFeature)
+			return
+		}
+		repoPath := path.Join("repos", handler.repourl.owner, handler.repourl.repo)
+
+		// The security_and_analysis object is only returned to users with admin access.
+		var repo repoSecurityAndAnalysis
+		if err := handler.get(repoPath, &repo); err != nil {
+			handler.errSetup = fmt.Errorf("error getting repository settings: %w", err)
+			return
+		}
+		if s := repo.SecurityAndAnalysis; s != nil {
+			handler.settings.SecretScanning = isFeatureEnabled(s.SecretScanning)
+			handler.settings.SecretScanningPushProtection = isFeatureEnabled(s.SecretScanningPushProtection)
+			handler.settings.DependabotSecurityUpdates = isFeatureEnabled(s.DependabotSecurityUpdates)
+		}
+
+		var reporting privateVulnerabilityReporting
+		if err := handler.get(path.Join(repoPath, "private-vulnerability-reporting"), &reporting); err != nil {
+			handler.errSetup = fmt.Errorf("error getting private vulnerability reporting: %w", err)
+			return
+		}
+		handler.settings.PrivateVulnerabilityReporting = reporting.Enabled
+
+		var permissions workflowPermissions
+		if err := handler.get(path.Join(repoPath, "actions", "permissions", "workflow"), &permissions); err != nil {
+			handler.errSetup = fmt.Errorf("error getting workflow permissions: %w", err)
+			return
+		}
+		if permissions.DefaultWorkflowPermissions != nil {
+			readOnly := *permissions.DefaultWorkflowPermissions == workflowPermissionRead
+			handler.workflowPermissions.DefaultTokenReadOnly = &readOnly
+		}
+		handler.workflowPermissions.CanApprovePullRequests = permissions.CanApprovePullRequestReviews
+		handler.errSetup = nil
+	})
+	return handler.errSetup
+}
+
+// get fetches the resource at reqURL into v. Resources which are not
+// accessible with the current token leave v unchanged.
+func (handler *securitySettingsHandler) get(reqURL string, v interface{}) error {
+	req, err := handler.ghClient.NewRequest(http.MethodGet, reqURL, nil)
+	if err != nil {
+		return fmt.Errorf("request for %s failed with %w", reqURL, err)
+	}
+	_, err = handler.ghClient.Do(handler.ctx, req, v)
+	var errResp *github.ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response != nil {
+		switch errResp.Response.StatusCode {
+		case http.StatusForbidden, http.StatusNotFound:
+			return nil
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("response for %s failed with %w", reqURL, err)
+	}
+	return nil
+}
+
+func isFeatureEnabled(s *securityFeatureStatus) *bool {
+	if s == nil || s.Status == nil {
+		return nil
+	}
+	enabled := *s.Status == securityFeatureEnabled
+	return &enabled
+}
+
+func (handler *securitySettingsHandler) getSecuritySettings() (clients.SecuritySettings, error) {
+	if err := handler.setup(); err != nil {
+		return clients.SecuritySettings{}, fmt.Errorf("error during securitySettingsHandler.setup: %w", err)
+	}
+	return handler.settings, nil
+}
+
+func (handler *securitySettingsHandler) getWorkflowPermissions() (clients.WorkflowPermissions, error) {
+	if err := handler.setup(); err != nil {
+		return clients.WorkflowPermissions{}, fmt.Errorf("error during securitySettingsHandler.setup: %w", err)
+	}
+	return handler.workflowPermissions, nil
+}
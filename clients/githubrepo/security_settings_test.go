@@ -0,0 +1,127 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githubrepo
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v53/github"
+
+	"github.com/ossf/scorecard/v4/clients"
+)
+
+// pathTripper responds with the file mapped to the request path, and 403 otherwise.
+type pathTripper struct {
+	responsePaths map[string]string
+}
+
+func (p pathTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+	responsePath, ok := p.responsePaths[r.URL.Path]
+	if !ok {
+		return &http.Response{
+			Status:     "403 Forbidden",
+			StatusCode: http.StatusForbidden,
+			Body:       io.NopCloser(bytes.NewBufferString(`{"message": "Resource not accessible by integration"}`)),
+			Request:    r,
+		}, nil
+	}
+	f, err := os.Open(responsePath)
+	if err != nil {
+		//nolint:wrapcheck
+		return nil, err
+	}
+	return &http.Response{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Body:       f,
+		Request:    r,
+	}, nil
+}
+
+func Test_getSecuritySettings(t *testing.T) {
+	t.Parallel()
+	enabled, disabled := true, false
+	tests := []struct {
+		name                    string
+		responsePaths           map[string]string
+		wantSettings            clients.SecuritySettings
+		wantWorkflowPermissions clients.WorkflowPermissions
+	}{
+		{
+			name: "admin token",
+			responsePaths: map[string]string{
+				"/repos/ossf-tests/foo":                                 "./testdata/valid-security-and-analysis.json",
+				"/repos/ossf-tests/foo/private-vulnerability-reporting": "./testdata/valid-private-vulnerability-reporting.json",
+				"/repos/ossf-tests/foo/actions/permissions/workflow":    "./testdata/valid-workflow-permissions.json",
+			},
+			wantSettings: clients.SecuritySettings{
+				SecretScanning:                &enabled,
+				SecretScanningPushProtection:  &disabled,
+				DependabotSecurityUpdates:     &enabled,
+				PrivateVulnerabilityReporting: &enabled,
+			},
+			wantWorkflowPermissions: clients.WorkflowPermissions{
+				DefaultTokenReadOnly:   &disabled,
+				CanApprovePullRequests: &disabled,
+			},
+		},
+		{
+			name: "token without admin scope",
+			responsePaths: map[string]string{
+				"/repos/ossf-tests/foo": "./testdata/valid-repo.json",
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctx := context.Background()
+			client := github.NewClient(&http.Client{
+				Transport: pathTripper{
+					responsePaths: tt.responsePaths,
+				},
+			})
+			handler := &securitySettingsHandler{
+				ghClient: client,
+			}
+			handler.init(ctx, &repoURL{
+				owner:     "ossf-tests",
+				repo:      "foo",
+				commitSHA: clients.HeadSHA,
+			})
+			settings, err := handler.getSecuritySettings()
+			if err != nil {
+				t.Fatalf("getSecuritySettings: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantSettings, settings); diff != "" {
+				t.Errorf("getSecuritySettings() mismatch (-want +got):\n%s", diff)
+			}
+			permissions, err := handler.getWorkflowPermissions()
+			if err != nil {
+				t.Fatalf("getWorkflowPermissions: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantWorkflowPermissions, permissions); diff != "" {
+				t.Errorf("getWorkflowPermissions() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,203 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githubrepo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/google/go-github/v53/github"
+	"github.com/shurcooL/githubv4"
+
+	"github.com/ossf/scorecard/v4/clients"
+	sce "github.com/ossf/scorecard/v4/errors"
+)
+
+const (
+	// tagsToAnalyze is the page size used to list the tags of the repository.
+	tagsToAnalyze = 100
+	// maxTagPages caps the number of pages of tags listed, most recent first.
+	maxTagPages = 10
+	// branchesPerPage is the page size used to list the branches of the repository.
+	branchesPerPage = 100
+	// maxBranchComparisons caps the number of branches, other than the default one,
+	// a commit is compared with.
+	maxBranchComparisons = 20
+)
+
+// tagTarget is the object pointed at by a tag ref. It is a Commit for
+// lightweight tags, and a Tag for annotated tags.
+type tagTarget struct {
+	Typename string `graphql:"__typename"`
+	Oid      githubv4.GitObjectID
+	Tag      struct {
+		Signature *gitSignature
+		Target    struct {
+			Oid githubv4.GitObjectID
+		}
+	} `graphql:"... on Tag"`
+}
+
+//nolint:govet
+type tagsData struct {
+	Repository struct {
+		Refs struct {
+			Nodes []struct {
+				Name   githubv4.String
+				Target tagTarget
+			}
+			PageInfo struct {
+				EndCursor   githubv4.String
+				HasNextPage bool
+			}
+		} `graphql:"refs(refPrefix: \"refs/tags/\", first: $tagsToAnalyze, after: $tagsCursor, orderBy: {field: TAG_COMMIT_DATE, direction: DESC})"`
+	} `graphql:"repository(owner: $owner, name: $name)"`
+}
+
+type tagsHandler struct {
+	client      *github.Client
+	graphClient *githubv4.Client
+	once        *sync.Once
+	ctx         context.Context
+	errSetup    error
+	repourl     *repoURL
+	tags        []clients.Tag
+}
+
+func (handler *tagsHandler) init(ctx context.Context, repourl *repoURL) {
+	handler.ctx = ctx
+	handler.repourl = repourl
+	handler.errSetup = nil
+	handler.once = new(sync.Once)
+	handler.tags = nil
+}
+
+func (handler *tagsHandler) setup() error {
+	handler.once.Do(func() {
+		vars := map[string]interface{}{
+			"owner":         githubv4.String(handler.repourl.owner),
+			"name":          githubv4.String(handler.repourl.repo),
+			"tagsToAnalyze": githubv4.Int(tagsToAnalyze),
+			"tagsCursor":    (*githubv4.String)(nil),
+		}
+		for page := 0; page < maxTagPages; page++ {
+			data := new(tagsData)
+			if err := handler.graphClient.Query(handler.ctx, data, vars); err != nil {
+				handler.errSetup = sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("ListTags: %v", err))
+				return
+			}
+			handler.tags = append(handler.tags, tagsFrom(data)...)
+			if !data.Repository.Refs.PageInfo.HasNextPage {
+				return
+			}
+			cursor := data.Repository.Refs.PageInfo.EndCursor
+			vars["tagsCursor"] = &cursor
+		}
+	})
+	return handler.errSetup
+}
+
+func tagsFrom(data *tagsData) []clients.Tag {
+	tags := make([]clients.Tag, 0, len(data.Repository.Refs.Nodes))
+	for _, ref := range data.Repository.Refs.Nodes {
+		tag := clients.Tag{
+			Name:      string(ref.Name),
+			CommitSHA: string(ref.Target.Oid),
+		}
+		if ref.Target.Typename == "Tag" {
+			tag.CommitSHA = string(ref.Target.Tag.Target.Oid)
+			tag.Signature = signatureFrom(ref.Target.Tag.Signature)
+		}
+		tags = append(tags, tag)
+	}
+	return tags
+}
+
+func (handler *tagsHandler) listTags() ([]clients.Tag, error) {
+	if err := handler.setup(); err != nil {
+		return nil, fmt.Errorf("error during tagsHandler.setup: %w", err)
+	}
+	return handler.tags, nil
+}
+
+// isCommitReachable returns whether a tag points at the commit, or the commit is an ancestor
+// of a branch. A commit that only exists in a fork is still returned by the API, but is
+// never behind a branch of the repository. The default branch is compared first, then the
+// other branches up to maxBranchComparisons: an error is returned if there are more branches,
+// as the commit can then be neither found nor ruled out.
+func (handler *tagsHandler) isCommitReachable(commitSHA string) (bool, error) {
+	tags, err := handler.listTags()
+	if err != nil {
+		return false, err
+	}
+	for _, t := range tags {
+		if strings.EqualFold(t.CommitSHA, commitSHA) {
+			return true, nil
+		}
+	}
+
+	reachable, err := handler.isAncestor(handler.repourl.defaultBranch, commitSHA)
+	if err != nil || reachable {
+		return reachable, err
+	}
+
+	// The commit may only be in another branch, e.g., a `releases/v2` branch.
+	comparisons := 0
+	opts := &github.BranchListOptions{ListOptions: github.ListOptions{PerPage: branchesPerPage}}
+	for {
+		branches, resp, err := handler.client.Repositories.ListBranches(handler.ctx, handler.repourl.owner,
+			handler.repourl.repo, opts)
+		if err != nil {
+			return false, sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("ListBranches: %v", err))
+		}
+		for _, b := range branches {
+			if b.GetName() == handler.repourl.defaultBranch {
+				continue
+			}
+			if strings.EqualFold(b.GetCommit().GetSHA(), commitSHA) {
+				return true, nil
+			}
+			if comparisons == maxBranchComparisons {
+				return false, sce.WithMessage(sce.ErrScorecardInternal,
+					fmt.Sprintf("commit %s not found in the first %d branches", commitSHA, maxBranchComparisons))
+			}
+			comparisons++
+			reachable, err := handler.isAncestor(b.GetName(), commitSHA)
+			if err != nil || reachable {
+				return reachable, err
+			}
+		}
+		if resp.NextPage == 0 {
+			return false, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// isAncestor returns whether the commit is behind the branch, or is its head.
+func (handler *tagsHandler) isAncestor(branch, commitSHA string) (bool, error) {
+	comparison, _, err := handler.client.Repositories.CompareCommits(handler.ctx, handler.repourl.owner,
+		handler.repourl.repo, branch, commitSHA, &github.ListOptions{PerPage: 1})
+	if err != nil {
+		return false, sce.WithMessage(sce.ErrScorecardInternal, fmt.Sprintf("CompareCommits: %v", err))
+	}
+	switch comparison.GetStatus() {
+	case "behind", "identical":
+		return true, nil
+	}
+	return false, nil
+}
@@ -0,0 +1,178 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package githubrepo
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v53/github"
+	"github.com/shurcooL/githubv4"
+
+	"github.com/ossf/scorecard/v4/clients"
+)
+
+func Test_tagsFrom(t *testing.T) {
+	t.Parallel()
+	lightweight := tagTarget{
+		Typename: "Commit",
+		Oid:      "c0ffee",
+	}
+	annotated := tagTarget{
+		Typename: "Tag",
+		Oid:      "7a9",
+	}
+	annotated.Tag.Target.Oid = "c0ffee"
+	signed := annotated
+	signed.Tag.Signature = &gitSignature{
+		Typename: "SshSignature",
+		State:    "VALID",
+		IsValid:  true,
+		Signer: &struct {
+			Login githubv4.String
+		}{Login: "octocat"},
+	}
+	unverified := annotated
+	unverified.Tag.Signature = &gitSignature{
+		Typename: "SmimeSignature",
+		State:    "UNKNOWN_KEY",
+	}
+
+	data := new(tagsData)
+	for _, ref := range []struct {
+		name   githubv4.String
+		target tagTarget
+	}{
+		{name: "lightweight", target: lightweight},
+		{name: "annotated", target: annotated},
+		{name: "signed", target: signed},
+		{name: "unverified", target: unverified},
+	} {
+		data.Repository.Refs.Nodes = append(data.Repository.Refs.Nodes, struct {
+			Name   githubv4.String
+			Target tagTarget
+		}{Name: ref.name, Target: ref.target})
+	}
+
+	want := []clients.Tag{
+		{Name: "lightweight", CommitSHA: "c0ffee"},
+		{Name: "annotated", CommitSHA: "c0ffee"},
+		{
+			Name:      "signed",
+			CommitSHA: "c0ffee",
+			Signature: &clients.Signature{
+				Type:     clients.SignatureTypeSSH,
+				Signer:   "octocat",
+				Verified: true,
+			},
+		},
+		{
+			Name:      "unverified",
+			CommitSHA: "c0ffee",
+			Signature: &clients.Signature{
+				Type:   clients.SignatureTypeX509,
+				Reason: "unknown_key",
+			},
+		},
+	}
+	if diff := cmp.Diff(want, tagsFrom(data)); diff != "" {
+		t.Errorf("tagsFrom() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_isCommitReachable(t *testing.T) {
+	t.Parallel()
+	const (
+		sha             = "c0ffeec0ffeec0ffeec0ffeec0ffeec0ffeec0ff"
+		compareMain     = "/repos/ossf-tests/foo/compare/main..." + sha
+		compareReleases = "/repos/ossf-tests/foo/compare/releases/v2..." + sha
+		branches        = "/repos/ossf-tests/foo/branches"
+	)
+	tests := []struct {
+		name          string
+		tags          []clients.Tag
+		responsePaths map[string]string
+		want          bool
+		wantErr       bool
+	}{
+		{
+			name: "tagged commit",
+			tags: []clients.Tag{{Name: "v1.0.0", CommitSHA: sha}},
+			want: true,
+		},
+		{
+			name: "commit of the default branch",
+			responsePaths: map[string]string{
+				compareMain: "./testdata/compare-behind.json",
+			},
+			want: true,
+		},
+		{
+			name: "commit of another branch",
+			responsePaths: map[string]string{
+				compareMain:     "./testdata/compare-diverged.json",
+				branches:        "./testdata/valid-branches.json",
+				compareReleases: "./testdata/compare-behind.json",
+			},
+			want: true,
+		},
+		{
+			name: "commit of no branch",
+			responsePaths: map[string]string{
+				compareMain:     "./testdata/compare-diverged.json",
+				branches:        "./testdata/valid-branches.json",
+				compareReleases: "./testdata/compare-diverged.json",
+			},
+			want: false,
+		},
+		{
+			name: "branches not listed",
+			responsePaths: map[string]string{
+				compareMain: "./testdata/compare-diverged.json",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			handler := &tagsHandler{
+				client: github.NewClient(&http.Client{
+					Transport: pathTripper{
+						responsePaths: tt.responsePaths,
+					},
+				}),
+			}
+			handler.init(context.Background(), &repoURL{
+				owner:         "ossf-tests",
+				repo:          "foo",
+				defaultBranch: "main",
+			})
+			// The tags are not listed with GraphQL.
+			handler.once.Do(func() {})
+			handler.tags = tt.tags
+			got, err := handler.isCommitReachable(sha)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("isCommitReachable() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("isCommitReachable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
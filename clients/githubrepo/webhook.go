@@ -17,6 +17,7 @@ package githubrepo
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -56,9 +57,15 @@ func (handler *webhookHandler) setup() error {
 		}
 
 		for _, hook := range hooks {
+			scheme, host := clients.RedactWebhookURL(getConfigString(hook.Config, "url"))
 			repoHook := clients.Webhook{
-				ID:             hook.GetID(),
-				UsesAuthSecret: getAuthSecret(hook.Config),
+				Path:            host,
+				Scheme:          scheme,
+				Events:          hook.Events,
+				ID:              hook.GetID(),
+				UsesAuthSecret:  getAuthSecret(hook.Config),
+				SSLVerification: getConfigString(hook.Config, "insecure_ssl") != "1",
+				Active:          hook.GetActive(),
 			}
 			handler.webhook = append(handler.webhook, repoHook)
 		}
@@ -77,6 +84,19 @@ func getAuthSecret(config map[string]interface{}) bool {
 	return false
 }
 
+// getConfigString returns the value of a hook configuration key,
+// which GitHub returns either as a string or as a number.
+func getConfigString(config map[string]interface{}, key string) string {
+	switch val := config[key].(type) {
+	case string:
+		return val
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	default:
+		return ""
+	}
+}
+
 func (handler *webhookHandler) listWebhooks() ([]clients.Webhook, error) {
 	if err := handler.setup(); err != nil {
 		return nil, fmt.Errorf("error during webhookHandler.setup: %w", err)
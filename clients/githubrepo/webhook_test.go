@@ -56,8 +56,28 @@ func Test_listWebhooks(t *testing.T) {
 			responsePath: "./testdata/valid-webhook.json",
 			want: []clients.Webhook{
 				{
+					Path:           "test.com",
+					Scheme:         "https",
+					Events:         []string{"push"},
 					ID:             12345678,
 					UsesAuthSecret: false,
+					Active:         true,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:         "webhook with secret",
+			responsePath: "./testdata/valid-webhook-secret.json",
+			want: []clients.Webhook{
+				{
+					Path:            "hooks.example.com:8443",
+					Scheme:          "http",
+					Events:          []string{"*"},
+					ID:              87654321,
+					UsesAuthSecret:  true,
+					SSLVerification: true,
+					Active:          false,
 				},
 			},
 			wantErr: false,
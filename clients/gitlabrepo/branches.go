@@ -17,6 +17,7 @@ package gitlabrepo
 import (
 	"fmt"
 	"net/http"
+	"regexp"
 	"strings"
 	"sync"
 
@@ -36,6 +37,7 @@ type branchesHandler struct {
 	getProtectedBranch       fnProtectedBranch
 	getProjectChecks         fnListProjectStatusChecks
 	getApprovalConfiguration fnGetApprovalConfiguration
+	getApprovalRules         fnGetApprovalRules
 }
 
 func (handler *branchesHandler) init(repourl *repoURL) {
@@ -47,6 +49,7 @@ func (handler *branchesHandler) init(repourl *repoURL) {
 	handler.getProtectedBranch = handler.glClient.ProtectedBranches.GetProtectedBranch
 	handler.getProjectChecks = handler.glClient.ExternalStatusChecks.ListProjectStatusChecks
 	handler.getApprovalConfiguration = handler.glClient.Projects.GetApprovalConfiguration
+	handler.getApprovalRules = handler.glClient.Projects.GetProjectApprovalRules
 }
 
 type (
@@ -60,8 +63,14 @@ type (
 		options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectStatusCheck, *gitlab.Response, error)
 	fnGetApprovalConfiguration func(pid interface{},
 		options ...gitlab.RequestOptionFunc) (*gitlab.ProjectApprovals, *gitlab.Response, error)
+	fnGetApprovalRules func(pid interface{}, opt *gitlab.GetProjectApprovalRulesListsOptions,
+		options ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectApprovalRule, *gitlab.Response, error)
 )
 
+// gitlabPipelineContext is the status check of the merge requests of projects
+// where pipelines must succeed.
+const gitlabPipelineContext = "pipeline"
+
 // nolint: nestif
 func (handler *branchesHandler) setup() error {
 	handler.once.Do(func() {
@@ -85,28 +94,37 @@ func (handler *branchesHandler) setup() error {
 		if branch.Protected {
 			protectedBranch, resp, err := handler.getProtectedBranch(
 				handler.repourl.projectID, branch.Name)
-			if err != nil && resp.StatusCode != http.StatusForbidden {
+			if err != nil && (resp == nil || resp.StatusCode != http.StatusForbidden) {
 				handler.errSetup = fmt.Errorf("request for protected branch failed with error %w", err)
 				return
-			} else if resp.StatusCode == http.StatusForbidden {
+			} else if resp != nil && resp.StatusCode == http.StatusForbidden {
 				handler.errSetup = fmt.Errorf("incorrect permissions to fully check branch protection %w", err)
 				return
 			}
 
 			projectStatusChecks, resp, err := handler.getProjectChecks(handler.repourl.projectID, &gitlab.ListOptions{})
 
-			if resp.StatusCode != 200 || err != nil {
+			if err != nil || resp == nil || resp.StatusCode != 200 {
 				handler.errSetup = fmt.Errorf("request for external status checks failed with error %w", err)
 			}
 
 			projectApprovalRule, resp, err := handler.getApprovalConfiguration(handler.repourl.projectID)
-			if err != nil && resp.StatusCode != 404 {
+			if err != nil && (resp == nil || resp.StatusCode != 404) {
 				handler.errSetup = fmt.Errorf("request for project approval rule failed with %w", err)
 				return
 			}
 
-			handler.defaultBranchRef = makeBranchRefFrom(branch, protectedBranch,
-				projectStatusChecks, projectApprovalRule)
+			// Approval rules are only available in GitLab Premium and Ultimate.
+			approvalRules, resp, err := handler.getApprovalRules(handler.repourl.projectID,
+				&gitlab.GetProjectApprovalRulesListsOptions{})
+			if err != nil && (resp == nil ||
+				(resp.StatusCode != http.StatusNotFound && resp.StatusCode != http.StatusForbidden)) {
+				handler.errSetup = fmt.Errorf("request for project approval rules failed with %w", err)
+				return
+			}
+
+			handler.defaultBranchRef = makeBranchRefFrom(branch, protectedBranch, proj,
+				projectStatusChecks, projectApprovalRule, approvalRules)
 		} else {
 			handler.defaultBranchRef = &clients.BranchRef{
 				Name:      &branch.Name,
@@ -151,16 +169,29 @@ func (handler *branchesHandler) getBranch(branch string) (*clients.BranchRef, er
 
 		projectStatusChecks, resp, err := handler.getProjectChecks(
 			handler.repourl.projectID, &gitlab.ListOptions{})
-		if err != nil && resp.StatusCode != 404 {
+		if err != nil && (resp == nil || resp.StatusCode != 404) {
 			return nil, fmt.Errorf("request for external status checks failed with error %w", err)
 		}
 
 		projectApprovalRule, resp, err := handler.getApprovalConfiguration(handler.repourl.projectID)
-		if err != nil && resp.StatusCode != 404 {
+		if err != nil && (resp == nil || resp.StatusCode != 404) {
 			return nil, fmt.Errorf("request for project approval rule failed with %w", err)
 		}
 
-		return makeBranchRefFrom(bran, protectedBranch, projectStatusChecks, projectApprovalRule), nil
+		approvalRules, resp, err := handler.getApprovalRules(handler.repourl.projectID,
+			&gitlab.GetProjectApprovalRulesListsOptions{})
+		if err != nil && (resp == nil ||
+			(resp.StatusCode != http.StatusNotFound && resp.StatusCode != http.StatusForbidden)) {
+			return nil, fmt.Errorf("request for project approval rules failed with %w", err)
+		}
+
+		proj, _, err := handler.queryProject(handler.repourl.projectID, &gitlab.GetProjectOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("request for project failed with error %w", err)
+		}
+
+		return makeBranchRefFrom(bran, protectedBranch, proj,
+			projectStatusChecks, projectApprovalRule, approvalRules), nil
 	} else {
 		ret := &clients.BranchRef{
 			Name:      &bran.Name,
@@ -170,37 +201,138 @@ func (handler *branchesHandler) getBranch(branch string) (*clients.BranchRef, er
 	}
 }
 
-func makeContextsFromResp(checks []*gitlab.ProjectStatusCheck) []string {
-	ret := make([]string, len(checks))
-	for i, statusCheck := range checks {
-		ret[i] = statusCheck.Name
+func makeContextsFromResp(checks []*gitlab.ProjectStatusCheck, project *gitlab.Project) []string {
+	ret := make([]string, 0, len(checks)+1)
+	if project != nil && project.OnlyAllowMergeIfPipelineSucceeds {
+		ret = append(ret, gitlabPipelineContext)
+	}
+	for _, statusCheck := range checks {
+		ret = append(ret, statusCheck.Name)
 	}
 	return ret
 }
 
+// lowestAccessLevel returns the lowest access level allowed by the access descriptions.
+// Access granted to specific users, groups or deploy keys counts as developer access.
+func lowestAccessLevel(levels []*gitlab.BranchAccessDescription) gitlab.AccessLevelValue {
+	lowest := gitlab.NoPermissions
+	for _, level := range levels {
+		l := level.AccessLevel
+		if l == gitlab.NoPermissions && (level.UserID != 0 || level.GroupID != 0) {
+			l = gitlab.DeveloperPermissions
+		}
+		if l != gitlab.NoPermissions && (lowest == gitlab.NoPermissions || l < lowest) {
+			lowest = l
+		}
+	}
+	return lowest
+}
+
+// pushAccessActors returns the roles, users and groups allowed to push without a merge request.
+func pushAccessActors(levels []*gitlab.BranchAccessDescription) []string {
+	var actors []string
+	for _, level := range levels {
+		if level.AccessLevel == gitlab.NoPermissions && level.UserID == 0 && level.GroupID == 0 {
+			continue
+		}
+		actors = append(actors, level.AccessLevelDescription)
+	}
+	return actors
+}
+
+// matchesProtectedBranch returns whether the branch matches the name of a protected branch,
+// which can contain `*` wildcards, e.g., `release/*`.
+func matchesProtectedBranch(pattern, branch string) bool {
+	if !strings.Contains(pattern, "*") {
+		return pattern == branch
+	}
+	parts := strings.Split(pattern, "*")
+	for i := range parts {
+		parts[i] = regexp.QuoteMeta(parts[i])
+	}
+	return regexp.MustCompile("^" + strings.Join(parts, ".*") + "$").MatchString(branch)
+}
+
+// requiredApprovals returns the number of approvals required to merge onto the branch,
+// which is the highest of the approval rules applying to it.
+func requiredApprovals(branch string, projectApprovalRule *gitlab.ProjectApprovals,
+	approvalRules []*gitlab.ProjectApprovalRule,
+) int {
+	required := 0
+	if projectApprovalRule != nil {
+		required = projectApprovalRule.ApprovalsBeforeMerge
+	}
+	for _, rule := range approvalRules {
+		applies := rule.AppliesToAllProtectedBranches || len(rule.ProtectedBranches) == 0
+		for _, pb := range rule.ProtectedBranches {
+			applies = applies || matchesProtectedBranch(pb.Name, branch)
+		}
+		if applies && rule.ApprovalsRequired > required {
+			required = rule.ApprovalsRequired
+		}
+	}
+	return required
+}
+
 func makeBranchRefFrom(branch *gitlab.Branch, protectedBranch *gitlab.ProtectedBranch,
+	project *gitlab.Project,
 	projectStatusChecks []*gitlab.ProjectStatusCheck,
 	projectApprovalRule *gitlab.ProjectApprovals,
+	approvalRules []*gitlab.ProjectApprovalRule,
 ) *clients.BranchRef {
+	contexts := makeContextsFromResp(projectStatusChecks, project)
 	requiresStatusChecks := newFalse()
-	if len(projectStatusChecks) > 0 {
+	if len(contexts) > 0 {
 		requiresStatusChecks = newTrue()
 	}
 
+	// Fast-forward and semi-linear merges require the source branch to be rebased on the target branch.
+	upToDateBeforeMerge := newFalse()
+	requireLinearHistory := newFalse()
+	if project != nil {
+		switch project.MergeMethod {
+		case gitlab.FastForwardMerge:
+			upToDateBeforeMerge = newTrue()
+			requireLinearHistory = newTrue()
+		case gitlab.RebaseMerge:
+			upToDateBeforeMerge = newTrue()
+		}
+	}
+
 	statusChecksRule := clients.StatusChecksRule{
-		UpToDateBeforeMerge:  newTrue(),
+		UpToDateBeforeMerge:  upToDateBeforeMerge,
 		RequiresStatusChecks: requiresStatusChecks,
-		Contexts:             makeContextsFromResp(projectStatusChecks),
+		Contexts:             contexts,
 	}
 
 	pullRequestReviewRule := clients.PullRequestReviewRule{
-		DismissStaleReviews:     newTrue(),
+		DismissStaleReviews:     newFalse(),
 		RequireCodeOwnerReviews: &protectedBranch.CodeOwnerApprovalRequired,
 	}
 
+	requiredApprovalNum := int32(requiredApprovals(branch.Name, projectApprovalRule, approvalRules))
+	pushLevel := lowestAccessLevel(protectedBranch.PushAccessLevels)
+	// Developers allowed to push do not need merge requests, so approvals are not required.
+	if pushLevel != gitlab.NoPermissions && pushLevel <= gitlab.DeveloperPermissions {
+		requiredApprovalNum = 0
+	}
+	pullRequestReviewRule.RequiredApprovingReviewCount = &requiredApprovalNum
+
+	// Approvals are reset on push, and the last pusher cannot approve if neither
+	// the author nor the committers of a merge request can approve it.
+	requireLastPushApproval := newFalse()
 	if projectApprovalRule != nil {
-		requiredApprovalNum := int32(projectApprovalRule.ApprovalsBeforeMerge)
-		pullRequestReviewRule.RequiredApprovingReviewCount = &requiredApprovalNum
+		pullRequestReviewRule.DismissStaleReviews = &projectApprovalRule.ResetApprovalsOnPush
+		if !projectApprovalRule.MergeRequestsAuthorApproval &&
+			projectApprovalRule.MergeRequestsDisableCommittersApproval {
+			requireLastPushApproval = newTrue()
+		}
+	}
+
+	// Maintainers allowed to push can bypass merge requests, like GitHub admins.
+	enforceAdmins := newTrue()
+	if pushLevel != gitlab.NoPermissions {
+		enforceAdmins = newFalse()
 	}
 
 	ret := &clients.BranchRef{
@@ -210,9 +342,19 @@ func makeBranchRefFrom(branch *gitlab.Branch, protectedBranch *gitlab.ProtectedB
 			RequiredPullRequestReviews: pullRequestReviewRule,
 			AllowDeletions:             newFalse(),
 			AllowForcePushes:           &protectedBranch.AllowForcePush,
-			EnforceAdmins:              newTrue(),
+			RequireLinearHistory:       requireLinearHistory,
+			EnforceAdmins:              enforceAdmins,
+			RequireLastPushApproval:    requireLastPushApproval,
 			CheckRules:                 statusChecksRule,
 		},
+		RuleSources: []clients.RuleSource{
+			{
+				Name:         &protectedBranch.Name,
+				Type:         clients.RuleSourceBranchProtection,
+				BypassActors: pushAccessActors(protectedBranch.PushAccessLevels),
+				Enforced:     true,
+			},
+		},
 	}
 
 	return ret
@@ -15,11 +15,17 @@
 package gitlabrepo
 
 import (
+	"encoding/json"
+	"errors"
 	"net/http"
+	"os"
 	"sync"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/xanzy/go-gitlab"
+
+	"github.com/ossf/scorecard/v4/clients"
 )
 
 func TestGetBranches(t *testing.T) {
@@ -106,6 +112,16 @@ func TestGetBranches(t *testing.T) {
 				) {
 					return tt.apprvlReturn, tt.returnStatus, nil
 				},
+				getApprovalRules: func(pid interface{}, opt *gitlab.GetProjectApprovalRulesListsOptions,
+					options ...gitlab.RequestOptionFunc,
+				) ([]*gitlab.ProjectApprovalRule, *gitlab.Response, error) {
+					return nil, tt.returnStatus, nil
+				},
+				queryProject: func(pid interface{}, opt *gitlab.GetProjectOptions,
+					options ...gitlab.RequestOptionFunc,
+				) (*gitlab.Project, *gitlab.Response, error) {
+					return &gitlab.Project{}, tt.returnStatus, nil
+				},
 			}
 
 			handler.once.Do(func() {})
@@ -131,3 +147,189 @@ func TestGetBranches(t *testing.T) {
 		})
 	}
 }
+
+func loadFixture(t *testing.T, path string, v interface{}) {
+	t.Helper()
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("cannot read fixture: %v", err)
+	}
+	if err := json.Unmarshal(content, v); err != nil {
+		t.Fatalf("cannot parse fixture: %v", err)
+	}
+}
+
+func TestGetBranchWithoutResponse(t *testing.T) {
+	t.Parallel()
+
+	ok := &gitlab.Response{
+		Response: &http.Response{
+			StatusCode: http.StatusOK,
+		},
+	}
+	handler := branchesHandler{
+		once: new(sync.Once),
+		repourl: &repoURL{
+			projectID: "5000",
+		},
+		queryBranch: func(pid interface{}, branch string,
+			options ...gitlab.RequestOptionFunc,
+		) (*gitlab.Branch, *gitlab.Response, error) {
+			return &gitlab.Branch{Name: branch, Protected: true}, ok, nil
+		},
+		getProtectedBranch: func(pid interface{}, branch string,
+			options ...gitlab.RequestOptionFunc,
+		) (*gitlab.ProtectedBranch, *gitlab.Response, error) {
+			return &gitlab.ProtectedBranch{}, ok, nil
+		},
+		getProjectChecks: func(pid interface{}, opt *gitlab.ListOptions,
+			options ...gitlab.RequestOptionFunc,
+		) ([]*gitlab.ProjectStatusCheck, *gitlab.Response, error) {
+			return nil, ok, nil
+		},
+		getApprovalConfiguration: func(pid interface{}, options ...gitlab.RequestOptionFunc) (
+			*gitlab.ProjectApprovals, *gitlab.Response, error,
+		) {
+			return &gitlab.ProjectApprovals{}, ok, nil
+		},
+		// Network errors are returned without a response.
+		getApprovalRules: func(pid interface{}, opt *gitlab.GetProjectApprovalRulesListsOptions,
+			options ...gitlab.RequestOptionFunc,
+		) ([]*gitlab.ProjectApprovalRule, *gitlab.Response, error) {
+			return nil, nil, errors.New("connection reset")
+		},
+	}
+
+	if _, err := handler.getBranch("main"); err == nil {
+		t.Errorf("expected an error")
+	}
+}
+
+func TestMakeBranchRefFrom(t *testing.T) {
+	t.Parallel()
+	trueVal := true
+	falseVal := false
+	zero := int32(0)
+	two := int32(2)
+	main := "main"
+
+	tests := []struct {
+		name                string
+		protectedBranchPath string
+		approvalsPath       string
+		approvalRulesPath   string
+		projectPath         string
+		expected            clients.BranchProtectionRule
+		bypassActors        []string
+	}{
+		{
+			name:                "maintainers can push",
+			protectedBranchPath: "./testdata/valid-protected-branch",
+			approvalsPath:       "./testdata/valid-approval-configuration",
+			approvalRulesPath:   "./testdata/valid-approval-rules",
+			projectPath:         "./testdata/valid-project",
+			expected: clients.BranchProtectionRule{
+				RequiredPullRequestReviews: clients.PullRequestReviewRule{
+					RequiredApprovingReviewCount: &two,
+					DismissStaleReviews:          &trueVal,
+					RequireCodeOwnerReviews:      &trueVal,
+				},
+				AllowDeletions:          &falseVal,
+				AllowForcePushes:        &falseVal,
+				RequireLinearHistory:    &trueVal,
+				EnforceAdmins:           &falseVal,
+				RequireLastPushApproval: &trueVal,
+				CheckRules: clients.StatusChecksRule{
+					UpToDateBeforeMerge:  &trueVal,
+					RequiresStatusChecks: &trueVal,
+					Contexts:             []string{gitlabPipelineContext, "compliance"},
+				},
+			},
+			bypassActors: []string{"Maintainers"},
+		},
+		{
+			name:                "no one can push",
+			protectedBranchPath: "./testdata/valid-protected-branch-no-push",
+			approvalsPath:       "./testdata/valid-approval-configuration",
+			approvalRulesPath:   "./testdata/valid-approval-rules",
+			projectPath:         "./testdata/valid-project",
+			expected: clients.BranchProtectionRule{
+				RequiredPullRequestReviews: clients.PullRequestReviewRule{
+					RequiredApprovingReviewCount: &two,
+					DismissStaleReviews:          &trueVal,
+					RequireCodeOwnerReviews:      &falseVal,
+				},
+				AllowDeletions:          &falseVal,
+				AllowForcePushes:        &falseVal,
+				RequireLinearHistory:    &trueVal,
+				EnforceAdmins:           &trueVal,
+				RequireLastPushApproval: &trueVal,
+				CheckRules: clients.StatusChecksRule{
+					UpToDateBeforeMerge:  &trueVal,
+					RequiresStatusChecks: &trueVal,
+					Contexts:             []string{gitlabPipelineContext, "compliance"},
+				},
+			},
+		},
+		{
+			name:                "developers can push without merge requests",
+			protectedBranchPath: "./testdata/valid-protected-branch-developers-push",
+			approvalsPath:       "./testdata/valid-approval-configuration",
+			approvalRulesPath:   "./testdata/valid-approval-rules",
+			projectPath:         "./testdata/valid-project",
+			expected: clients.BranchProtectionRule{
+				RequiredPullRequestReviews: clients.PullRequestReviewRule{
+					RequiredApprovingReviewCount: &zero,
+					DismissStaleReviews:          &trueVal,
+					RequireCodeOwnerReviews:      &falseVal,
+				},
+				AllowDeletions:          &falseVal,
+				AllowForcePushes:        &trueVal,
+				RequireLinearHistory:    &trueVal,
+				EnforceAdmins:           &falseVal,
+				RequireLastPushApproval: &trueVal,
+				CheckRules: clients.StatusChecksRule{
+					UpToDateBeforeMerge:  &trueVal,
+					RequiresStatusChecks: &trueVal,
+					Contexts:             []string{gitlabPipelineContext, "compliance"},
+				},
+			},
+			bypassActors: []string{"Developers + Maintainers"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var protectedBranch gitlab.ProtectedBranch
+			var approvals gitlab.ProjectApprovals
+			var approvalRules []*gitlab.ProjectApprovalRule
+			var project gitlab.Project
+			loadFixture(t, tt.protectedBranchPath, &protectedBranch)
+			loadFixture(t, tt.approvalsPath, &approvals)
+			loadFixture(t, tt.approvalRulesPath, &approvalRules)
+			loadFixture(t, tt.projectPath, &project)
+			statusChecks := []*gitlab.ProjectStatusCheck{{Name: "compliance"}}
+
+			br := makeBranchRefFrom(&gitlab.Branch{Name: main, Protected: true}, &protectedBranch, &project,
+				statusChecks, &approvals, approvalRules)
+
+			if diff := cmp.Diff(tt.expected, br.BranchProtectionRule); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+			expectedSources := []clients.RuleSource{
+				{
+					Name:         &main,
+					Type:         clients.RuleSourceBranchProtection,
+					BypassActors: tt.bypassActors,
+					Enforced:     true,
+				},
+			}
+			if diff := cmp.Diff(expectedSources, br.RuleSources); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -22,6 +22,13 @@ import (
 	"github.com/ossf/scorecard/v4/clients"
 )
 
+const (
+	// gitlabCIApp is the app reported for the pipelines and jobs of GitLab CI.
+	gitlabCIApp = "gitlab-ci"
+	// jobsPerPage is the number of pipeline jobs requested per page.
+	jobsPerPage = 100
+)
+
 type checkrunsHandler struct {
 	glClient *gitlab.Client
 	repourl  *repoURL
@@ -32,28 +39,124 @@ func (handler *checkrunsHandler) init(repourl *repoURL) {
 }
 
 func (handler *checkrunsHandler) listCheckRunsForRef(ref string) ([]clients.CheckRun, error) {
-	pipelines, _, err := handler.glClient.Pipelines.ListProjectPipelines(
-		handler.repourl.projectID, &gitlab.ListProjectPipelinesOptions{
-			SHA:         &ref,
-			ListOptions: gitlab.ListOptions{},
-		})
+	pipelines, err := handler.mergeRequestPipelines(ref)
 	if err != nil {
-		return nil, fmt.Errorf("request for pipelines returned error: %w", err)
+		return nil, err
+	}
+	if len(pipelines) == 0 {
+		pipelines, _, err = handler.glClient.Pipelines.ListProjectPipelines(
+			handler.repourl.projectID, &gitlab.ListProjectPipelinesOptions{
+				SHA:         &ref,
+				ListOptions: gitlab.ListOptions{},
+			})
+		if err != nil {
+			return nil, fmt.Errorf("request for pipelines returned error: %w", err)
+		}
 	}
 
-	return checkRunsFrom(pipelines), nil
+	var checkRuns []clients.CheckRun
+	for _, pipeline := range pipelines {
+		jobs, err := handler.listPipelineJobs(pipeline.ID)
+		if err != nil {
+			return nil, err
+		}
+		if len(jobs) == 0 {
+			checkRuns = append(checkRuns, checkRunFromPipeline(pipeline))
+			continue
+		}
+		for _, job := range jobs {
+			checkRuns = append(checkRuns, checkRunFromJob(job))
+		}
+	}
+	return checkRuns, nil
 }
 
-// Conclusion does not exist in the pipelines for gitlab so I have a placeholder "" as the value.
-func checkRunsFrom(data []*gitlab.PipelineInfo) []clients.CheckRun {
-	var checkRuns []clients.CheckRun
-	for _, pipelineInfo := range data {
-		// TODO: Can get more info from GitLab API here (e.g. pipeline name, URL)
-		// https://docs.gitlab.com/ee/api/pipelines.html#get-a-pipelines-test-report
-		checkRuns = append(checkRuns, clients.CheckRun{
-			Status: pipelineInfo.Status,
-			URL:    pipelineInfo.WebURL,
-		})
-	}
-	return checkRuns
+// listPipelineJobs returns all the jobs of a pipeline, across all the pages.
+func (handler *checkrunsHandler) listPipelineJobs(pipelineID int) ([]*gitlab.Job, error) {
+	var jobs []*gitlab.Job
+	opts := &gitlab.ListJobsOptions{
+		ListOptions: gitlab.ListOptions{
+			Page:    1,
+			PerPage: jobsPerPage,
+		},
+	}
+	for {
+		page, resp, err := handler.glClient.Jobs.ListPipelineJobs(handler.repourl.projectID, pipelineID, opts)
+		if err != nil {
+			return nil, fmt.Errorf("request for pipeline jobs returned error: %w", err)
+		}
+		jobs = append(jobs, page...)
+		if resp == nil || resp.NextPage == 0 {
+			return jobs, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// mergeRequestPipelines returns the latest pipeline of the merge request merged as ref.
+// The merge requests are tested by their own pipelines, which run on their head
+// commit or on the merge result, and not by the pipelines of the merge commit.
+func (handler *checkrunsHandler) mergeRequestPipelines(ref string) ([]*gitlab.PipelineInfo, error) {
+	mrs, _, err := handler.glClient.Commits.ListMergeRequestsByCommit(handler.repourl.projectID, ref)
+	if err != nil {
+		return nil, fmt.Errorf("request for merge requests returned error: %w", err)
+	}
+	for _, mr := range mrs {
+		if mr == nil || (mr.MergeCommitSHA != ref && mr.SquashCommitSHA != ref && mr.SHA != ref) {
+			continue
+		}
+		pipelines, _, err := handler.glClient.MergeRequests.ListMergeRequestPipelines(
+			handler.repourl.projectID, mr.IID)
+		if err != nil {
+			return nil, fmt.Errorf("request for merge request pipelines returned error: %w", err)
+		}
+		// The pipelines are sorted from the most recent.
+		if len(pipelines) > 0 {
+			return pipelines[:1], nil
+		}
+		return nil, nil
+	}
+	return nil, nil
+}
+
+func checkRunFromPipeline(pipeline *gitlab.PipelineInfo) clients.CheckRun {
+	status, conclusion := checkRunStatus(pipeline.Status)
+	return clients.CheckRun{
+		Status:     status,
+		Conclusion: conclusion,
+		URL:        pipeline.WebURL,
+		App: clients.CheckRunApp{
+			Slug: gitlabCIApp,
+		},
+	}
+}
+
+func checkRunFromJob(job *gitlab.Job) clients.CheckRun {
+	status, conclusion := checkRunStatus(job.Status)
+	return clients.CheckRun{
+		Status:     status,
+		Conclusion: conclusion,
+		URL:        job.WebURL,
+		Name:       job.Name,
+		App: clients.CheckRunApp{
+			Slug: gitlabCIApp,
+		},
+	}
+}
+
+// checkRunStatus maps the status of a GitLab pipeline or job
+// to the status and conclusion of a check run.
+func checkRunStatus(status string) (string, string) {
+	switch status {
+	case "success":
+		return "completed", "success"
+	case "failed":
+		return "completed", "failure"
+	case "canceled":
+		return "completed", "cancelled"
+	case "skipped":
+		return "completed", "skipped"
+	default:
+		return status, ""
+	}
 }
@@ -15,7 +15,11 @@
 package gitlabrepo
 
 import (
+	"fmt"
+	"io"
 	"net/http"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -27,32 +31,81 @@ import (
 func Test_CheckRuns(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
-		name         string
-		responsePath string
-		want         []clients.CheckRun
-		wantErr      bool
+		name          string
+		responsePaths map[string]string
+		ref           string
+		want          []clients.CheckRun
+		wantErr       bool
 	}{
 		{
-			name:         "valid checkruns",
-			responsePath: "./testdata/valid-checkruns",
+			name: "jobs of the merge request pipeline",
+			responsePaths: map[string]string{
+				"merge_requests": "./testdata/valid-commit-merge-requests",
+				"pipelines":      "./testdata/valid-checkruns",
+				"jobs":           "./testdata/valid-pipeline-jobs",
+			},
+			ref: "eb94b618fb5865b26e80fdd8ae531b7a63ad851a",
+			want: []clients.CheckRun{
+				{
+					Status:     "completed",
+					Conclusion: "success",
+					URL:        "https://example.com/foo/bar/-/jobs/7",
+					Name:       "unit-tests",
+					App:        clients.CheckRunApp{Slug: "gitlab-ci"},
+				},
+				{
+					Status:     "completed",
+					Conclusion: "failure",
+					URL:        "https://example.com/foo/bar/-/jobs/8",
+					Name:       "lint",
+					App:        clients.CheckRunApp{Slug: "gitlab-ci"},
+				},
+			},
+		},
+		{
+			name: "pipelines of a commit without merge request",
+			responsePaths: map[string]string{
+				"merge_requests": "./testdata/valid-checkruns-1",
+				"pipelines":      "./testdata/valid-checkruns",
+				"jobs":           "./testdata/valid-checkruns-1",
+			},
+			ref: "main",
 			want: []clients.CheckRun{
 				{
 					Status:     "pending",
 					URL:        "https://example.com/foo/bar/pipelines/48",
 					Conclusion: "",
+					App:        clients.CheckRunApp{Slug: "gitlab-ci"},
 				},
 			},
+		},
+		{
+			name: "valid checkruns with zero results",
+			responsePaths: map[string]string{
+				"merge_requests": "./testdata/valid-checkruns-1",
+				"pipelines":      "./testdata/valid-checkruns-1",
+			},
+			ref:     "main",
 			wantErr: false,
 		},
 		{
-			name:         "valid checkruns with zero results",
-			responsePath: "./testdata/valid-checkruns-1",
-			wantErr:      false,
+			name: "failure fetching the checkruns",
+			responsePaths: map[string]string{
+				"merge_requests": "./testdata/valid-checkruns-1",
+				"pipelines":      "./testdata/invalid-checkruns-result",
+			},
+			ref:     "main",
+			wantErr: true,
 		},
 		{
-			name:         "failure fetching the checkruns",
-			responsePath: "./testdata/invalid-checkruns-result",
-			wantErr:      true,
+			name: "failure fetching the jobs",
+			responsePaths: map[string]string{
+				"merge_requests": "./testdata/valid-checkruns-1",
+				"pipelines":      "./testdata/valid-checkruns",
+				"jobs":           "./testdata/invalid-jobs-result",
+			},
+			ref:     "main",
+			wantErr: true,
 		},
 	}
 	for _, tt := range tests {
@@ -60,8 +113,8 @@ func Test_CheckRuns(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 			httpClient := &http.Client{
-				Transport: stubTripper{
-					responsePath: tt.responsePath,
+				Transport: suffixStubTripper{
+					responsePaths: tt.responsePaths,
 				},
 			}
 			client, err := gitlab.NewClient("", gitlab.WithHTTPClient(httpClient))
@@ -77,7 +130,7 @@ func Test_CheckRuns(t *testing.T) {
 				commitSHA: clients.HeadSHA,
 			}
 			handler.init(&repoURL)
-			got, err := handler.listCheckRunsForRef("main")
+			got, err := handler.listCheckRunsForRef(tt.ref)
 			if (err != nil) != tt.wantErr {
 				t.Fatalf("checkRuns error: %v, wantedErr: %t", err, tt.wantErr)
 			}
@@ -88,3 +141,57 @@ func Test_CheckRuns(t *testing.T) {
 		})
 	}
 }
+
+// pagedJobsTripper serves one job per page, for the number of pages.
+type pagedJobsTripper struct {
+	pages int
+}
+
+func (p pagedJobsTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+	page, err := strconv.Atoi(r.URL.Query().Get("page"))
+	if err != nil {
+		//nolint:wrapcheck
+		return nil, err
+	}
+	header := http.Header{}
+	if page < p.pages {
+		header.Set("X-Next-Page", strconv.Itoa(page+1))
+	}
+	body := fmt.Sprintf(`[{"id": %d, "name": "job-%d", "status": "success"}]`, page, page)
+	return &http.Response{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Header:     header,
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}, nil
+}
+
+func Test_listPipelineJobs(t *testing.T) {
+	t.Parallel()
+	httpClient := &http.Client{
+		Transport: pagedJobsTripper{pages: 3},
+	}
+	client, err := gitlab.NewClient("", gitlab.WithHTTPClient(httpClient))
+	if err != nil {
+		t.Fatalf("gitlab.NewClient error: %v", err)
+	}
+	handler := &checkrunsHandler{
+		glClient: client,
+	}
+	handler.init(&repoURL{
+		owner:     "ossf-tests",
+		commitSHA: clients.HeadSHA,
+	})
+	jobs, err := handler.listPipelineJobs(1)
+	if err != nil {
+		t.Fatalf("listPipelineJobs error: %v", err)
+	}
+	var got []string
+	for _, job := range jobs {
+		got = append(got, job.Name)
+	}
+	want := []string{"job-1", "job-2", "job-3"}
+	if !cmp.Equal(got, want) {
+		t.Errorf("listPipelineJobs() = %v", cmp.Diff(want, got))
+	}
+}
@@ -45,11 +45,14 @@ type Client struct {
 	checkruns     *checkrunsHandler
 	commits       *commitsHandler
 	issues        *issuesHandler
+	mergeRequests *mergeRequestsHandler
 	project       *projectHandler
 	statuses      *statusesHandler
 	search        *searchHandler
 	searchCommits *searchCommitsHandler
 	webhook       *webhookHandler
+	security      *securitySettingsHandler
+	codeowners    *codeownersHandler
 	languages     *languagesHandler
 	licenses      *licensesHandler
 	tarball       *tarballHandler
@@ -125,6 +128,9 @@ func (client *Client) InitRepo(inputRepo clients.Repo, commitSHA string, commitD
 	// Init issuesHandler
 	client.issues.init(client.repourl)
 
+	// Init mergeRequestsHandler
+	client.mergeRequests.init(client.repourl)
+
 	// Init projectHandler
 	client.project.init(client.repourl)
 
@@ -146,6 +152,12 @@ func (client *Client) InitRepo(inputRepo clients.Repo, commitSHA string, commitD
 	// Init webhookHandler
 	client.webhook.init(client.repourl)
 
+	// Init securitySettingsHandler
+	client.security.init(client.repourl)
+
+	// Init codeownersHandler
+	client.codeowners.init(client.repourl)
+
 	// Init languagesHandler
 	client.languages.init(client.repourl)
 
@@ -203,6 +215,10 @@ func (client *Client) ListIssues() ([]clients.Issue, error) {
 	return client.issues.listIssues()
 }
 
+func (client *Client) ListPullRequests() ([]clients.PullRequest, error) {
+	return client.mergeRequests.listMergeRequests()
+}
+
 func (client *Client) ListReleases() ([]clients.Release, error) {
 	return client.releases.getReleases()
 }
@@ -235,10 +251,34 @@ func (client *Client) GetOrgRepoClient(ctx context.Context) (clients.RepoClient,
 	return nil, fmt.Errorf("GetOrgRepoClient (GitLab): %w", clients.ErrUnsupportedFeature)
 }
 
+func (client *Client) GetRepoClient(ctx context.Context, repo string) (clients.RepoClient, error) {
+	return nil, fmt.Errorf("GetRepoClient (GitLab): %w", clients.ErrUnsupportedFeature)
+}
+
+func (client *Client) ListTags() ([]clients.Tag, error) {
+	return nil, fmt.Errorf("ListTags (GitLab): %w", clients.ErrUnsupportedFeature)
+}
+
+func (client *Client) IsCommitReachable(commitSHA string) (bool, error) {
+	return false, fmt.Errorf("IsCommitReachable (GitLab): %w", clients.ErrUnsupportedFeature)
+}
+
 func (client *Client) ListWebhooks() ([]clients.Webhook, error) {
 	return client.webhook.listWebhooks()
 }
 
+func (client *Client) GetSecuritySettings() (clients.SecuritySettings, error) {
+	return client.security.getSecuritySettings()
+}
+
+func (client *Client) GetWorkflowPermissions() (clients.WorkflowPermissions, error) {
+	return client.security.getWorkflowPermissions()
+}
+
+func (client *Client) GetCodeOwnerAccess(owner string) (clients.CodeOwnerAccess, error) {
+	return client.codeowners.getCodeOwnerAccess(owner)
+}
+
 func (client *Client) ListSuccessfulWorkflowRuns(filename string) ([]clients.WorkflowRun, error) {
 	return client.workflows.listSuccessfulWorkflowRuns(filename)
 }
@@ -307,6 +347,9 @@ func CreateGitlabClientWithToken(ctx context.Context, token, host string) (clien
 		issues: &issuesHandler{
 			glClient: client,
 		},
+		mergeRequests: &mergeRequestsHandler{
+			glClient: client,
+		},
 		project: &projectHandler{
 			glClient: client,
 		},
@@ -322,6 +365,12 @@ func CreateGitlabClientWithToken(ctx context.Context, token, host string) (clien
 		webhook: &webhookHandler{
 			glClient: client,
 		},
+		security: &securitySettingsHandler{
+			glClient: client,
+		},
+		codeowners: &codeownersHandler{
+			glClient: client,
+		},
 		languages: &languagesHandler{
 			glClient: client,
 		},
@@ -0,0 +1,153 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitlabrepo
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/ossf/scorecard/v4/clients"
+)
+
+// codeownersRoles are the roles which can be used as owners, e.g. `@@maintainer`,
+// https://docs.gitlab.com/ee/user/project/codeowners/reference.html#add-a-role-as-a-code-owner.
+var codeownersRoles = map[string]bool{
+	"developer":   true,
+	"developers":  true,
+	"maintainer":  true,
+	"maintainers": true,
+	"owner":       true,
+	"owners":      true,
+}
+
+type codeownersHandler struct {
+	glClient *gitlab.Client
+	repourl  *repoURL
+	project  *gitlab.Project
+	mu       sync.Mutex
+	access   map[string]clients.CodeOwnerAccess
+}
+
+func (handler *codeownersHandler) init(repourl *repoURL) {
+	handler.repourl = repourl
+	handler.project = nil
+	handler.access = make(map[string]clients.CodeOwnerAccess)
+}
+
+// getCodeOwnerAccess returns the access of a user (`@user`), a group (`@group` or
+// `@group/subgroup`) or a role (`@@maintainer`). Owners identified by email cannot
+// be resolved by the API.
+func (handler *codeownersHandler) getCodeOwnerAccess(owner string) (clients.CodeOwnerAccess, error) {
+	if strings.HasPrefix(owner, "@@") {
+		if codeownersRoles[strings.ToLower(owner[2:])] {
+			return clients.CodeOwnerAccessWrite, nil
+		}
+		return clients.CodeOwnerAccessNotFound, nil
+	}
+	if !strings.HasPrefix(owner, "@") {
+		return clients.CodeOwnerAccessUnknown, nil
+	}
+	key := strings.ToLower(owner)
+
+	handler.mu.Lock()
+	defer handler.mu.Unlock()
+	if access, ok := handler.access[key]; ok {
+		return access, nil
+	}
+
+	name := owner[1:]
+	access := clients.CodeOwnerAccessNotFound
+	var err error
+	if !strings.Contains(name, "/") {
+		access, err = handler.getUserAccess(name)
+		if err != nil {
+			return clients.CodeOwnerAccessUnknown, err
+		}
+	}
+	if access == clients.CodeOwnerAccessNotFound {
+		access, err = handler.getGroupAccess(name)
+		if err != nil {
+			return clients.CodeOwnerAccessUnknown, err
+		}
+	}
+	handler.access[key] = access
+	return access, nil
+}
+
+func (handler *codeownersHandler) getUserAccess(username string) (clients.CodeOwnerAccess, error) {
+	users, _, err := handler.glClient.Users.ListUsers(&gitlab.ListUsersOptions{Username: &username})
+	if err != nil {
+		return clients.CodeOwnerAccessUnknown, fmt.Errorf("error listing users: %w", err)
+	}
+	if len(users) == 0 {
+		return clients.CodeOwnerAccessNotFound, nil
+	}
+
+	member, resp, err := handler.glClient.ProjectMembers.GetInheritedProjectMember(
+		handler.repourl.projectID, users[0].ID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return clients.CodeOwnerAccessRead, nil
+		}
+		if resp != nil && resp.StatusCode == http.StatusForbidden {
+			return clients.CodeOwnerAccessUnknown, nil
+		}
+		return clients.CodeOwnerAccessUnknown, fmt.Errorf("error getting project member %s: %w", username, err)
+	}
+	if member.AccessLevel >= gitlab.DeveloperPermissions {
+		return clients.CodeOwnerAccessWrite, nil
+	}
+	return clients.CodeOwnerAccessRead, nil
+}
+
+// getGroupAccess returns the access of a group, which is either an ancestor of the
+// project, or must be invited to the project to be a code owner.
+func (handler *codeownersHandler) getGroupAccess(fullPath string) (clients.CodeOwnerAccess, error) {
+	group, resp, err := handler.glClient.Groups.GetGroup(fullPath, &gitlab.GetGroupOptions{})
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return clients.CodeOwnerAccessNotFound, nil
+		}
+		if resp != nil && resp.StatusCode == http.StatusForbidden {
+			return clients.CodeOwnerAccessUnknown, nil
+		}
+		return clients.CodeOwnerAccessUnknown, fmt.Errorf("error getting group %s: %w", fullPath, err)
+	}
+
+	if handler.project == nil {
+		project, _, err := handler.glClient.Projects.GetProject(handler.repourl.projectID, &gitlab.GetProjectOptions{})
+		if err != nil {
+			return clients.CodeOwnerAccessUnknown, fmt.Errorf("error getting project: %w", err)
+		}
+		handler.project = project
+	}
+	if handler.project.Namespace != nil {
+		ns := strings.ToLower(handler.project.Namespace.FullPath)
+		gp := strings.ToLower(group.FullPath)
+		if ns == gp || strings.HasPrefix(ns, gp+"/") {
+			return clients.CodeOwnerAccessWrite, nil
+		}
+	}
+	for _, g := range handler.project.SharedWithGroups {
+		if g.GroupID == group.ID && gitlab.AccessLevelValue(g.GroupAccessLevel) >= gitlab.DeveloperPermissions {
+			return clients.CodeOwnerAccessWrite, nil
+		}
+	}
+	return clients.CodeOwnerAccessRead, nil
+}
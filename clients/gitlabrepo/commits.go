@@ -15,7 +15,9 @@
 package gitlabrepo
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
 	"os"
 	"strconv"
 	"strings"
@@ -26,18 +28,41 @@ import (
 	"github.com/ossf/scorecard/v4/clients"
 )
 
+// GitLab signature verification statuses.
+const (
+	signatureVerified       = "verified"
+	signatureVerifiedSystem = "verified_system"
+)
+
+// commitSignature is the signature of a commit. It is fetched with a raw request,
+// since go-gitlab only supports GPG signatures.
+type commitSignature struct {
+	SignatureType      string `json:"signature_type"`
+	VerificationStatus string `json:"verification_status"`
+	GPGKeyPrimaryKeyID string `json:"gpg_key_primary_keyid"`
+	GPGKeyUserEmail    string `json:"gpg_key_user_email"`
+	X509Certificate    *struct {
+		Email string `json:"email"`
+	} `json:"x509_certificate"`
+	Key *struct {
+		Title string `json:"title"`
+	} `json:"key"`
+}
+
 type commitsHandler struct {
 	glClient   *gitlab.Client
 	once       *sync.Once
 	errSetup   error
 	repourl    *repoURL
 	commitsRaw []*gitlab.Commit
+	signatures map[string]*clients.Signature
 }
 
 func (handler *commitsHandler) init(repourl *repoURL) {
 	handler.repourl = repourl
 	handler.errSetup = nil
 	handler.once = new(sync.Once)
+	handler.signatures = make(map[string]*clients.Signature)
 }
 
 func (handler *commitsHandler) setup() error {
@@ -53,6 +78,15 @@ func (handler *commitsHandler) setup() error {
 			return
 		}
 		handler.commitsRaw = commits
+		// The REST API doesn't return the signatures when listing commits.
+		for _, commit := range commits {
+			sig, err := handler.getSignature(commit.ID)
+			if err != nil {
+				handler.errSetup = fmt.Errorf("request for commit signature failed with %w", err)
+				return
+			}
+			handler.signatures[commit.ID] = sig
+		}
 		if handler.repourl.commitSHA != clients.HeadSHA {
 			//nolint:lll
 			// TODO(#3193): Fix the way graphql retrieves merge details to more closely
@@ -64,6 +98,57 @@ func (handler *commitsHandler) setup() error {
 	return handler.errSetup
 }
 
+// getSignature returns the signature of the commit, or nil if the commit is not signed.
+func (handler *commitsHandler) getSignature(sha string) (*clients.Signature, error) {
+	req, err := handler.glClient.NewRequest(http.MethodGet,
+		fmt.Sprintf("projects/%s/repository/commits/%s/signature", gitlab.PathEscape(handler.repourl.projectID), sha),
+		nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	var sig commitSignature
+	_, err = handler.glClient.Do(req, &sig)
+	var errResp *gitlab.ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response != nil && errResp.Response.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+	return signatureFrom(&sig), nil
+}
+
+func signatureFrom(sig *commitSignature) *clients.Signature {
+	ret := &clients.Signature{
+		Verified:      sig.VerificationStatus == signatureVerified || sig.VerificationStatus == signatureVerifiedSystem,
+		SignedByForge: sig.VerificationStatus == signatureVerifiedSystem,
+	}
+	if !ret.Verified {
+		ret.Reason = sig.VerificationStatus
+	}
+	switch strings.ToUpper(sig.SignatureType) {
+	case "PGP":
+		ret.Type = clients.SignatureTypeGPG
+		ret.Signer = sig.GPGKeyUserEmail
+		if ret.Signer == "" {
+			ret.Signer = sig.GPGKeyPrimaryKeyID
+		}
+	case "SSH":
+		ret.Type = clients.SignatureTypeSSH
+		if sig.Key != nil {
+			ret.Signer = sig.Key.Title
+		}
+	case "X509":
+		ret.Type = clients.SignatureTypeX509
+		if sig.X509Certificate != nil {
+			ret.Signer = sig.X509Certificate.Email
+		}
+	default:
+		ret.Type = clients.SignatureTypeUnknown
+	}
+	return ret
+}
+
 func (handler *commitsHandler) listRawCommits() ([]*gitlab.Commit, error) {
 	if err := handler.setup(); err != nil {
 		return nil, fmt.Errorf("error during commitsHandler.setup: %w", err)
@@ -159,6 +244,11 @@ func (handler *commitsHandler) zip(commitsRaw []*gitlab.Commit, data graphqlData
 				Message:                cRaw.Message,
 				SHA:                    cRaw.ID,
 				AssociatedMergeRequest: associatedMr,
+				Author: clients.CommitAuthor{
+					Name:  cRaw.AuthorName,
+					Email: cRaw.AuthorEmail,
+				},
+				Signature: handler.signatures[cRaw.ID],
 			})
 	}
 
@@ -16,6 +16,10 @@ package gitlabrepo
 
 import (
 	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v4/clients"
 )
 
 func TestParsingEmail(t *testing.T) {
@@ -56,3 +60,63 @@ func TestParsingEmail(t *testing.T) {
 		})
 	}
 }
+
+func TestSignatureFrom(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		sig      commitSignature
+		expected clients.Signature
+	}{
+		{
+			name: "verified GPG signature",
+			sig: commitSignature{
+				SignatureType:      "PGP",
+				VerificationStatus: "verified",
+				GPGKeyPrimaryKeyID: "8254AAB3FBD54AC9",
+				GPGKeyUserEmail:    "john.doe@example.com",
+			},
+			expected: clients.Signature{
+				Type:     clients.SignatureTypeGPG,
+				Signer:   "john.doe@example.com",
+				Verified: true,
+			},
+		},
+		{
+			name: "unverified X.509 signature",
+			sig: commitSignature{
+				SignatureType:      "X509",
+				VerificationStatus: "unverified",
+			},
+			expected: clients.Signature{
+				Type:   clients.SignatureTypeX509,
+				Reason: "unverified",
+			},
+		},
+		{
+			name: "web commit signed by GitLab",
+			sig: commitSignature{
+				SignatureType:      "SSH",
+				VerificationStatus: "verified_system",
+			},
+			expected: clients.Signature{
+				Type:          clients.SignatureTypeSSH,
+				Verified:      true,
+				SignedByForge: true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := signatureFrom(&tt.sig)
+			if diff := cmp.Diff(tt.expected, *got); diff != "" {
+				t.Errorf("signatureFrom() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
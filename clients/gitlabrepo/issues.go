@@ -59,24 +59,25 @@ func (handler *issuesHandler) setup() error {
 			return
 		}
 
-		var authorAssociation clients.RepoAssociation
 		for _, issue := range issues {
-			for _, m := range projMemberships {
-				if issue.Author.ID == m.ID {
-					authorAssociation = accessLevelToRepoAssociation(m.AccessLevel)
-				}
+			notes, _, err := handler.glClient.Notes.ListIssueNotes(
+				handler.repourl.projectID, issue.IID, &gitlab.ListIssueNotesOptions{})
+			if err != nil {
+				handler.errSetup = fmt.Errorf("unable to find notes of the issue %d: %w", issue.IID, err)
+				return
 			}
-
+			authorAssociation := memberAssociation(issue.Author.ID, projMemberships)
 			issueIDString := fmt.Sprint(issue.ID)
 			handler.issues = append(handler.issues,
 				clients.Issue{
 					URI:       &issueIDString,
 					CreatedAt: issue.CreatedAt,
+					ClosedAt:  issue.ClosedAt,
 					Author: &clients.User{
 						ID: int64(issue.Author.ID),
 					},
 					AuthorAssociation: &authorAssociation,
-					Comments:          nil,
+					Comments:          commentsFromNotes(notes, projMemberships),
 				})
 		}
 	})
@@ -91,6 +92,37 @@ func (handler *issuesHandler) listIssues() ([]clients.Issue, error) {
 	return handler.issues, nil
 }
 
+// memberAssociation returns the association of the user with the project.
+func memberAssociation(userID int, members []*gitlab.ProjectMember) clients.RepoAssociation {
+	for _, m := range members {
+		if m.ID == userID {
+			return accessLevelToRepoAssociation(m.AccessLevel)
+		}
+	}
+	return clients.RepoAssociationNone
+}
+
+// commentsFromNotes returns the comments of the users among the notes of an
+// issue or a merge request, skipping the notes generated by GitLab.
+func commentsFromNotes(notes []*gitlab.Note, members []*gitlab.ProjectMember) []clients.IssueComment {
+	var comments []clients.IssueComment
+	for _, note := range notes {
+		if note.System {
+			continue
+		}
+		association := memberAssociation(note.Author.ID, members)
+		comments = append(comments, clients.IssueComment{
+			CreatedAt: note.CreatedAt,
+			Author: &clients.User{
+				ID:    int64(note.Author.ID),
+				Login: note.Author.Username,
+			},
+			AuthorAssociation: &association,
+		})
+	}
+	return comments
+}
+
 func accessLevelToRepoAssociation(l gitlab.AccessLevelValue) clients.RepoAssociation {
 	switch l {
 	case 0:
@@ -64,6 +64,7 @@ func Test_listIssues(t *testing.T) {
 		name       string
 		issuePath  string
 		memberPath string
+		notesPath  string
 		want       []clients.Issue
 		wantErr    bool
 	}{
@@ -71,6 +72,7 @@ func Test_listIssues(t *testing.T) {
 			name:       "issue with maintainer as author",
 			issuePath:  "./testdata/valid-issues",
 			memberPath: "./testdata/valid-repo-members",
+			notesPath:  "./testdata/valid-notes",
 			want: []clients.Issue{
 				{
 					URI:       strptr("131356518"),
@@ -79,6 +81,16 @@ func Test_listIssues(t *testing.T) {
 						ID: 1355794,
 					},
 					AuthorAssociation: associationptr(clients.RepoAssociationMaintainer),
+					Comments: []clients.IssueComment{
+						{
+							CreatedAt: timeptr(time.Date(2023, time.July, 27, 10, 3, 11, 0, time.UTC)),
+							Author: &clients.User{
+								ID:    3332021,
+								Login: "owner1",
+							},
+							AuthorAssociation: associationptr(clients.RepoAssociationOwner),
+						},
+					},
 				},
 			},
 			wantErr: false,
@@ -99,6 +111,7 @@ func Test_listIssues(t *testing.T) {
 					responsePaths: map[string]string{
 						"issues": tt.issuePath,  // corresponds to projects/<id>/issues
 						"all":    tt.memberPath, // corresponds to projects/<id>/members/all
+						"notes":  tt.notesPath,  // corresponds to projects/<id>/issues/<iid>/notes
 					},
 				},
 			}
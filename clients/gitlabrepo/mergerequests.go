@@ -0,0 +1,111 @@
+// Copyright 2022 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitlabrepo
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/ossf/scorecard/v4/clients"
+)
+
+// mergeRequestsToAnalyze is the number of recently updated merge requests
+// returned by ListPullRequests.
+const mergeRequestsToAnalyze = 30
+
+type mergeRequestsHandler struct {
+	glClient      *gitlab.Client
+	once          *sync.Once
+	errSetup      error
+	repourl       *repoURL
+	mergeRequests []clients.PullRequest
+}
+
+func (handler *mergeRequestsHandler) init(repourl *repoURL) {
+	handler.repourl = repourl
+	handler.errSetup = nil
+	handler.once = new(sync.Once)
+	handler.mergeRequests = nil
+}
+
+func (handler *mergeRequestsHandler) setup() error {
+	handler.once.Do(func() {
+		mrs, _, err := handler.glClient.MergeRequests.ListProjectMergeRequests(
+			handler.repourl.projectID, &gitlab.ListProjectMergeRequestsOptions{
+				ListOptions: gitlab.ListOptions{PerPage: mergeRequestsToAnalyze},
+				OrderBy:     gitlab.String("updated_at"),
+			})
+		if err != nil {
+			handler.errSetup = fmt.Errorf("unable to find merge requests associated with the project id: %w", err)
+			return
+		}
+
+		projMemberships, resp, err := handler.glClient.ProjectMembers.ListAllProjectMembers(
+			handler.repourl.projectID, &gitlab.ListProjectMembersOptions{})
+		if err != nil && resp.StatusCode != http.StatusUnauthorized {
+			handler.errSetup = fmt.Errorf("unable to find access tokens associated with the project id: %w", err)
+			return
+		} else if resp.StatusCode == http.StatusUnauthorized {
+			handler.errSetup = fmt.Errorf("insufficient permissions to check merge request author associations %w", err)
+			return
+		}
+
+		for _, mr := range mrs {
+			notes, _, err := handler.glClient.Notes.ListMergeRequestNotes(
+				handler.repourl.projectID, mr.IID, &gitlab.ListMergeRequestNotesOptions{})
+			if err != nil {
+				handler.errSetup = fmt.Errorf("unable to find notes of the merge request %d: %w", mr.IID, err)
+				return
+			}
+			pr := clients.PullRequest{
+				Number:   mr.IID,
+				HeadSHA:  mr.SHA,
+				Comments: commentsFromNotes(notes, projMemberships),
+			}
+			if mr.Author != nil {
+				association := memberAssociation(mr.Author.ID, projMemberships)
+				pr.Author = clients.User{
+					ID:    int64(mr.Author.ID),
+					Login: mr.Author.Username,
+				}
+				pr.AuthorAssociation = &association
+			}
+			if mr.CreatedAt != nil {
+				pr.CreatedAt = *mr.CreatedAt
+			}
+			if mr.ClosedAt != nil {
+				pr.ClosedAt = *mr.ClosedAt
+			}
+			if mr.MergedAt != nil {
+				pr.MergedAt = *mr.MergedAt
+				// GitLab does not set the closing time of the merged merge requests.
+				pr.ClosedAt = *mr.MergedAt
+			}
+			handler.mergeRequests = append(handler.mergeRequests, pr)
+		}
+	})
+	return handler.errSetup
+}
+
+func (handler *mergeRequestsHandler) listMergeRequests() ([]clients.PullRequest, error) {
+	if err := handler.setup(); err != nil {
+		return nil, fmt.Errorf("error during mergeRequestsHandler.setup: %w", err)
+	}
+
+	return handler.mergeRequests, nil
+}
@@ -0,0 +1,106 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitlabrepo
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/ossf/scorecard/v4/clients"
+)
+
+func Test_listMergeRequests(t *testing.T) {
+	t.Parallel()
+	mergedAt := time.Date(2023, time.July, 29, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		mrPath  string
+		want    []clients.PullRequest
+		wantErr bool
+	}{
+		{
+			name:   "merge request of an external contributor",
+			mrPath: "./testdata/valid-merge-requests",
+			want: []clients.PullRequest{
+				{
+					Number:   2,
+					HeadSHA:  "8d1f0c4e2b9a7d6c5b4a39281706f5e4d3c2b1a0",
+					MergedAt: mergedAt,
+					Author: clients.User{
+						ID:    7654321,
+						Login: "contributor1",
+					},
+					CreatedAt:         time.Date(2023, time.July, 28, 8, 0, 0, 0, time.UTC),
+					ClosedAt:          mergedAt,
+					AuthorAssociation: associationptr(clients.RepoAssociationNone),
+					Comments: []clients.IssueComment{
+						{
+							CreatedAt: timeptr(time.Date(2023, time.July, 27, 10, 3, 11, 0, time.UTC)),
+							Author: &clients.User{
+								ID:    3332021,
+								Login: "owner1",
+							},
+							AuthorAssociation: associationptr(clients.RepoAssociationOwner),
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "failure fetching merge requests",
+			mrPath:  "./testdata/invalid-merge-requests",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			httpClient := &http.Client{
+				Transport: suffixStubTripper{
+					responsePaths: map[string]string{
+						"merge_requests": tt.mrPath,                       // corresponds to projects/<id>/merge_requests
+						"all":            "./testdata/valid-repo-members", // corresponds to projects/<id>/members/all
+						"notes":          "./testdata/valid-notes",        // corresponds to projects/<id>/merge_requests/<iid>/notes
+					},
+				},
+			}
+			client, err := gitlab.NewClient("", gitlab.WithHTTPClient(httpClient))
+			if err != nil {
+				t.Fatalf("gitlab.NewClient error: %v", err)
+			}
+			handler := &mergeRequestsHandler{
+				glClient: client,
+			}
+
+			repoURL := repoURL{
+				owner:     "ossf-tests",
+				commitSHA: clients.HeadSHA,
+			}
+			handler.init(&repoURL)
+			got, err := handler.listMergeRequests()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("listMergeRequests error: %v, wantedErr: %t", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("listMergeRequests() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitlabrepo
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/ossf/scorecard/v4/clients"
+)
+
+// projectSecuritySettings holds the project attributes which are not yet exposed by go-gitlab.
+// They are only returned to project maintainers, and on the tiers supporting them.
+type projectSecuritySettings struct {
+	PreReceiveSecretDetectionEnabled   *bool `json:"pre_receive_secret_detection_enabled"`
+	CIPushRepositoryForJobTokenAllowed *bool `json:"ci_push_repository_for_job_token_allowed"`
+}
+
+type securitySettingsHandler struct {
+	glClient            *gitlab.Client
+	once                *sync.Once
+	errSetup            error
+	repourl             *repoURL
+	settings            clients.SecuritySettings
+	workflowPermissions clients.WorkflowPermissions
+}
+
+func (handler *securitySettingsHandler) init(repourl *repoURL) {
+	handler.repourl = repourl
+	handler.errSetup = nil
+	handler.once = new(sync.Once)
+	handler.settings = clients.SecuritySettings{}
+	handler.workflowPermissions = clients.WorkflowPermissions{}
+}
+
+func (handler *securitySettingsHandler) setup() error {
+	handler.once.Do(func() {
+		if !strings.EqualFold(handler.repourl.commitSHA, clients.HeadSHA) {
+			handler.errSetup = fmt.Errorf("%w: security settings only supported for HEAD queries",
+				clients.ErrUnsupportedFeature)
+			return
+		}
+
+		req, err := handler.glClient.NewRequest(http.MethodGet,
+			fmt.Sprintf("projects/%s", gitlab.PathEscape(handler.repourl.projectID)), nil, nil)
+		if err != nil {
+			handler.errSetup = fmt.Errorf("request for project failed with error %w", err)
+			return
+		}
+		var proj projectSecuritySettings
+		if _, err := handler.glClient.Do(req, &proj); err != nil {
+			handler.errSetup = fmt.Errorf("request for project failed with error %w", err)
+			return
+		}
+
+		// Secret push protection is GitLab's equivalent of push protection. Secret detection
+		// runs as a CI job, and GitLab has no equivalent of Dependabot security updates or
+		// private vulnerability reporting, so these settings stay unavailable.
+		handler.settings.SecretScanningPushProtection = proj.PreReceiveSecretDetectionEnabled
+		if proj.CIPushRepositoryForJobTokenAllowed != nil {
+			readOnly := !*proj.CIPushRepositoryForJobTokenAllowed
+			handler.workflowPermissions.DefaultTokenReadOnly = &readOnly
+		}
+		// The API does not tell whether CI job tokens can approve merge requests,
+		// so CanApprovePullRequests is left unset.
+	})
+
+	return handler.errSetup
+}
+
+func (handler *securitySettingsHandler) getSecuritySettings() (clients.SecuritySettings, error) {
+	if err := handler.setup(); err != nil {
+		return clients.SecuritySettings{}, fmt.Errorf("error during securitySettingsHandler.setup: %w", err)
+	}
+	return handler.settings, nil
+}
+
+func (handler *securitySettingsHandler) getWorkflowPermissions() (clients.WorkflowPermissions, error) {
+	if err := handler.setup(); err != nil {
+		return clients.WorkflowPermissions{}, fmt.Errorf("error during securitySettingsHandler.setup: %w", err)
+	}
+	return handler.workflowPermissions, nil
+}
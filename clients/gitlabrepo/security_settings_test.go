@@ -0,0 +1,88 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gitlabrepo
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/xanzy/go-gitlab"
+
+	"github.com/ossf/scorecard/v4/clients"
+)
+
+func Test_getSecuritySettings(t *testing.T) {
+	t.Parallel()
+	enabled := true
+	tests := []struct {
+		name                    string
+		responsePath            string
+		wantSettings            clients.SecuritySettings
+		wantWorkflowPermissions clients.WorkflowPermissions
+	}{
+		{
+			name:         "maintainer token",
+			responsePath: "./testdata/valid-project-security-settings",
+			wantSettings: clients.SecuritySettings{
+				SecretScanningPushProtection: &enabled,
+			},
+			wantWorkflowPermissions: clients.WorkflowPermissions{
+				DefaultTokenReadOnly: &enabled,
+			},
+		},
+		{
+			name:         "settings not returned",
+			responsePath: "./testdata/valid-project",
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			httpClient := &http.Client{
+				Transport: stubTripper{
+					responsePath: tt.responsePath,
+				},
+			}
+			client, err := gitlab.NewClient("", gitlab.WithHTTPClient(httpClient))
+			if err != nil {
+				t.Fatalf("gitlab.NewClient error: %v", err)
+			}
+			handler := &securitySettingsHandler{
+				glClient: client,
+			}
+			handler.init(&repoURL{
+				owner:     "ossf-tests",
+				projectID: "5000",
+				commitSHA: clients.HeadSHA,
+			})
+			settings, err := handler.getSecuritySettings()
+			if err != nil {
+				t.Fatalf("getSecuritySettings: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantSettings, settings); diff != "" {
+				t.Errorf("getSecuritySettings() mismatch (-want +got):\n%s", diff)
+			}
+			permissions, err := handler.getWorkflowPermissions()
+			if err != nil {
+				t.Fatalf("getWorkflowPermissions: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantWorkflowPermissions, permissions); diff != "" {
+				t.Errorf("getWorkflowPermissions() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
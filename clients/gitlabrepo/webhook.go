@@ -16,6 +16,7 @@ package gitlabrepo
 
 import (
 	"fmt"
+	"net/http"
 	"sync"
 
 	"github.com/xanzy/go-gitlab"
@@ -23,6 +24,17 @@ import (
 	"github.com/ossf/scorecard/v4/clients"
 )
 
+// hookAlertStatusDisabled is the alert status of a hook GitLab
+// stopped delivering events to after repeated failures.
+const hookAlertStatusDisabled = "disabled"
+
+// projectHook is a project hook with the fields not exposed by the
+// gitlab package.
+type projectHook struct {
+	gitlab.ProjectHook
+	AlertStatus string `json:"alert_status"`
+}
+
 type webhookHandler struct {
 	glClient *gitlab.Client
 	once     *sync.Once
@@ -39,20 +51,31 @@ func (handler *webhookHandler) init(repourl *repoURL) {
 
 func (handler *webhookHandler) setup() error {
 	handler.once.Do(func() {
-		projectHooks, _, err := handler.glClient.Projects.ListProjectHooks(
-			handler.repourl.projectID, &gitlab.ListProjectHooksOptions{})
+		req, err := handler.glClient.NewRequest(http.MethodGet,
+			fmt.Sprintf("projects/%s/hooks", gitlab.PathEscape(handler.repourl.projectID)),
+			&gitlab.ListProjectHooksOptions{}, nil)
 		if err != nil {
 			handler.errSetup = fmt.Errorf("request for project hooks failed with %w", err)
 			return
 		}
+		var projectHooks []*projectHook
+		if _, err := handler.glClient.Do(req, &projectHooks); err != nil {
+			handler.errSetup = fmt.Errorf("request for project hooks failed with %w", err)
+			return
+		}
 
-		// TODO: make sure that enablesslverification is similarly equivalent to auth secret.
 		for _, hook := range projectHooks {
+			scheme, host := clients.RedactWebhookURL(hook.URL)
 			handler.webhooks = append(handler.webhooks,
 				clients.Webhook{
-					Path:           hook.URL,
-					ID:             int64(hook.ID),
-					UsesAuthSecret: hook.EnableSSLVerification,
+					Path:   host,
+					Scheme: scheme,
+					Events: hookEvents(&hook.ProjectHook),
+					ID:     int64(hook.ID),
+					// GitLab does not report whether a secret token is configured.
+					AuthSecretUnknown: true,
+					SSLVerification:   hook.EnableSSLVerification,
+					Active:            hook.AlertStatus != hookAlertStatusDisabled,
 				})
 		}
 	})
@@ -67,3 +90,31 @@ func (handler *webhookHandler) listWebhooks() ([]clients.Webhook, error) {
 
 	return handler.webhooks, nil
 }
+
+// hookEvents returns the events a project hook is subscribed to.
+func hookEvents(hook *gitlab.ProjectHook) []string {
+	subscriptions := []struct {
+		event      string
+		subscribed bool
+	}{
+		{"push", hook.PushEvents},
+		{"tag_push", hook.TagPushEvents},
+		{"issues", hook.IssuesEvents},
+		{"confidential_issues", hook.ConfidentialIssuesEvents},
+		{"merge_requests", hook.MergeRequestsEvents},
+		{"note", hook.NoteEvents},
+		{"confidential_note", hook.ConfidentialNoteEvents},
+		{"job", hook.JobEvents},
+		{"pipeline", hook.PipelineEvents},
+		{"wiki_page", hook.WikiPageEvents},
+		{"deployment", hook.DeploymentEvents},
+		{"releases", hook.ReleasesEvents},
+	}
+	var events []string
+	for _, s := range subscriptions {
+		if s.subscribed {
+			events = append(events, s.event)
+		}
+	}
+	return events
+}
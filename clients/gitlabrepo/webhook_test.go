@@ -55,9 +55,30 @@ func Test_listWebhooks(t *testing.T) {
 			responsePath: "./testdata/valid-webhook",
 			want: []clients.Webhook{
 				{
-					ID:             1,
-					Path:           "http://example.com/hook",
-					UsesAuthSecret: true,
+					ID:     1,
+					Path:   "example.com",
+					Scheme: "http",
+					Events: []string{
+						"push", "tag_push", "issues", "confidential_issues", "merge_requests", "note",
+						"confidential_note", "job", "pipeline", "wiki_page", "deployment", "releases",
+					},
+					AuthSecretUnknown: true,
+					SSLVerification:   true,
+					Active:            true,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:         "disabled webhook",
+			responsePath: "./testdata/valid-webhook-disabled",
+			want: []clients.Webhook{
+				{
+					ID:                2,
+					Path:              "hooks.example.com",
+					Scheme:            "https",
+					Events:            []string{"push"},
+					AuthSecretUnknown: true,
 				},
 			},
 			wantErr: false,
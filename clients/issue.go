@@ -20,6 +20,7 @@ import "time"
 type Issue struct {
 	URI               *string
 	CreatedAt         *time.Time
+	ClosedAt          *time.Time
 	Author            *User
 	AuthorAssociation *RepoAssociation
 	Comments          []IssueComment
@@ -29,12 +29,14 @@ import (
 	"time"
 
 	clients "github.com/ossf/scorecard/v4/clients"
+	"github.com/ossf/scorecard/v4/clients/git"
 	"github.com/ossf/scorecard/v4/log"
 )
 
 var (
-	_                clients.RepoClient = &localDirClient{}
-	errInputRepoType                    = errors.New("input repo should be of type repoLocal")
+	_                  clients.RepoClient = &localDirClient{}
+	errInputRepoType                      = errors.New("input repo should be of type repoLocal")
+	errInputClientType                    = errors.New("input client should be of type localDirClient")
 )
 
 //nolint:govet
@@ -46,6 +48,10 @@ type localDirClient struct {
 	errFiles    error
 	files       []string
 	commitDepth int
+	gitOnce     *sync.Once
+	gitClient   *git.Client
+	errGit      error
+	keyring     string
 }
 
 // InitRepo sets up the local repo.
@@ -60,10 +66,29 @@ func (client *localDirClient) InitRepo(inputRepo clients.Repo, commitSHA string,
 		client.commitDepth = commitDepth
 	}
 	client.path = strings.TrimPrefix(localRepo.URI(), "file://")
+	client.gitOnce = new(sync.Once)
+	client.gitClient = nil
+	client.errGit = nil
 
 	return nil
 }
 
+// gitRepo returns a client for the git repository containing the local folder.
+func (client *localDirClient) gitRepo() (*git.Client, error) {
+	client.gitOnce.Do(func() {
+		c := &git.Client{}
+		if err := c.OpenRepo(client.path, client.commitDepth); err != nil {
+			client.errGit = fmt.Errorf("%w: not a git repository: %v", clients.ErrUnsupportedFeature, err)
+			return
+		}
+		if client.keyring != "" {
+			c.SetKeyring(client.keyring)
+		}
+		client.gitClient = c
+	})
+	return client.gitClient, client.errGit
+}
+
 // URI implements RepoClient.URI.
 func (client *localDirClient) URI() string {
 	return fmt.Sprintf("file://%s", client.path)
@@ -191,7 +216,15 @@ func (client *localDirClient) GetDefaultBranchName() (string, error) {
 
 // ListCommits implements RepoClient.ListCommits.
 func (client *localDirClient) ListCommits() ([]clients.Commit, error) {
-	return nil, fmt.Errorf("ListCommits: %w", clients.ErrUnsupportedFeature)
+	c, err := client.gitRepo()
+	if err != nil {
+		return nil, fmt.Errorf("ListCommits: %w", err)
+	}
+	commits, err := c.ListCommits()
+	if err != nil {
+		return nil, fmt.Errorf("ListCommits: %w", err)
+	}
+	return commits, nil
 }
 
 // ListIssues implements RepoClient.ListIssues.
@@ -199,6 +232,11 @@ func (client *localDirClient) ListIssues() ([]clients.Issue, error) {
 	return nil, fmt.Errorf("ListIssues: %w", clients.ErrUnsupportedFeature)
 }
 
+// ListPullRequests implements RepoClient.ListPullRequests.
+func (client *localDirClient) ListPullRequests() ([]clients.PullRequest, error) {
+	return nil, fmt.Errorf("ListPullRequests: %w", clients.ErrUnsupportedFeature)
+}
+
 // ListReleases implements RepoClient.ListReleases.
 func (client *localDirClient) ListReleases() ([]clients.Release, error) {
 	return nil, fmt.Errorf("ListReleases: %w", clients.ErrUnsupportedFeature)
@@ -229,6 +267,21 @@ func (client *localDirClient) ListWebhooks() ([]clients.Webhook, error) {
 	return nil, fmt.Errorf("ListWebhooks: %w", clients.ErrUnsupportedFeature)
 }
 
+// GetSecuritySettings implements RepoClient.GetSecuritySettings.
+func (client *localDirClient) GetSecuritySettings() (clients.SecuritySettings, error) {
+	return clients.SecuritySettings{}, fmt.Errorf("GetSecuritySettings: %w", clients.ErrUnsupportedFeature)
+}
+
+// GetWorkflowPermissions implements RepoClient.GetWorkflowPermissions.
+func (client *localDirClient) GetWorkflowPermissions() (clients.WorkflowPermissions, error) {
+	return clients.WorkflowPermissions{}, fmt.Errorf("GetWorkflowPermissions: %w", clients.ErrUnsupportedFeature)
+}
+
+// GetCodeOwnerAccess implements RepoClient.GetCodeOwnerAccess.
+func (client *localDirClient) GetCodeOwnerAccess(owner string) (clients.CodeOwnerAccess, error) {
+	return clients.CodeOwnerAccessUnknown, fmt.Errorf("GetCodeOwnerAccess: %w", clients.ErrUnsupportedFeature)
+}
+
 // Search implements RepoClient.Search.
 func (client *localDirClient) Search(request clients.SearchRequest) (clients.SearchResponse, error) {
 	return clients.SearchResponse{}, fmt.Errorf("Search: %w", clients.ErrUnsupportedFeature)
@@ -263,6 +316,29 @@ func (client *localDirClient) GetOrgRepoClient(ctx context.Context) (clients.Rep
 	return nil, fmt.Errorf("GetOrgRepoClient: %w", clients.ErrUnsupportedFeature)
 }
 
+// GetRepoClient implements RepoClient.GetRepoClient.
+func (client *localDirClient) GetRepoClient(ctx context.Context, repo string) (clients.RepoClient, error) {
+	return nil, fmt.Errorf("GetRepoClient: %w", clients.ErrUnsupportedFeature)
+}
+
+// ListTags implements RepoClient.ListTags.
+func (client *localDirClient) ListTags() ([]clients.Tag, error) {
+	c, err := client.gitRepo()
+	if err != nil {
+		return nil, fmt.Errorf("ListTags: %w", err)
+	}
+	tags, err := c.ListTags()
+	if err != nil {
+		return nil, fmt.Errorf("ListTags: %w", err)
+	}
+	return tags, nil
+}
+
+// IsCommitReachable implements RepoClient.IsCommitReachable.
+func (client *localDirClient) IsCommitReachable(commitSHA string) (bool, error) {
+	return false, fmt.Errorf("IsCommitReachable: %w", clients.ErrUnsupportedFeature)
+}
+
 // CreateLocalDirClient returns a client which implements RepoClient interface.
 func CreateLocalDirClient(ctx context.Context, logger *log.Logger) clients.RepoClient {
 	return &localDirClient{
@@ -270,3 +346,14 @@ func CreateLocalDirClient(ctx context.Context, logger *log.Logger) clients.RepoC
 		logger: logger,
 	}
 }
+
+// SetSigningKeyring sets the armored OpenPGP keyring used to verify the signatures
+// of the commits and tags of a client returned by CreateLocalDirClient.
+func SetSigningKeyring(repoClient clients.RepoClient, armoredKeyRing string) error {
+	client, ok := repoClient.(*localDirClient)
+	if !ok {
+		return fmt.Errorf("%w: %T", errInputClientType, repoClient)
+	}
+	client.keyring = armoredKeyRing
+	return nil
+}
@@ -200,3 +200,17 @@ func TestClient_GetFileListAndContent(t *testing.T) {
 		})
 	}
 }
+
+func TestSetSigningKeyring(t *testing.T) {
+	t.Parallel()
+	client := CreateLocalDirClient(context.Background(), log.NewLogger(log.DebugLevel))
+	if err := SetSigningKeyring(client, "keyring"); err != nil {
+		t.Fatalf("SetSigningKeyring: %v", err)
+	}
+	if got := client.(*localDirClient).keyring; got != "keyring" {
+		t.Errorf("keyring = %q, want %q", got, "keyring")
+	}
+	if err := SetSigningKeyring(nil, "keyring"); !errors.Is(err, errInputClientType) {
+		t.Errorf("SetSigningKeyring: %v, expected %v", err, errInputClientType)
+	}
+}
@@ -155,6 +155,21 @@ func (mr *MockRepoClientMockRecorder) GetOrgRepoClient(arg0 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrgRepoClient", reflect.TypeOf((*MockRepoClient)(nil).GetOrgRepoClient), arg0)
 }
 
+// GetRepoClient mocks base method.
+func (m *MockRepoClient) GetRepoClient(ctx context.Context, repo string) (clients.RepoClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRepoClient", ctx, repo)
+	ret0, _ := ret[0].(clients.RepoClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRepoClient indicates an expected call of GetRepoClient.
+func (mr *MockRepoClientMockRecorder) GetRepoClient(ctx, repo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRepoClient", reflect.TypeOf((*MockRepoClient)(nil).GetRepoClient), ctx, repo)
+}
+
 // InitRepo mocks base method.
 func (m *MockRepoClient) InitRepo(repo clients.Repo, commitSHA string, commitDepth int) error {
 	m.ctrl.T.Helper()
@@ -184,6 +199,21 @@ func (mr *MockRepoClientMockRecorder) IsArchived() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsArchived", reflect.TypeOf((*MockRepoClient)(nil).IsArchived))
 }
 
+// IsCommitReachable mocks base method.
+func (m *MockRepoClient) IsCommitReachable(commitSHA string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsCommitReachable", commitSHA)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsCommitReachable indicates an expected call of IsCommitReachable.
+func (mr *MockRepoClientMockRecorder) IsCommitReachable(commitSHA interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsCommitReachable", reflect.TypeOf((*MockRepoClient)(nil).IsCommitReachable), commitSHA)
+}
+
 // ListCheckRunsForRef mocks base method.
 func (m *MockRepoClient) ListCheckRunsForRef(ref string) ([]clients.CheckRun, error) {
 	m.ctrl.T.Helper()
@@ -289,6 +319,21 @@ func (mr *MockRepoClientMockRecorder) ListProgrammingLanguages() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProgrammingLanguages", reflect.TypeOf((*MockRepoClient)(nil).ListProgrammingLanguages))
 }
 
+// ListPullRequests mocks base method.
+func (m *MockRepoClient) ListPullRequests() ([]clients.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPullRequests")
+	ret0, _ := ret[0].([]clients.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPullRequests indicates an expected call of ListPullRequests.
+func (mr *MockRepoClientMockRecorder) ListPullRequests() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPullRequests", reflect.TypeOf((*MockRepoClient)(nil).ListPullRequests))
+}
+
 // ListReleases mocks base method.
 func (m *MockRepoClient) ListReleases() ([]clients.Release, error) {
 	m.ctrl.T.Helper()
@@ -334,6 +379,21 @@ func (mr *MockRepoClientMockRecorder) ListSuccessfulWorkflowRuns(filename interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSuccessfulWorkflowRuns", reflect.TypeOf((*MockRepoClient)(nil).ListSuccessfulWorkflowRuns), filename)
 }
 
+// ListTags mocks base method.
+func (m *MockRepoClient) ListTags() ([]clients.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTags")
+	ret0, _ := ret[0].([]clients.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTags indicates an expected call of ListTags.
+func (mr *MockRepoClientMockRecorder) ListTags() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTags", reflect.TypeOf((*MockRepoClient)(nil).ListTags))
+}
+
 // ListWebhooks mocks base method.
 func (m *MockRepoClient) ListWebhooks() ([]clients.Webhook, error) {
 	m.ctrl.T.Helper()
@@ -349,6 +409,51 @@ func (mr *MockRepoClientMockRecorder) ListWebhooks() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhooks", reflect.TypeOf((*MockRepoClient)(nil).ListWebhooks))
 }
 
+// GetSecuritySettings mocks base method.
+func (m *MockRepoClient) GetSecuritySettings() (clients.SecuritySettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecuritySettings")
+	ret0, _ := ret[0].(clients.SecuritySettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecuritySettings indicates an expected call of GetSecuritySettings.
+func (mr *MockRepoClientMockRecorder) GetSecuritySettings() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecuritySettings", reflect.TypeOf((*MockRepoClient)(nil).GetSecuritySettings))
+}
+
+// GetWorkflowPermissions mocks base method.
+func (m *MockRepoClient) GetWorkflowPermissions() (clients.WorkflowPermissions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkflowPermissions")
+	ret0, _ := ret[0].(clients.WorkflowPermissions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkflowPermissions indicates an expected call of GetWorkflowPermissions.
+func (mr *MockRepoClientMockRecorder) GetWorkflowPermissions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkflowPermissions", reflect.TypeOf((*MockRepoClient)(nil).GetWorkflowPermissions))
+}
+
+// GetCodeOwnerAccess mocks base method.
+func (m *MockRepoClient) GetCodeOwnerAccess(owner string) (clients.CodeOwnerAccess, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCodeOwnerAccess", owner)
+	ret0, _ := ret[0].(clients.CodeOwnerAccess)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCodeOwnerAccess indicates an expected call of GetCodeOwnerAccess.
+func (mr *MockRepoClientMockRecorder) GetCodeOwnerAccess(owner interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCodeOwnerAccess", reflect.TypeOf((*MockRepoClient)(nil).GetCodeOwnerAccess), owner)
+}
+
 // LocalPath mocks base method.
 func (m *MockRepoClient) LocalPath() (string, error) {
 	m.ctrl.T.Helper()
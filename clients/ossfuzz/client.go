@@ -202,6 +202,21 @@ func (c *client) GetOrgRepoClient(ctx context.Context) (clients.RepoClient, erro
 	return nil, fmt.Errorf("GetOrgRepoClient: %w", clients.ErrUnsupportedFeature)
 }
 
+// GetRepoClient implements RepoClient.GetRepoClient.
+func (c *client) GetRepoClient(ctx context.Context, repo string) (clients.RepoClient, error) {
+	return nil, fmt.Errorf("GetRepoClient: %w", clients.ErrUnsupportedFeature)
+}
+
+// ListTags implements RepoClient.ListTags.
+func (c *client) ListTags() ([]clients.Tag, error) {
+	return nil, fmt.Errorf("ListTags: %w", clients.ErrUnsupportedFeature)
+}
+
+// IsCommitReachable implements RepoClient.IsCommitReachable.
+func (c *client) IsCommitReachable(commitSHA string) (bool, error) {
+	return false, fmt.Errorf("IsCommitReachable: %w", clients.ErrUnsupportedFeature)
+}
+
 // GetDefaultBranchName implements RepoClient.GetDefaultBranchName.
 func (c *client) GetDefaultBranchName() (string, error) {
 	return "", fmt.Errorf("GetDefaultBranchName: %w", clients.ErrUnsupportedFeature)
@@ -217,6 +232,11 @@ func (c *client) ListIssues() ([]clients.Issue, error) {
 	return nil, fmt.Errorf("ListIssues: %w", clients.ErrUnsupportedFeature)
 }
 
+// ListPullRequests implements RepoClient.ListPullRequests.
+func (c *client) ListPullRequests() ([]clients.PullRequest, error) {
+	return nil, fmt.Errorf("ListPullRequests: %w", clients.ErrUnsupportedFeature)
+}
+
 // ListReleases implements RepoClient.ListReleases.
 func (c *client) ListReleases() ([]clients.Release, error) {
 	return nil, fmt.Errorf("ListReleases: %w", clients.ErrUnsupportedFeature)
@@ -247,6 +267,21 @@ func (c *client) ListWebhooks() ([]clients.Webhook, error) {
 	return nil, fmt.Errorf("ListWebhooks: %w", clients.ErrUnsupportedFeature)
 }
 
+// GetSecuritySettings implements RepoClient.GetSecuritySettings.
+func (c *client) GetSecuritySettings() (clients.SecuritySettings, error) {
+	return clients.SecuritySettings{}, fmt.Errorf("GetSecuritySettings: %w", clients.ErrUnsupportedFeature)
+}
+
+// GetWorkflowPermissions implements RepoClient.GetWorkflowPermissions.
+func (c *client) GetWorkflowPermissions() (clients.WorkflowPermissions, error) {
+	return clients.WorkflowPermissions{}, fmt.Errorf("GetWorkflowPermissions: %w", clients.ErrUnsupportedFeature)
+}
+
+// GetCodeOwnerAccess implements RepoClient.GetCodeOwnerAccess.
+func (c *client) GetCodeOwnerAccess(owner string) (clients.CodeOwnerAccess, error) {
+	return clients.CodeOwnerAccessUnknown, fmt.Errorf("GetCodeOwnerAccess: %w", clients.ErrUnsupportedFeature)
+}
+
 // SearchCommits implements RepoClient.SearchCommits.
 func (c *client) SearchCommits(request clients.SearchCommitsOptions) ([]clients.Commit, error) {
 	return nil, fmt.Errorf("SearchCommits: %w", clients.ErrUnsupportedFeature)
@@ -29,6 +29,12 @@ type PullRequest struct {
 	Labels   []Label
 	Reviews  []Review
 	MergedBy User
+	// The fields below are only set for the pull requests returned by ListPullRequests.
+	CreatedAt         time.Time
+	ClosedAt          time.Time
+	AuthorAssociation *RepoAssociation
+	// Comments contains the comments and the reviews of the pull request.
+	Comments []IssueComment
 }
 
 // Label represents a PR label.
@@ -42,15 +42,29 @@ type RepoClient interface {
 	GetDefaultBranchName() (string, error)
 	GetDefaultBranch() (*BranchRef, error)
 	GetOrgRepoClient(context.Context) (RepoClient, error)
+	// GetRepoClient returns a client for another repository hosted on the same
+	// platform, e.g. `owner/name` for the repository of a GitHub action.
+	GetRepoClient(ctx context.Context, repo string) (RepoClient, error)
+	// IsCommitReachable returns true if a tag points at the commit,
+	// or the commit is reachable from a branch of the repository.
+	IsCommitReachable(commitSHA string) (bool, error)
 	ListCommits() ([]Commit, error)
 	ListIssues() ([]Issue, error)
+	// ListPullRequests returns the recently updated pull requests, open or closed.
+	ListPullRequests() ([]PullRequest, error)
 	ListLicenses() ([]License, error)
 	ListReleases() ([]Release, error)
 	ListContributors() ([]User, error)
 	ListSuccessfulWorkflowRuns(filename string) ([]WorkflowRun, error)
 	ListCheckRunsForRef(ref string) ([]CheckRun, error)
 	ListStatuses(ref string) ([]Status, error)
+	ListTags() ([]Tag, error)
 	ListWebhooks() ([]Webhook, error)
+	GetSecuritySettings() (SecuritySettings, error)
+	GetWorkflowPermissions() (WorkflowPermissions, error)
+	// GetCodeOwnerAccess returns the access to the repository of an owner of a CODEOWNERS file,
+	// e.g. `@user`, `@org/team` or an email address.
+	GetCodeOwnerAccess(owner string) (CodeOwnerAccess, error)
 	ListProgrammingLanguages() ([]Language, error)
 	Search(request SearchRequest) (SearchResponse, error)
 	SearchCommits(request SearchCommitsOptions) ([]Commit, error)
@@ -0,0 +1,34 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clients
+
+// SecuritySettings represents the security features of a repository.
+// A nil value means the setting could not be retrieved, e.g., because
+// the token does not have admin access, or the platform has no equivalent.
+type SecuritySettings struct {
+	SecretScanning                *bool
+	SecretScanningPushProtection  *bool
+	DependabotSecurityUpdates     *bool
+	PrivateVulnerabilityReporting *bool
+}
+
+// WorkflowPermissions represents the permissions of the token given to CI workflows.
+// A nil value means the setting could not be retrieved.
+type WorkflowPermissions struct {
+	// DefaultTokenReadOnly is true if the token only has read access to the repository by default.
+	DefaultTokenReadOnly *bool
+	// CanApprovePullRequests is true if workflows can create or approve pull requests.
+	CanApprovePullRequests *bool
+}
@@ -0,0 +1,24 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clients
+
+// Tag represents a git tag of a repo.
+type Tag struct {
+	Name string
+	// CommitSHA is the SHA of the commit the tag points at.
+	CommitSHA string
+	// Signature is nil for lightweight tags and unsigned annotated tags.
+	Signature *Signature
+}
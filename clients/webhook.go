@@ -14,9 +14,37 @@
 
 package clients
 
+import "net/url"
+
+// WebhookEventAll is the event of a webhook subscribed to all the events.
+const WebhookEventAll = "*"
+
 // Webhook represents VCS Webhook.
 type Webhook struct {
-	Path           string
+	// Path is the host of the webhook URL. The rest of the URL is redacted
+	// since it may carry credentials.
+	Path string
+	// Scheme is the scheme of the webhook URL, e.g., "https".
+	Scheme string
+	// Events are the events the webhook is subscribed to.
+	Events         []string
 	ID             int64
 	UsesAuthSecret bool
+	// AuthSecretUnknown is set when the forge does not report whether
+	// a secret is configured.
+	AuthSecretUnknown bool
+	// SSLVerification is set when the forge verifies the certificate
+	// of the webhook URL when delivering events.
+	SSLVerification bool
+	// Active is set when the forge delivers events to the webhook.
+	Active bool
+}
+
+// RedactWebhookURL returns the lowercase scheme and the host of a webhook URL.
+func RedactWebhookURL(rawURL string) (scheme, host string) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", ""
+	}
+	return u.Scheme, u.Host
 }
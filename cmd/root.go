@@ -27,9 +27,10 @@ import (
 
 	"github.com/ossf/scorecard/v4/checker"
 	"github.com/ossf/scorecard/v4/clients"
-	pmc "github.com/ossf/scorecard/v4/cmd/internal/packagemanager"
+	"github.com/ossf/scorecard/v4/clients/localdir"
 	docs "github.com/ossf/scorecard/v4/docs/checks"
 	sce "github.com/ossf/scorecard/v4/errors"
+	pmc "github.com/ossf/scorecard/v4/internal/packagemanager"
 	sclog "github.com/ossf/scorecard/v4/log"
 	"github.com/ossf/scorecard/v4/options"
 	"github.com/ossf/scorecard/v4/pkg"
@@ -97,6 +98,17 @@ func rootCmd(o *options.Options) error {
 	}
 
 	defer repoClient.Close()
+	if o.Local != "" {
+		keyring, err := o.SigningKeyringContents()
+		if err != nil {
+			return fmt.Errorf("SigningKeyringContents: %w", err)
+		}
+		if keyring != "" {
+			if err := localdir.SetSigningKeyring(repoClient, keyring); err != nil {
+				return fmt.Errorf("SetSigningKeyring: %w", err)
+			}
+		}
+	}
 	if ossFuzzRepoClient != nil {
 		defer ossFuzzRepoClient.Close()
 	}
@@ -119,6 +131,11 @@ func rootCmd(o *options.Options) error {
 		return fmt.Errorf("GetEnabled: %w", err)
 	}
 
+	emailDomainOrgs, err := o.EmailDomainOrgMap()
+	if err != nil {
+		return fmt.Errorf("EmailDomainOrgMap: %w", err)
+	}
+
 	if o.Format == options.FormatDefault {
 		for checkName := range enabledChecks {
 			fmt.Fprintf(os.Stderr, "Starting [%s]\n", checkName)
@@ -135,6 +152,7 @@ func rootCmd(o *options.Options) error {
 		ossFuzzRepoClient,
 		ciiClient,
 		vulnsClient,
+		emailDomainOrgs,
 	)
 	if err != nil {
 		return fmt.Errorf("RunScorecard: %w", err)
@@ -72,7 +72,7 @@ func serveCmd(o *options.Options) *cobra.Command {
 				checksToRun := checks.GetAll()
 				repoResult, err := pkg.RunScorecard(
 					ctx, repo, clients.HeadSHA /*commitSHA*/, o.CommitDepth, checksToRun, repoClient,
-					ossFuzzRepoClient, ciiClient, vulnsClient)
+					ossFuzzRepoClient, ciiClient, vulnsClient, nil)
 				if err != nil {
 					logger.Error(err, "running enabled scorecard checks on repo")
 					rw.WriteHeader(http.StatusInternalServerError)
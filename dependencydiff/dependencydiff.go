@@ -171,6 +171,7 @@ func getScorecardCheckResults(dCtx *dependencydiffContext) error {
 				dCtx.ossFuzzClient,
 				dCtx.ciiClient,
 				dCtx.vulnsClient,
+				nil,
 			)
 			// If the run fails, we leave the current dependency scorecard result empty and record the error
 			// rather than letting the entire API return nil since we still expect results for other dependencies.
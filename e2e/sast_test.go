@@ -43,11 +43,10 @@ var _ = Describe("E2E TEST:"+checks.CheckSAST, func() {
 				Dlogger:    &dl,
 			}
 			expected := scut.TestReturn{
-				Error:         nil,
-				Score:         10,
-				NumberOfWarn:  1,
-				NumberOfInfo:  1,
-				NumberOfDebug: 1,
+				Error:        nil,
+				Score:        10,
+				NumberOfWarn: 1,
+				NumberOfInfo: 1,
 			}
 			result := checks.SAST(&req)
 			// New version.
@@ -26,8 +26,8 @@ import (
 
 	"golang.org/x/exp/slices"
 
-	pmc "github.com/ossf/scorecard/v4/cmd/internal/packagemanager"
 	sce "github.com/ossf/scorecard/v4/errors"
+	pmc "github.com/ossf/scorecard/v4/internal/packagemanager"
 )
 
 type indexResults struct {
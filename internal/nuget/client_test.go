@@ -28,7 +28,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"golang.org/x/exp/slices"
 
-	pmc "github.com/ossf/scorecard/v4/cmd/internal/packagemanager"
+	pmc "github.com/ossf/scorecard/v4/internal/packagemanager"
 )
 
 type resultPackagePage struct {
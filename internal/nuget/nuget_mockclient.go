@@ -14,7 +14,7 @@
 //
 
 // Code generated by MockGen. DO NOT EDIT.
-// Source: cmd/internal/nuget/client.go
+// Source: internal/nuget/client.go
 
 // Package nuget is a generated GoMock package.
 package nuget
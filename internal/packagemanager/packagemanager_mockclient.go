@@ -14,7 +14,7 @@
 //
 
 // Code generated by MockGen. DO NOT EDIT.
-// Source: cmd/internal/packagemanager/client.go
+// Source: internal/packagemanager/client.go
 
 // Package packagemanager is a generated GoMock package.
 package packagemanager
@@ -70,6 +70,12 @@ const (
 	ShorthandFlagResultsFile = "o"
 
 	FlagCommitDepth = "commit-depth"
+
+	// FlagEmailDomainOrgs is the flag name for specifying the mapping of email domains to organizations.
+	FlagEmailDomainOrgs = "email-domain-orgs"
+
+	// FlagSigningKeyring is the flag name for specifying the keyring verifying commit and tag signatures.
+	FlagSigningKeyring = "signing-keyring"
 )
 
 // Command is an interface for handling options for command-line utilities.
@@ -157,6 +163,20 @@ func (o *Options) AddFlags(cmd *cobra.Command) {
 		"number of commits to check, commits begin backwards from the HEAD",
 	)
 
+	cmd.Flags().StringVar(
+		&o.EmailDomainOrgs,
+		FlagEmailDomainOrgs,
+		o.EmailDomainOrgs,
+		"YAML file mapping the email domains of the commit authors to organizations, e.g. 'chromium.org: google'",
+	)
+
+	cmd.Flags().StringVar(
+		&o.SigningKeyring,
+		FlagSigningKeyring,
+		o.SigningKeyring,
+		"armored OpenPGP keyring file verifying the commit and tag signatures of a local repository",
+	)
+
 	checkNames := []string{}
 	for checkName := range checks.GetAll() {
 		checkNames = append(checkNames, checkName)
@@ -23,6 +23,7 @@ import (
 	"strings"
 
 	"github.com/caarlos0/env/v6"
+	"gopkg.in/yaml.v3"
 
 	"github.com/ossf/scorecard/v4/clients"
 	sclog "github.com/ossf/scorecard/v4/log"
@@ -45,6 +46,12 @@ type Options struct {
 	Metadata    []string
 	CommitDepth int
 	ShowDetails bool
+	// EmailDomainOrgs is a YAML file mapping the email domains of the commit
+	// authors to organizations, e.g., `chromium.org: google`.
+	EmailDomainOrgs string `env:"SCORECARD_EMAIL_DOMAIN_ORGS"`
+	// SigningKeyring is an armored OpenPGP keyring file used to verify the
+	// signatures of the commits and tags of a local repository.
+	SigningKeyring string `env:"SCORECARD_SIGNING_KEYRING"`
 	// Feature flags.
 	EnableSarif                 bool `env:"ENABLE_SARIF"`
 	EnableScorecardV6           bool `env:"SCORECARD_V6"`
@@ -109,6 +116,7 @@ var (
 	DefaultLogLevel = sclog.DefaultLevel.String()
 
 	errCommitIsEmpty                   = errors.New("commit should be non-empty")
+	errEmailDomainOrgs                 = errors.New("invalid email domain organizations file")
 	errFormatNotSupported              = errors.New("unsupported format")
 	errFormatSupportedWithExperimental = errors.New("format supported only with SCORECARD_EXPERIMENTAL=1")
 	errPolicyFileNotSupported          = errors.New("policy file is not supported yet")
@@ -116,8 +124,10 @@ var (
 	errRepoOptionMustBeSet             = errors.New(
 		"exactly one of `repo`, `npm`, `pypi`, `rubygems`, `nuget` or `local` must be set",
 	)
-	errSARIFNotSupported = errors.New("SARIF format is not supported yet")
-	errValidate          = errors.New("some options could not be validated")
+	errSARIFNotSupported        = errors.New("SARIF format is not supported yet")
+	errSigningKeyring           = errors.New("invalid signing keyring file")
+	errSigningKeyringNeedsLocal = errors.New("`signing-keyring` is only supported with `local`")
+	errValidate                 = errors.New("some options could not be validated")
 )
 
 // Validate validates scorecard configuration options.
@@ -183,6 +193,30 @@ func (o *Options) Validate() error {
 		)
 	}
 
+	// Validate the mapping of email domains to organizations.
+	if _, err := o.EmailDomainOrgMap(); err != nil {
+		errs = append(
+			errs,
+			err,
+		)
+	}
+
+	// Validate the signing keyring is readable and only used on local repositories.
+	if o.SigningKeyring != "" {
+		if o.Local == "" {
+			errs = append(
+				errs,
+				errSigningKeyringNeedsLocal,
+			)
+		}
+		if _, err := o.SigningKeyringContents(); err != nil {
+			errs = append(
+				errs,
+				err,
+			)
+		}
+	}
+
 	// Validate `commit` is non-empty.
 	if o.Commit == "" {
 		errs = append(
@@ -201,6 +235,39 @@ func (o *Options) Validate() error {
 	return nil
 }
 
+// EmailDomainOrgMap reads the mapping of email domains to organizations
+// of EmailDomainOrgs, if set. The domains and organizations are lowercased.
+func (o *Options) EmailDomainOrgMap() (map[string]string, error) {
+	if o.EmailDomainOrgs == "" {
+		return nil, nil
+	}
+	content, err := os.ReadFile(o.EmailDomainOrgs)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errEmailDomainOrgs, err)
+	}
+	var orgs map[string]string
+	if err := yaml.Unmarshal(content, &orgs); err != nil {
+		return nil, fmt.Errorf("%w: %v", errEmailDomainOrgs, err)
+	}
+	domainOrgs := make(map[string]string, len(orgs))
+	for domain, org := range orgs {
+		domainOrgs[strings.ToLower(strings.TrimSpace(domain))] = strings.ToLower(strings.TrimSpace(org))
+	}
+	return domainOrgs, nil
+}
+
+// SigningKeyringContents reads the armored OpenPGP keyring of SigningKeyring, if set.
+func (o *Options) SigningKeyringContents() (string, error) {
+	if o.SigningKeyring == "" {
+		return "", nil
+	}
+	content, err := os.ReadFile(o.SigningKeyring)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", errSigningKeyring, err)
+	}
+	return string(content), nil
+}
+
 func boolSum(bools ...bool) int {
 	sum := 0
 	for _, b := range bools {
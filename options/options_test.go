@@ -17,7 +17,10 @@ package options
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
+
+	"github.com/google/go-cmp/cmp"
 )
 
 // Cannot run parallel tests because of the ENV variables.
@@ -120,3 +123,94 @@ func TestOptions_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestOptions_EmailDomainOrgMap(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	file := filepath.Join(dir, "orgs.yaml")
+	if err := os.WriteFile(file, []byte("Chromium.org: Google\ngmail.com: \"\"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	invalid := filepath.Join(dir, "invalid.yaml")
+	if err := os.WriteFile(invalid, []byte("- not a map\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		file    string
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name: "unset",
+		},
+		{
+			name: "mapping",
+			file: file,
+			want: map[string]string{"chromium.org": "google", "gmail.com": ""},
+		},
+		{
+			name:    "missing file",
+			file:    filepath.Join(dir, "missing.yaml"),
+			wantErr: true,
+		},
+		{
+			name:    "invalid file",
+			file:    invalid,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			o := &Options{EmailDomainOrgs: tt.file}
+			got, err := o.EmailDomainOrgMap()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Options.EmailDomainOrgMap() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOptions_SigningKeyring(t *testing.T) {
+	t.Parallel()
+	keyring := filepath.Join(t.TempDir(), "keyring.asc")
+	if err := os.WriteFile(keyring, []byte("-----BEGIN PGP PUBLIC KEY BLOCK-----\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		options Options
+		wantErr bool
+	}{
+		{
+			name:    "local repository",
+			options: Options{Local: ".", SigningKeyring: keyring},
+		},
+		{
+			name:    "remote repository",
+			options: Options{Repo: "github.com/ossf/scorecard", SigningKeyring: keyring},
+			wantErr: true,
+		},
+		{
+			name:    "missing file",
+			options: Options{Local: ".", SigningKeyring: keyring + ".missing"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tt.options.Commit = DefaultCommit
+			tt.options.Format = FormatDefault
+			if err := tt.options.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Options.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
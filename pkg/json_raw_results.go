@@ -46,6 +46,12 @@ type jsonFile struct {
 	EndOffset uint    `json:"endOffset,omitempty"`
 }
 
+type jsonSecret struct {
+	Type           string   `json:"type"`
+	File           jsonFile `json:"file"`
+	HighConfidence bool     `json:"highConfidence"`
+}
+
 type jsonTool struct {
 	URL   *string          `json:"url"`
 	Desc  *string          `json:"desc"`
@@ -68,9 +74,17 @@ type jsonBranchProtectionSettings struct {
 	StatusCheckContexts                 []string `json:"statusChecksContexts"`
 }
 
+type jsonBranchRuleSource struct {
+	Name         *string  `json:"name,omitempty"`
+	Type         string   `json:"type"`
+	BypassActors []string `json:"bypassActors,omitempty"`
+	Enforced     bool     `json:"enforced"`
+}
+
 type jsonBranchProtection struct {
 	Protection *jsonBranchProtectionSettings `json:"protection"`
 	Name       string                        `json:"name"`
+	Sources    []jsonBranchRuleSource        `json:"sources,omitempty"`
 }
 
 type jsonBranchProtectionMetadata struct {
@@ -95,8 +109,19 @@ type jsonUser struct {
 }
 
 type jsonContributors struct {
-	Users []jsonUser `json:"users"`
-	// TODO: high-level statistics, etc
+	Users   []jsonUser               `json:"users"`
+	Windows []jsonContributionWindow `json:"windows,omitempty"`
+}
+
+type jsonContributionWindow struct {
+	TopAffiliation      string `json:"topAffiliation,omitempty"`
+	Days                int    `json:"days"`
+	Commits             int    `json:"commits"`
+	Authors             int    `json:"authors"`
+	BusFactor           int    `json:"busFactor"`
+	TopAuthorShare      int    `json:"topAuthorShare"`
+	Affiliations        int    `json:"affiliations"`
+	TopAffiliationShare int    `json:"topAffiliationShare"`
 }
 
 type jsonOrganization struct {
@@ -157,6 +182,20 @@ type jsonIssue struct {
 	// TODO: add fields, e.g., state=[opened|closed]
 }
 
+//nolint:govet
+type jsonTrackedItem struct {
+	Type                    string     `json:"type"`
+	URL                     string     `json:"url,omitempty"`
+	Number                  int        `json:"number,omitempty"`
+	Author                  string     `json:"author"`
+	External                bool       `json:"external"`
+	CreatedAt               time.Time  `json:"createdAt"`
+	FirstMaintainerResponse *time.Time `json:"firstMaintainerResponse,omitempty"`
+	LastMaintainerActivity  *time.Time `json:"lastMaintainerActivity,omitempty"`
+	ClosedAt                *time.Time `json:"closedAt,omitempty"`
+	MergedAt                *time.Time `json:"mergedAt,omitempty"`
+}
+
 type jsonRelease struct {
 	Tag    string             `json:"tag"`
 	URL    string             `json:"url"`
@@ -185,9 +224,25 @@ type jsonLicense struct {
 	License jsonLicenseInfo `json:"file"`
 }
 
+type jsonLicenseDeclaration struct {
+	File       jsonFile `json:"file"`
+	Source     string   `json:"source"`
+	Expression string   `json:"expression"`
+	Licenses   []string `json:"licenses,omitempty"`
+	Error      string   `json:"error,omitempty"`
+	Conflicts  bool     `json:"conflictsWithLicenseFile,omitempty"`
+}
+
+type jsonLicenseCoverage struct {
+	ProjectLicenses     []string `json:"projectLicenses,omitempty"`
+	SourceFiles         int      `json:"sourceFiles"`
+	DeclaredSourceFiles int      `json:"declaredSourceFiles"`
+}
+
 type jsonWorkflow struct {
-	Job  *jsonWorkflowJob `json:"job"`
-	File *jsonFile        `json:"file"`
+	Job    *jsonWorkflowJob `json:"job"`
+	File   *jsonFile        `json:"file"`
+	Caller *jsonFile        `json:"caller,omitempty"`
 	// Type is a string to allow different types for permissions, unpinned dependencies, etc.
 	Type string `json:"type"`
 }
@@ -197,11 +252,81 @@ type jsonWorkflowJob struct {
 	ID   *string `json:"id"`
 }
 
+type jsonWorkflowSecretExposure struct {
+	Job        *jsonWorkflowJob `json:"job,omitempty"`
+	Secret     string           `json:"secret,omitempty"`
+	Receiver   string           `json:"receiver,omitempty"`
+	Type       string           `json:"type"`
+	File       jsonFile         `json:"file"`
+	ThirdParty bool             `json:"thirdParty"`
+}
+
+type jsonCodeowners struct {
+	File           *jsonFile            `json:"file"`
+	Owners         []jsonCodeOwner      `json:"owners"`
+	SensitivePaths []jsonCodeownersPath `json:"sensitivePaths"`
+	NumFiles       int                  `json:"numFiles"`
+	NumOwnedFiles  int                  `json:"numOwnedFiles"`
+}
+
+type jsonCodeOwner struct {
+	Name   string   `json:"name"`
+	Access string   `json:"access"`
+	File   jsonFile `json:"file"`
+}
+
+type jsonCodeownersPath struct {
+	Path   string   `json:"path"`
+	Type   string   `json:"type"`
+	Owners []string `json:"owners"`
+}
+
+type jsonDockerfileIssue struct {
+	Type  string   `json:"type"`
+	Stage string   `json:"stage,omitempty"`
+	File  jsonFile `json:"file"`
+}
+
+type jsonRegistryConfigIssue struct {
+	Type      string   `json:"type"`
+	Ecosystem string   `json:"ecosystem"`
+	Registry  string   `json:"registry,omitempty"`
+	File      jsonFile `json:"file"`
+}
+
+type jsonSASTConfig struct {
+	Tool string   `json:"tool"`
+	File jsonFile `json:"file"`
+}
+
+type jsonSASTPullRequest struct {
+	HeadSHA string   `json:"headSHA"`
+	Tools   []string `json:"tools"`
+	Number  int      `json:"number"`
+}
+
+type jsonSAST struct {
+	Configs      []jsonSASTConfig      `json:"configs"`
+	PullRequests []jsonSASTPullRequest `json:"pullRequests"`
+}
+
 type jsonPackage struct {
-	Name *string          `json:"name,omitempty"`
-	Job  *jsonWorkflowJob `json:"job,omitempty"`
-	File *jsonFile        `json:"file,omitempty"`
-	Runs []jsonRun        `json:"runs,omitempty"`
+	Name       *string          `json:"name,omitempty"`
+	Job        *jsonWorkflowJob `json:"job,omitempty"`
+	File       *jsonFile        `json:"file,omitempty"`
+	Auth       string           `json:"auth,omitempty"`
+	Runs       []jsonRun        `json:"runs,omitempty"`
+	Provenance bool             `json:"provenance"`
+}
+
+type jsonPublicationTarget struct {
+	File     jsonFile `json:"file"`
+	Registry string   `json:"registry"`
+	Tool     string   `json:"tool"`
+	Name     string   `json:"name,omitempty"`
+	// Verified is omitted if the registry was not looked up.
+	Verified *bool `json:"verified,omitempty"`
+	Signed   bool  `json:"signed"`
 }
 
 type jsonRun struct {
@@ -216,10 +341,13 @@ type jsonPinningDependenciesData struct {
 type jsonDependency struct {
 	// TODO: unique dependency name.
 	// TODO: Job         *WorkflowJob
-	Location *jsonFile `json:"location"`
-	Name     *string   `json:"name"`
-	PinnedAt *string   `json:"pinnedAt"`
-	Type     string    `json:"type"`
+	Location          *jsonFile `json:"location"`
+	Caller            *jsonFile `json:"caller,omitempty"`
+	Name              *string   `json:"name"`
+	PinnedAt          *string   `json:"pinnedAt"`
+	VersionComment    *string   `json:"versionComment,omitempty"`
+	PinnedCommitIssue *string   `json:"pinnedCommitIssue,omitempty"`
+	Type              string    `json:"type"`
 }
 
 type jsonPermissionsData struct {
@@ -241,6 +369,15 @@ type jsonSecurityFile struct {
 	ContentLength uint                     `json:"contentLength,omitempty"`
 }
 
+type jsonSecurityTxt struct {
+	Expires    *time.Time `json:"expires,omitempty"`
+	Path       string     `json:"path"`
+	Contacts   []string   `json:"contacts,omitempty"`
+	Encryption []string   `json:"encryption,omitempty"`
+	Policies   []string   `json:"policies,omitempty"`
+	Errors     []string   `json:"errors,omitempty"`
+}
+
 type jsonSecurityPolicyHits struct {
 	Type       string `json:"type"`
 	Match      string `json:"match,omitempty"`
@@ -256,6 +393,9 @@ type jsonRawResults struct {
 	Permissions jsonPermissionsData `json:"permissions"`
 	// License.
 	Licenses []jsonLicense `json:"licenses"`
+	// File-level license declarations: SPDX headers and REUSE annotations.
+	LicenseDeclarations []jsonLicenseDeclaration `json:"licenseDeclarations,omitempty"`
+	LicenseCoverage     *jsonLicenseCoverage     `json:"licenseCoverage,omitempty"`
 	// List of recent issues.
 	RecentIssues []jsonIssue `json:"issues"`
 	// OSSF best practices badge.
@@ -267,6 +407,10 @@ type jsonRawResults struct {
 	// List of security policy files found in the repo.
 	// Note: we return one at most.
 	SecurityPolicies []jsonSecurityFile `json:"securityPolicies"`
+	// security.txt files (RFC 9116) found in the repo.
+	SecurityTxtFiles []jsonSecurityTxt `json:"securityTxtFiles,omitempty"`
+	// Whether GitHub private vulnerability reporting is enabled.
+	PrivateVulnerabilityReporting *bool `json:"privateVulnerabilityReporting,omitempty"`
 	// List of update tools.
 	// Note: we return one at most.
 	DependencyUpdateTools []jsonTool `json:"dependencyUpdateTools"`
@@ -288,14 +432,42 @@ type jsonRawResults struct {
 	Releases []jsonRelease `json:"releases"`
 	// Packages.
 	Packages []jsonPackage `json:"packages"`
+	// Registries the packaging pipelines publish to.
+	PublicationTargets []jsonPublicationTarget `json:"publicationTargets"`
 	// Dependency pinning.
 	DependencyPinning jsonPinningDependenciesData `json:"dependencyPinning"`
+	// Hard-coded secrets. The snippets never contain the value of the secrets.
+	Secrets []jsonSecret `json:"secrets"`
+	// Risky flows of the secrets of the workflows.
+	WorkflowSecretExposures []jsonWorkflowSecretExposure `json:"workflowSecretExposures"`
+	// CODEOWNERS file, its owners and the owners of the sensitive files.
+	Codeowners jsonCodeowners `json:"codeowners"`
+	// Hardening issues of the Dockerfiles.
+	DockerfileIssues []jsonDockerfileIssue `json:"dockerfileIssues"`
+	// Risky package registry settings. The credentials are redacted.
+	RegistryConfigIssues []jsonRegistryConfigIssue `json:"registryConfigIssues"`
+	// SAST tools configured, and run on the merged pull requests.
+	SAST jsonSAST `json:"sast"`
+	// Recent issues and pull requests with the activity of the maintainers.
+	TrackedItems []jsonTrackedItem `json:"trackedItems"`
 }
 
 func asPointer(s string) *string {
 	return &s
 }
 
+func asJSONFile(f *checker.File) *jsonFile {
+	v := &jsonFile{
+		Path:      f.Path,
+		Offset:    f.Offset,
+		EndOffset: f.EndOffset,
+	}
+	if f.Snippet != "" {
+		v.Snippet = asPointer(f.Snippet)
+	}
+	return v
+}
+
 func (r *jsonScorecardRawResult) addTokenPermissionsRawResults(tp *checker.TokenPermissionsData) error {
 	r.Results.Permissions = jsonPermissionsData{}
 
@@ -364,6 +536,9 @@ func (r *jsonScorecardRawResult) addPackagingRawResults(pk *checker.PackagingDat
 			jpk.File.Snippet = asPointer(p.File.Snippet)
 		}
 
+		jpk.Auth = string(p.Auth)
+		jpk.Provenance = p.Provenance
+
 		for _, run := range p.Runs {
 			jpk.Runs = append(jpk.Runs,
 				jsonRun{
@@ -374,6 +549,19 @@ func (r *jsonScorecardRawResult) addPackagingRawResults(pk *checker.PackagingDat
 
 		r.Results.Packages = append(r.Results.Packages, jpk)
 	}
+
+	r.Results.PublicationTargets = []jsonPublicationTarget{}
+	for i := range pk.Targets {
+		t := &pk.Targets[i]
+		r.Results.PublicationTargets = append(r.Results.PublicationTargets, jsonPublicationTarget{
+			File:     *asJSONFile(&t.File),
+			Registry: string(t.Registry),
+			Tool:     t.Tool,
+			Name:     t.Name,
+			Verified: t.Verified,
+			Signed:   t.Signed,
+		})
+	}
 	return nil
 }
 
@@ -392,14 +580,22 @@ func (r *jsonScorecardRawResult) addDependencyPinningRawResults(pd *checker.Pinn
 				Offset:    rr.Location.Offset,
 				EndOffset: rr.Location.EndOffset,
 			},
-			Name:     rr.Name,
-			PinnedAt: rr.PinnedAt,
-			Type:     string(rr.Type),
+			Name:           rr.Name,
+			PinnedAt:       rr.PinnedAt,
+			VersionComment: rr.VersionComment,
+			Type:           string(rr.Type),
 		}
 
 		if rr.Location.Snippet != "" {
 			v.Location.Snippet = &rr.Location.Snippet
 		}
+		if rr.Caller != nil {
+			v.Caller = asJSONFile(rr.Caller)
+		}
+		if rr.PinnedCommitIssue != nil {
+			issue := string(*rr.PinnedCommitIssue)
+			v.PinnedCommitIssue = &issue
+		}
 
 		r.Results.DependencyPinning.Dependencies = append(r.Results.DependencyPinning.Dependencies, v)
 	}
@@ -421,6 +617,9 @@ func (r *jsonScorecardRawResult) addDangerousWorkflowRawResults(df *checker.Dang
 		if e.File.Snippet != "" {
 			v.File.Snippet = asPointer(e.File.Snippet)
 		}
+		if e.Caller != nil {
+			v.Caller = asJSONFile(e.Caller)
+		}
 		if e.Job != nil {
 			v.Job = &jsonWorkflowJob{
 				Name: e.Job.Name,
@@ -434,6 +633,116 @@ func (r *jsonScorecardRawResult) addDangerousWorkflowRawResults(df *checker.Dang
 	return nil
 }
 
+//nolint:unparam
+func (r *jsonScorecardRawResult) addWorkflowSecretsRawResults(ws *checker.WorkflowSecretsData) error {
+	r.Results.WorkflowSecretExposures = []jsonWorkflowSecretExposure{}
+	for i := range ws.Exposures {
+		e := &ws.Exposures[i]
+		v := jsonWorkflowSecretExposure{
+			Secret:     e.Secret,
+			Receiver:   e.Receiver,
+			Type:       string(e.Type),
+			File:       *asJSONFile(&e.File),
+			ThirdParty: e.ThirdParty,
+		}
+		if e.Job != nil {
+			v.Job = &jsonWorkflowJob{
+				Name: e.Job.Name,
+				ID:   e.Job.ID,
+			}
+		}
+		r.Results.WorkflowSecretExposures = append(r.Results.WorkflowSecretExposures, v)
+	}
+	return nil
+}
+
+//nolint:unparam
+func (r *jsonScorecardRawResult) addCodeownersRawResults(cd *checker.CodeownersData) error {
+	r.Results.Codeowners = jsonCodeowners{
+		Owners:         []jsonCodeOwner{},
+		SensitivePaths: []jsonCodeownersPath{},
+		NumFiles:       cd.NumFiles,
+		NumOwnedFiles:  cd.NumOwnedFiles,
+	}
+	if cd.File != nil {
+		r.Results.Codeowners.File = asJSONFile(cd.File)
+	}
+	for i := range cd.Owners {
+		o := &cd.Owners[i]
+		r.Results.Codeowners.Owners = append(r.Results.Codeowners.Owners, jsonCodeOwner{
+			Name:   o.Name,
+			Access: string(o.Access),
+			File:   *asJSONFile(&o.File),
+		})
+	}
+	for i := range cd.SensitivePaths {
+		p := &cd.SensitivePaths[i]
+		r.Results.Codeowners.SensitivePaths = append(r.Results.Codeowners.SensitivePaths, jsonCodeownersPath{
+			Path:   p.Path,
+			Type:   string(p.Type),
+			Owners: p.Owners,
+		})
+	}
+	return nil
+}
+
+//nolint:unparam
+func (r *jsonScorecardRawResult) addDockerfileHardeningRawResults(dh *checker.DockerfileHardeningData) error {
+	r.Results.DockerfileIssues = []jsonDockerfileIssue{}
+	for i := range dh.Issues {
+		issue := &dh.Issues[i]
+		r.Results.DockerfileIssues = append(r.Results.DockerfileIssues, jsonDockerfileIssue{
+			Type:  string(issue.Type),
+			Stage: issue.Stage,
+			File:  *asJSONFile(&issue.File),
+		})
+	}
+	return nil
+}
+
+//nolint:unparam
+func (r *jsonScorecardRawResult) addDependencyConfusionRawResults(dc *checker.DependencyConfusionData) error {
+	r.Results.RegistryConfigIssues = []jsonRegistryConfigIssue{}
+	for i := range dc.Issues {
+		issue := &dc.Issues[i]
+		r.Results.RegistryConfigIssues = append(r.Results.RegistryConfigIssues, jsonRegistryConfigIssue{
+			Type:      string(issue.Type),
+			Ecosystem: issue.Ecosystem,
+			Registry:  issue.Registry,
+			File:      *asJSONFile(&issue.File),
+		})
+	}
+	return nil
+}
+
+//nolint:unparam
+func (r *jsonScorecardRawResult) addSASTRawResults(sast *checker.SASTData) error {
+	r.Results.SAST = jsonSAST{
+		Configs:      []jsonSASTConfig{},
+		PullRequests: []jsonSASTPullRequest{},
+	}
+	for i := range sast.Configs {
+		config := &sast.Configs[i]
+		r.Results.SAST.Configs = append(r.Results.SAST.Configs, jsonSASTConfig{
+			Tool: string(config.Tool),
+			File: *asJSONFile(&config.File),
+		})
+	}
+	for i := range sast.PullRequests {
+		pr := &sast.PullRequests[i]
+		tools := []string{}
+		for _, tool := range pr.Tools {
+			tools = append(tools, string(tool))
+		}
+		r.Results.SAST.PullRequests = append(r.Results.SAST.PullRequests, jsonSASTPullRequest{
+			Number:  pr.Number,
+			HeadSHA: pr.HeadSHA,
+			Tools:   tools,
+		})
+	}
+	return nil
+}
+
 //nolint:unparam
 func (r *jsonScorecardRawResult) addContributorsRawResults(cr *checker.ContributorsData) error {
 	r.Results.Contributors = jsonContributors{}
@@ -463,6 +772,21 @@ func (r *jsonScorecardRawResult) addContributorsRawResults(cr *checker.Contribut
 		r.Results.Contributors.Users = append(r.Results.Contributors.Users, u)
 	}
 
+	for _, w := range cr.Windows {
+		r.Results.Contributors.Windows = append(r.Results.Contributors.Windows,
+			jsonContributionWindow{
+				Days:                w.Days,
+				Commits:             w.Commits,
+				Authors:             w.Authors,
+				BusFactor:           w.BusFactor,
+				TopAuthorShare:      w.TopAuthorShare,
+				Affiliations:        w.Affiliations,
+				TopAffiliation:      w.TopAffiliation,
+				TopAffiliationShare: w.TopAffiliationShare,
+			},
+		)
+	}
+
 	return nil
 }
 
@@ -526,6 +850,24 @@ func (r *jsonScorecardRawResult) addMaintainedRawResults(mr *checker.MaintainedD
 		r.Results.RecentIssues = append(r.Results.RecentIssues, issue)
 	}
 
+	// Issues and pull requests.
+	r.Results.TrackedItems = []jsonTrackedItem{}
+	for i := range mr.TrackedItems {
+		item := &mr.TrackedItems[i]
+		r.Results.TrackedItems = append(r.Results.TrackedItems, jsonTrackedItem{
+			Type:                    string(item.Type),
+			URL:                     item.URI,
+			Number:                  item.Number,
+			Author:                  item.Author,
+			External:                item.External,
+			CreatedAt:               item.CreatedAt,
+			FirstMaintainerResponse: item.FirstMaintainerResponse,
+			LastMaintainerActivity:  item.LastMaintainerActivity,
+			ClosedAt:                item.ClosedAt,
+			MergedAt:                item.MergedAt,
+		})
+	}
+
 	return nil
 }
 
@@ -610,6 +952,27 @@ func (r *jsonScorecardRawResult) addLicenseRawResults(ld *checker.LicenseData) e
 			},
 		)
 	}
+	for i := range ld.Declarations {
+		d := &ld.Declarations[i]
+		r.Results.LicenseDeclarations = append(r.Results.LicenseDeclarations, jsonLicenseDeclaration{
+			File: jsonFile{
+				Path:   d.File.Path,
+				Offset: d.File.Offset,
+			},
+			Source:     string(d.Source),
+			Expression: d.Expression,
+			Licenses:   d.Licenses,
+			Error:      d.Error,
+			Conflicts:  d.ConflictsWithLicenseFile,
+		})
+	}
+	if ld.SourceFiles > 0 || len(ld.ProjectLicenses) > 0 {
+		r.Results.LicenseCoverage = &jsonLicenseCoverage{
+			ProjectLicenses:     ld.ProjectLicenses,
+			SourceFiles:         ld.SourceFiles,
+			DeclaredSourceFiles: ld.DeclaredSourceFiles,
+		}
+	}
 	return nil
 }
 
@@ -636,6 +999,20 @@ func (r *jsonScorecardRawResult) addBinaryArtifactRawResults(ba *checker.BinaryA
 	return nil
 }
 
+//nolint:unparam
+func (r *jsonScorecardRawResult) addSecretsRawResults(sd *checker.SecretsData) error {
+	r.Results.Secrets = []jsonSecret{}
+	for i := range sd.Secrets {
+		s := &sd.Secrets[i]
+		r.Results.Secrets = append(r.Results.Secrets, jsonSecret{
+			Type:           string(s.Type),
+			File:           *asJSONFile(&s.File),
+			HighConfidence: s.HighConfidence,
+		})
+	}
+	return nil
+}
+
 //nolint:unparam
 func (r *jsonScorecardRawResult) addSecurityPolicyRawResults(sp *checker.SecurityPolicyData) error {
 	r.Results.SecurityPolicies = []jsonSecurityFile{}
@@ -656,6 +1033,18 @@ func (r *jsonScorecardRawResult) addSecurityPolicyRawResults(sp *checker.Securit
 			}
 		}
 	}
+	for i := range sp.SecurityTxtFiles {
+		txt := &sp.SecurityTxtFiles[i]
+		r.Results.SecurityTxtFiles = append(r.Results.SecurityTxtFiles, jsonSecurityTxt{
+			Path:       txt.File.Path,
+			Expires:    txt.Expires,
+			Contacts:   txt.Contacts,
+			Encryption: txt.Encryption,
+			Policies:   txt.Policies,
+			Errors:     txt.Errors,
+		})
+	}
+	r.Results.PrivateVulnerabilityReporting = sp.PrivateVulnerabilityReporting
 	return nil
 }
 
@@ -722,9 +1111,19 @@ func (r *jsonScorecardRawResult) addBranchProtectionRawResults(bp *checker.Branc
 				StatusCheckContexts:                 v.BranchProtectionRule.CheckRules.Contexts,
 			}
 		}
+		var sources []jsonBranchRuleSource
+		for _, s := range v.RuleSources {
+			sources = append(sources, jsonBranchRuleSource{
+				Name:         s.Name,
+				Type:         string(s.Type),
+				BypassActors: s.BypassActors,
+				Enforced:     s.Enforced,
+			})
+		}
 		branches = append(branches, jsonBranchProtection{
 			Name:       *v.Name,
 			Protection: bp,
+			Sources:    sources,
 		})
 	}
 	r.Results.BranchProtections.Branches = branches
@@ -750,6 +1149,11 @@ func (r *jsonScorecardRawResult) fillJSONRawResults(raw *checker.RawResults) err
 		return sce.WithMessage(sce.ErrScorecardInternal, err.Error())
 	}
 
+	// Secrets.
+	if err := r.addSecretsRawResults(&raw.SecretsResults); err != nil {
+		return sce.WithMessage(sce.ErrScorecardInternal, err.Error())
+	}
+
 	// Security-Policy.
 	if err := r.addSecurityPolicyRawResults(&raw.SecurityPolicyResults); err != nil {
 		return sce.WithMessage(sce.ErrScorecardInternal, err.Error())
@@ -800,6 +1204,31 @@ func (r *jsonScorecardRawResult) fillJSONRawResults(raw *checker.RawResults) err
 		return sce.WithMessage(sce.ErrScorecardInternal, err.Error())
 	}
 
+	// Workflow-Secrets.
+	if err := r.addWorkflowSecretsRawResults(&raw.WorkflowSecretsResults); err != nil {
+		return sce.WithMessage(sce.ErrScorecardInternal, err.Error())
+	}
+
+	// Code-Owners.
+	if err := r.addCodeownersRawResults(&raw.CodeownersResults); err != nil {
+		return sce.WithMessage(sce.ErrScorecardInternal, err.Error())
+	}
+
+	// Dockerfile-Hardening.
+	if err := r.addDockerfileHardeningRawResults(&raw.DockerfileHardeningResults); err != nil {
+		return sce.WithMessage(sce.ErrScorecardInternal, err.Error())
+	}
+
+	// Dependency-Confusion.
+	if err := r.addDependencyConfusionRawResults(&raw.DependencyConfusionResults); err != nil {
+		return sce.WithMessage(sce.ErrScorecardInternal, err.Error())
+	}
+
+	// SAST.
+	if err := r.addSASTRawResults(&raw.SASTResults); err != nil {
+		return sce.WithMessage(sce.ErrScorecardInternal, err.Error())
+	}
+
 	// Fuzzers.
 	if err := r.addFuzzingRawResults(&raw.FuzzingResults); err != nil {
 		return sce.WithMessage(sce.ErrScorecardInternal, err.Error())
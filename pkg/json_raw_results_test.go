@@ -142,6 +142,52 @@ func TestJsonScorecardRawResult_AddPackagingRawResults(t *testing.T) {
 	}
 }
 
+func TestAddPublicationTargetsRawResults(t *testing.T) {
+	t.Parallel()
+	verified := true
+	r := &jsonScorecardRawResult{}
+	pk := &checker.PackagingData{
+		Targets: []checker.PublicationTarget{
+			{
+				File:     checker.File{Path: ".gitlab-ci.yml", Offset: 22, Snippet: "cargo publish"},
+				Registry: checker.PackageRegistryCratesIO,
+				Tool:     "cargo publish",
+				Name:     "project",
+				Verified: &verified,
+			},
+			{
+				File:     checker.File{Path: ".gitlab-ci.yml", Offset: 31},
+				Registry: checker.PackageRegistryOCI,
+				Tool:     "oras push",
+				Signed:   true,
+			},
+		},
+	}
+	if err := r.addPackagingRawResults(pk); err != nil {
+		t.Fatalf("addPackagingRawResults returned an error: %v", err)
+	}
+
+	expected := []jsonPublicationTarget{
+		{
+			File:     jsonFile{Path: ".gitlab-ci.yml", Offset: 22, Snippet: asPointer("cargo publish")},
+			Registry: "crates.io",
+			Tool:     "cargo publish",
+			Name:     "project",
+			Verified: &verified,
+		},
+		{
+			File:     jsonFile{Path: ".gitlab-ci.yml", Offset: 31},
+			Registry: "oci",
+			Tool:     "oras push",
+			Signed:   true,
+		},
+	}
+	if !reflect.DeepEqual(r.Results.PublicationTargets, expected) {
+		t.Errorf("addPackagingRawResults did not produce the expected publication targets. Got: %v, Expected: %v",
+			r.Results.PublicationTargets, expected)
+	}
+}
+
 func TestJsonScorecardRawResult_AddTokenPermissionsRawResults(t *testing.T) {
 	t.Parallel()
 	loc := checker.PermissionLocation("testLocationType")
@@ -471,6 +517,135 @@ func TestJsonScorecardRawResult_AddMaintainedRawResults(t *testing.T) {
 	}
 }
 
+func TestAddSecurityPolicySecurityTxt(t *testing.T) {
+	t.Parallel()
+	expires := time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)
+	enabled := true
+	r := &jsonScorecardRawResult{}
+	sp := &checker.SecurityPolicyData{
+		PrivateVulnerabilityReporting: &enabled,
+		SecurityTxtFiles: []checker.SecurityTxt{
+			{
+				File:     checker.File{Path: ".well-known/security.txt"},
+				Expires:  &expires,
+				Contacts: []string{"mailto:security@example.com"},
+			},
+		},
+	}
+
+	if err := r.addSecurityPolicyRawResults(sp); err != nil {
+		t.Errorf("addSecurityPolicyRawResults returned an error: %v", err)
+	}
+
+	expected := []jsonSecurityTxt{
+		{
+			Path:     ".well-known/security.txt",
+			Expires:  &expires,
+			Contacts: []string{"mailto:security@example.com"},
+		},
+	}
+	if !cmp.Equal(r.Results.SecurityTxtFiles, expected) {
+		t.Errorf("unexpected security.txt files (-want +got):\n%s", cmp.Diff(expected, r.Results.SecurityTxtFiles))
+	}
+	if r.Results.PrivateVulnerabilityReporting == nil || !*r.Results.PrivateVulnerabilityReporting {
+		t.Errorf("expected private vulnerability reporting to be enabled")
+	}
+}
+
+func TestAddContributorsWindows(t *testing.T) {
+	t.Parallel()
+	r := &jsonScorecardRawResult{}
+	cr := &checker.ContributorsData{
+		Windows: []checker.ContributionWindow{
+			{
+				Days:                30,
+				Commits:             10,
+				Authors:             3,
+				BusFactor:           2,
+				TopAuthorShare:      40,
+				Affiliations:        2,
+				TopAffiliation:      "acme.com",
+				TopAffiliationShare: 70,
+			},
+		},
+	}
+
+	if err := r.addContributorsRawResults(cr); err != nil {
+		t.Errorf("addContributorsRawResults returned an error: %v", err)
+	}
+
+	expected := []jsonContributionWindow{
+		{
+			Days:                30,
+			Commits:             10,
+			Authors:             3,
+			BusFactor:           2,
+			TopAuthorShare:      40,
+			Affiliations:        2,
+			TopAffiliation:      "acme.com",
+			TopAffiliationShare: 70,
+		},
+	}
+	if !cmp.Equal(r.Results.Contributors.Windows, expected) {
+		t.Errorf("unexpected windows (-want +got):\n%s", cmp.Diff(expected, r.Results.Contributors.Windows))
+	}
+}
+
+func TestAddMaintainedTrackedItems(t *testing.T) {
+	t.Parallel()
+	created := time.Date(2023, time.July, 1, 0, 0, 0, 0, time.UTC)
+	response := created.Add(2 * time.Hour)
+	r := &jsonScorecardRawResult{}
+	mr := &checker.MaintainedData{
+		TrackedItems: []checker.TrackedItem{
+			{
+				Type:                    checker.TrackedItemIssue,
+				URI:                     "https://github.com/foo/bar/issues/1",
+				Author:                  "someone",
+				External:                true,
+				CreatedAt:               created,
+				FirstMaintainerResponse: &response,
+				LastMaintainerActivity:  &response,
+			},
+			{
+				Type:      checker.TrackedItemPullRequest,
+				Number:    2,
+				Author:    "maintainer",
+				CreatedAt: created,
+				ClosedAt:  &response,
+				MergedAt:  &response,
+			},
+		},
+	}
+
+	if err := r.addMaintainedRawResults(mr); err != nil {
+		t.Errorf("addMaintainedRawResults returned an error: %v", err)
+	}
+
+	expected := []jsonTrackedItem{
+		{
+			Type:                    "issue",
+			URL:                     "https://github.com/foo/bar/issues/1",
+			Author:                  "someone",
+			External:                true,
+			CreatedAt:               created,
+			FirstMaintainerResponse: &response,
+			LastMaintainerActivity:  &response,
+		},
+		{
+			Type:      "pullRequest",
+			Number:    2,
+			Author:    "maintainer",
+			CreatedAt: created,
+			ClosedAt:  &response,
+			MergedAt:  &response,
+		},
+	}
+	if !cmp.Equal(r.Results.TrackedItems, expected) {
+		t.Errorf("addMaintainedRawResults mismatch (-want +got):\n%s", cmp.Diff(expected, r.Results.TrackedItems))
+	}
+}
+
 func TestSetDefaultCommitData(t *testing.T) {
 	// Define some test data.
 	changesets := []checker.Changeset{
@@ -792,6 +967,63 @@ func TestAddLicenseRawResults(t *testing.T) {
 	}
 }
 
+func TestAddLicenseDeclarationsRawResults(t *testing.T) {
+	t.Parallel()
+	r := &jsonScorecardRawResult{}
+	ld := &checker.LicenseData{
+		ProjectLicenses:     []string{"Apache-2.0"},
+		SourceFiles:         3,
+		DeclaredSourceFiles: 2,
+		Declarations: []checker.LicenseDeclaration{
+			{
+				File:                     checker.File{Path: "lib/gpl.c", Offset: 1},
+				Source:                   checker.LicenseDeclarationSourceHeader,
+				Expression:               "GPL-3.0-or-later",
+				Licenses:                 []string{"GPL-3.0-or-later"},
+				ConflictsWithLicenseFile: true,
+			},
+			{
+				File:       checker.File{Path: ".reuse/dep5", Offset: 5},
+				Source:     checker.LicenseDeclarationSourceREUSEDep5,
+				Expression: "MIT AND",
+				Error:      "invalid SPDX license expression: unexpected end of expression",
+			},
+		},
+	}
+	if err := r.addLicenseRawResults(ld); err != nil {
+		t.Fatalf("addLicenseRawResults returned an error: %v", err)
+	}
+
+	expectedDeclarations := []jsonLicenseDeclaration{
+		{
+			File:       jsonFile{Path: "lib/gpl.c", Offset: 1},
+			Source:     "spdxHeader",
+			Expression: "GPL-3.0-or-later",
+			Licenses:   []string{"GPL-3.0-or-later"},
+			Conflicts:  true,
+		},
+		{
+			File:       jsonFile{Path: ".reuse/dep5", Offset: 5},
+			Source:     "reuseDep5",
+			Expression: "MIT AND",
+			Error:      "invalid SPDX license expression: unexpected end of expression",
+		},
+	}
+	if !reflect.DeepEqual(r.Results.LicenseDeclarations, expectedDeclarations) {
+		t.Errorf("addLicenseRawResults did not produce the expected declarations. Got: %v, Expected: %v",
+			r.Results.LicenseDeclarations, expectedDeclarations)
+	}
+	expectedCoverage := &jsonLicenseCoverage{
+		ProjectLicenses:     []string{"Apache-2.0"},
+		SourceFiles:         3,
+		DeclaredSourceFiles: 2,
+	}
+	if !reflect.DeepEqual(r.Results.LicenseCoverage, expectedCoverage) {
+		t.Errorf("addLicenseRawResults did not produce the expected coverage. Got: %v, Expected: %v",
+			r.Results.LicenseCoverage, expectedCoverage)
+	}
+}
+
 func TestAddBinaryArtifactRawResults(t *testing.T) {
 	r := &jsonScorecardRawResult{}
 	ba := &checker.BinaryArtifactData{
@@ -830,6 +1062,278 @@ func TestAddBinaryArtifactRawResults(t *testing.T) {
 	}
 }
 
+func TestAddSecretsRawResults(t *testing.T) {
+	r := &jsonScorecardRawResult{}
+	sd := &checker.SecretsData{
+		Secrets: []checker.Secret{
+			{
+				Type:           checker.SecretAWSAccessKey,
+				HighConfidence: true,
+				File: checker.File{
+					Path:      "deploy/config.yml",
+					Offset:    2,
+					EndOffset: 2,
+					Snippet:   "key: [REDACTED]",
+				},
+			},
+		},
+	}
+
+	err := r.addSecretsRawResults(sd)
+	if err != nil {
+		t.Errorf("addSecretsRawResults returned an error: %v", err)
+	}
+
+	snippet := "key: [REDACTED]"
+	expected := []jsonSecret{
+		{
+			Type:           "awsAccessKey",
+			HighConfidence: true,
+			File: jsonFile{
+				Path:      "deploy/config.yml",
+				Offset:    2,
+				EndOffset: 2,
+				Snippet:   &snippet,
+			},
+		},
+	}
+	if !cmp.Equal(r.Results.Secrets, expected) {
+		t.Errorf("addSecretsRawResults mismatch (-want +got):\n%s", cmp.Diff(expected, r.Results.Secrets))
+	}
+}
+
+func TestAddWorkflowSecretsRawResults(t *testing.T) {
+	r := &jsonScorecardRawResult{}
+	jobID := "release"
+	ws := &checker.WorkflowSecretsData{
+		NumWorkflows: 1,
+		Exposures: []checker.WorkflowSecretExposure{
+			{
+				Job:        &checker.WorkflowJob{ID: &jobID},
+				Secret:     "NPM_TOKEN",
+				Receiver:   "other-org/action@v1",
+				Type:       checker.WorkflowSecretUnpinnedReceiver,
+				ThirdParty: true,
+				File: checker.File{
+					Path:      ".github/workflows/release.yml",
+					Offset:    12,
+					EndOffset: 12,
+					Snippet:   "other-org/action@v1",
+				},
+			},
+		},
+	}
+
+	err := r.addWorkflowSecretsRawResults(ws)
+	if err != nil {
+		t.Errorf("addWorkflowSecretsRawResults returned an error: %v", err)
+	}
+
+	snippet := "other-org/action@v1"
+	expected := []jsonWorkflowSecretExposure{
+		{
+			Job:        &jsonWorkflowJob{ID: &jobID},
+			Secret:     "NPM_TOKEN",
+			Receiver:   "other-org/action@v1",
+			Type:       "unpinnedReceiver",
+			ThirdParty: true,
+			File: jsonFile{
+				Path:      ".github/workflows/release.yml",
+				Offset:    12,
+				EndOffset: 12,
+				Snippet:   &snippet,
+			},
+		},
+	}
+	if !cmp.Equal(r.Results.WorkflowSecretExposures, expected) {
+		t.Errorf("addWorkflowSecretsRawResults mismatch (-want +got):\n%s",
+			cmp.Diff(expected, r.Results.WorkflowSecretExposures))
+	}
+}
+
+func TestAddCodeownersRawResults(t *testing.T) {
+	r := &jsonScorecardRawResult{}
+	cd := &checker.CodeownersData{
+		File: &checker.File{Path: ".github/CODEOWNERS"},
+		Owners: []checker.CodeOwner{
+			{
+				Name:   "@ossf/maintainers",
+				Access: clients.CodeOwnerAccessWrite,
+				File: checker.File{
+					Path:      ".github/CODEOWNERS",
+					Offset:    1,
+					EndOffset: 1,
+					Snippet:   "@ossf/maintainers",
+				},
+			},
+		},
+		SensitivePaths: []checker.CodeownersPath{
+			{Path: "Makefile", Type: checker.CodeownersPathBuild},
+		},
+		NumFiles:      10,
+		NumOwnedFiles: 9,
+	}
+
+	err := r.addCodeownersRawResults(cd)
+	if err != nil {
+		t.Errorf("addCodeownersRawResults returned an error: %v", err)
+	}
+
+	snippet := "@ossf/maintainers"
+	expected := jsonCodeowners{
+		File: &jsonFile{Path: ".github/CODEOWNERS"},
+		Owners: []jsonCodeOwner{
+			{
+				Name:   "@ossf/maintainers",
+				Access: "write",
+				File: jsonFile{
+					Path:      ".github/CODEOWNERS",
+					Offset:    1,
+					EndOffset: 1,
+					Snippet:   &snippet,
+				},
+			},
+		},
+		SensitivePaths: []jsonCodeownersPath{
+			{Path: "Makefile", Type: "build"},
+		},
+		NumFiles:      10,
+		NumOwnedFiles: 9,
+	}
+	if !cmp.Equal(r.Results.Codeowners, expected) {
+		t.Errorf("addCodeownersRawResults mismatch (-want +got):\n%s",
+			cmp.Diff(expected, r.Results.Codeowners))
+	}
+}
+
+func TestAddDockerfileHardeningRawResults(t *testing.T) {
+	r := &jsonScorecardRawResult{}
+	dh := &checker.DockerfileHardeningData{
+		NumDockerfiles: 1,
+		Issues: []checker.DockerfileIssue{
+			{
+				Type:  checker.DockerfileRunsAsRoot,
+				Stage: "runtime",
+				File: checker.File{
+					Path:      "Dockerfile",
+					Offset:    8,
+					EndOffset: 8,
+					Snippet:   "USER root",
+				},
+			},
+		},
+	}
+
+	err := r.addDockerfileHardeningRawResults(dh)
+	if err != nil {
+		t.Errorf("addDockerfileHardeningRawResults returned an error: %v", err)
+	}
+
+	snippet := "USER root"
+	expected := []jsonDockerfileIssue{
+		{
+			Type:  "runsAsRoot",
+			Stage: "runtime",
+			File: jsonFile{
+				Path:      "Dockerfile",
+				Offset:    8,
+				EndOffset: 8,
+				Snippet:   &snippet,
+			},
+		},
+	}
+	if !cmp.Equal(r.Results.DockerfileIssues, expected) {
+		t.Errorf("addDockerfileHardeningRawResults mismatch (-want +got):\n%s",
+			cmp.Diff(expected, r.Results.DockerfileIssues))
+	}
+}
+
+func TestAddDependencyConfusionRawResults(t *testing.T) {
+	r := &jsonScorecardRawResult{}
+	dc := &checker.DependencyConfusionData{
+		NumConfigFiles: 1,
+		Issues: []checker.RegistryConfigIssue{
+			{
+				Type:      checker.RegistryConfigUnscopedSources,
+				Ecosystem: "pypi",
+				Registry:  "https://pypi.example.com/simple",
+				File: checker.File{
+					Path:      "requirements.txt",
+					Offset:    2,
+					EndOffset: 2,
+				},
+			},
+		},
+	}
+
+	err := r.addDependencyConfusionRawResults(dc)
+	if err != nil {
+		t.Errorf("addDependencyConfusionRawResults returned an error: %v", err)
+	}
+
+	expected := []jsonRegistryConfigIssue{
+		{
+			Type:      "unscopedSources",
+			Ecosystem: "pypi",
+			Registry:  "https://pypi.example.com/simple",
+			File: jsonFile{
+				Path:      "requirements.txt",
+				Offset:    2,
+				EndOffset: 2,
+			},
+		},
+	}
+	if !cmp.Equal(r.Results.RegistryConfigIssues, expected) {
+		t.Errorf("addDependencyConfusionRawResults mismatch (-want +got):\n%s",
+			cmp.Diff(expected, r.Results.RegistryConfigIssues))
+	}
+}
+
+func TestAddSASTRawResults(t *testing.T) {
+	r := &jsonScorecardRawResult{}
+	sast := &checker.SASTData{
+		Configs: []checker.SASTConfig{
+			{
+				Tool: checker.SASTToolCodeQL,
+				File: checker.File{
+					Path:      ".github/workflows/codeql.yml",
+					Offset:    40,
+					EndOffset: 40,
+				},
+			},
+		},
+		PullRequests: []checker.SASTPullRequest{
+			{Number: 1, HeadSHA: "sha1", Tools: []checker.SASTTool{checker.SASTToolCodeQL, checker.SASTToolSemgrep}},
+			{Number: 2, HeadSHA: "sha2"},
+		},
+	}
+
+	err := r.addSASTRawResults(sast)
+	if err != nil {
+		t.Errorf("addSASTRawResults returned an error: %v", err)
+	}
+
+	expected := jsonSAST{
+		Configs: []jsonSASTConfig{
+			{
+				Tool: "CodeQL",
+				File: jsonFile{
+					Path:      ".github/workflows/codeql.yml",
+					Offset:    40,
+					EndOffset: 40,
+				},
+			},
+		},
+		PullRequests: []jsonSASTPullRequest{
+			{Number: 1, HeadSHA: "sha1", Tools: []string{"CodeQL", "Semgrep"}},
+			{Number: 2, HeadSHA: "sha2", Tools: []string{}},
+		},
+	}
+	if !cmp.Equal(r.Results.SAST, expected) {
+		t.Errorf("addSASTRawResults mismatch (-want +got):\n%s", cmp.Diff(expected, r.Results.SAST))
+	}
+}
+
 func TestAddSecurityPolicyRawResults(t *testing.T) {
 	r := &jsonScorecardRawResult{}
 	sp := &checker.SecurityPolicyData{
@@ -1248,7 +1752,7 @@ func TestScorecardResult_AsRawJSON(t *testing.T) {
 					CommitSHA: "1234567890123456789012345678901234567890",
 				},
 			},
-			wantWriter: `{"date":"0001-01-01","repo":{"name":"bar","commit":"1234567890123456789012345678901234567890"},"scorecard":{"version":"","commit":""},"metadata":null,"results":{"workflows":[],"permissions":{},"licenses":[],"issues":null,"openssfBestPracticesBadge":{"badge":"Unknown"},"databaseVulnerabilities":[],"binaries":[],"securityPolicies":[],"dependencyUpdateTools":[],"branchProtections":{"branches":[],"codeownersFiles":null},"Contributors":{"users":null},"defaultBranchChangesets":[],"archived":{"status":false},"createdAt":{"timestamp":"0001-01-01T00:00:00Z"},"fuzzers":[],"releases":[],"packages":[],"dependencyPinning":{"dependencies":null}}}
+			wantWriter: `{"date":"0001-01-01","repo":{"name":"bar","commit":"1234567890123456789012345678901234567890"},"scorecard":{"version":"","commit":""},"metadata":null,"results":{"workflows":[],"permissions":{},"licenses":[],"issues":null,"openssfBestPracticesBadge":{"badge":"Unknown"},"databaseVulnerabilities":[],"binaries":[],"securityPolicies":[],"dependencyUpdateTools":[],"branchProtections":{"branches":[],"codeownersFiles":null},"Contributors":{"users":null},"defaultBranchChangesets":[],"archived":{"status":false},"createdAt":{"timestamp":"0001-01-01T00:00:00Z"},"fuzzers":[],"releases":[],"packages":[],"publicationTargets":[],"dependencyPinning":{"dependencies":null},"secrets":[],"workflowSecretExposures":[],"codeowners":{"file":null,"owners":[],"sensitivePaths":[],"numFiles":0,"numOwnedFiles":0},"dockerfileIssues":[],"registryConfigIssues":[],"sast":{"configs":[],"pullRequests":[]},"trackedItems":[]}}
 `, //nolint:lll
 		},
 	}
@@ -41,7 +41,7 @@ var errEmptyRepository = errors.New("repository empty")
 func runEnabledChecks(ctx context.Context,
 	repo clients.Repo, raw *checker.RawResults, checksToRun checker.CheckNameToFnMap,
 	repoClient clients.RepoClient, ossFuzzRepoClient clients.RepoClient, ciiClient clients.CIIBestPracticesClient,
-	vulnsClient clients.VulnerabilitiesClient,
+	vulnsClient clients.VulnerabilitiesClient, emailDomainOrgs map[string]string,
 	resultsCh chan checker.CheckResult,
 ) {
 	request := checker.CheckRequest{
@@ -50,6 +50,7 @@ func runEnabledChecks(ctx context.Context,
 		OssFuzzRepo:           ossFuzzRepoClient,
 		CIIClient:             ciiClient,
 		VulnerabilitiesClient: vulnsClient,
+		EmailDomainOrgs:       emailDomainOrgs,
 		Repo:                  repo,
 		RawResults:            raw,
 	}
@@ -90,6 +91,7 @@ func getRepoCommitHash(r clients.RepoClient) (string, error) {
 }
 
 // RunScorecard runs enabled Scorecard checks on a Repo.
+// emailDomainOrgs maps email domains to organizations, and may be nil.
 func RunScorecard(ctx context.Context,
 	repo clients.Repo,
 	commitSHA string,
@@ -99,6 +101,7 @@ func RunScorecard(ctx context.Context,
 	ossFuzzRepoClient clients.RepoClient,
 	ciiClient clients.CIIBestPracticesClient,
 	vulnsClient clients.VulnerabilitiesClient,
+	emailDomainOrgs map[string]string,
 ) (ScorecardResult, error) {
 	if err := repoClient.InitRepo(repo, commitSHA, commitDepth); err != nil {
 		// No need to call sce.WithMessage() since InitRepo will do that for us.
@@ -152,7 +155,7 @@ func RunScorecard(ctx context.Context,
 
 	go runEnabledChecks(ctx, repo, &ret.RawResults, checksToRun,
 		repoClient, ossFuzzRepoClient,
-		ciiClient, vulnsClient, resultsCh)
+		ciiClient, vulnsClient, emailDomainOrgs, resultsCh)
 
 	for result := range resultsCh {
 		ret.Checks = append(ret.Checks, result)
@@ -110,7 +110,7 @@ var _ = Describe("E2E TEST: RunScorecard with re-used repoClient", func() {
 			lastRepo := repos[len(repos)-1]
 			repo, rc, ofrc, cc, vc, err := checker.GetClients(ctx, lastRepo, "", isolatedLogger)
 			Expect(err).Should(BeNil())
-			isolatedResult, err := RunScorecard(ctx, repo, clients.HeadSHA, 0, allChecks, rc, ofrc, cc, vc)
+			isolatedResult, err := RunScorecard(ctx, repo, clients.HeadSHA, 0, allChecks, rc, ofrc, cc, vc, nil)
 			Expect(err).Should(BeNil())
 
 			logger := sclog.NewLogger(sclog.DebugLevel)
@@ -121,7 +121,7 @@ var _ = Describe("E2E TEST: RunScorecard with re-used repoClient", func() {
 			for i := range repos {
 				repo, err = githubrepo.MakeGithubRepo(repos[i])
 				Expect(err).Should(BeNil())
-				sharedResult, err = RunScorecard(ctx, repo, clients.HeadSHA, 0, allChecks, rc2, ofrc2, cc2, vc2)
+				sharedResult, err = RunScorecard(ctx, repo, clients.HeadSHA, 0, allChecks, rc2, ofrc2, cc2, vc2, nil)
 				Expect(err).Should(BeNil())
 			}
 
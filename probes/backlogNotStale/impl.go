@@ -0,0 +1,87 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// nolint:stylecheck
+package backlogNotStale
+
+import (
+	"embed"
+	"fmt"
+	"time"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/responsiveness"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe = "backlogNotStale"
+	// DaysOpenKey is the name of the value holding the number of days
+	// a stale issue or pull request has been open.
+	DaysOpenKey = "daysOpen"
+	staleDays   = 90
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	items := raw.MaintainedResults.TrackedItems
+	if len(items) == 0 {
+		f, err := finding.NewWith(fs, Probe,
+			"no recent issues or pull requests", nil,
+			finding.OutcomeNotApplicable)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+
+	threshold := time.Now().AddDate(0 /*years*/, 0 /*months*/, -1*staleDays /*days*/)
+	var findings []finding.Finding
+	for i := range items {
+		item := &items[i]
+		if item.ClosedAt != nil || item.CreatedAt.After(threshold) ||
+			(item.LastMaintainerActivity != nil && item.LastMaintainerActivity.After(threshold)) {
+			continue
+		}
+		daysOpen := int(time.Since(item.CreatedAt).Hours() / 24)
+		f, err := finding.NewWith(fs, Probe,
+			fmt.Sprintf("%s open for %d days without maintainer activity in the last %d days",
+				responsiveness.Describe(item), daysOpen, staleDays), nil,
+			finding.OutcomeNegative)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		f.Values = map[string]int{
+			DaysOpenKey: daysOpen,
+		}
+		findings = append(findings, *f)
+	}
+	if len(findings) == 0 {
+		f, err := finding.NewWith(fs, Probe,
+			"no stale open issues or pull requests", nil,
+			finding.OutcomePositive)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+	return findings, Probe, nil
+}
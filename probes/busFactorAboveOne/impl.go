@@ -0,0 +1,79 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// nolint:stylecheck
+package busFactorAboveOne
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe = "busFactorAboveOne"
+	// DaysKey is the name of the value holding the number of days of the window.
+	DaysKey = "days"
+	// BusFactorKey is the name of the value holding the bus factor.
+	BusFactorKey = "busFactor"
+	// TopAuthorShareKey is the name of the value holding the percentage
+	// of the commits of the most active author.
+	TopAuthorShareKey = "topAuthorShare"
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	var findings []finding.Finding
+	for _, w := range raw.ContributorsResults.Windows {
+		if w.Commits == 0 {
+			continue
+		}
+		outcome := finding.OutcomePositive
+		if w.BusFactor <= 1 {
+			outcome = finding.OutcomeNegative
+		}
+		f, err := finding.NewWith(fs, Probe,
+			fmt.Sprintf("bus factor of %d in the last %d days: the most active of %d authors made %d%% of the %d commits",
+				w.BusFactor, w.Days, w.Authors, w.TopAuthorShare, w.Commits), nil,
+			outcome)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		f.Values = map[string]int{
+			DaysKey:           w.Days,
+			BusFactorKey:      w.BusFactor,
+			TopAuthorShareKey: w.TopAuthorShare,
+		}
+		findings = append(findings, *f)
+	}
+	if len(findings) == 0 {
+		f, err := finding.NewWith(fs, Probe,
+			"no recent commits", nil,
+			finding.OutcomeNotApplicable)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+	return findings, Probe, nil
+}
@@ -0,0 +1,71 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// nolint:stylecheck
+package codeownersCoverFiles
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe = "codeownersCoverFiles"
+	// OwnedFilesKey is the name of the value holding the number of files with owners.
+	OwnedFilesKey = "ownedFiles"
+	// TotalFilesKey is the name of the value holding the number of files of the repository.
+	TotalFilesKey = "totalFiles"
+	// minCoveragePercent is the share of files with owners needed for a positive outcome.
+	minCoveragePercent = 80
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	r := &raw.CodeownersResults
+	if r.File == nil || r.NumFiles == 0 {
+		f, err := finding.NewWith(fs, Probe,
+			"no files to own", nil,
+			finding.OutcomeNotApplicable)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+
+	outcome := finding.OutcomePositive
+	if r.NumOwnedFiles*100 < r.NumFiles*minCoveragePercent {
+		outcome = finding.OutcomeNegative
+	}
+	f, err := finding.NewWith(fs, Probe,
+		fmt.Sprintf("%d out of %d files have code owners", r.NumOwnedFiles, r.NumFiles), nil,
+		outcome)
+	if err != nil {
+		return nil, Probe, fmt.Errorf("create finding: %w", err)
+	}
+	f.Values = map[string]int{
+		OwnedFilesKey: r.NumOwnedFiles,
+		TotalFilesKey: r.NumFiles,
+	}
+	return []finding.Finding{*f}, Probe, nil
+}
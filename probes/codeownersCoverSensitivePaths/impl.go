@@ -0,0 +1,69 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// nolint:stylecheck
+package codeownersCoverSensitivePaths
+
+import (
+	"embed"
+	"fmt"
+	"strings"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+//go:embed *.yml
+var fs embed.FS
+
+const Probe = "codeownersCoverSensitivePaths"
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	r := &raw.CodeownersResults
+	if r.File == nil || len(r.SensitivePaths) == 0 {
+		f, err := finding.NewWith(fs, Probe,
+			"no sensitive files to own", nil,
+			finding.OutcomeNotApplicable)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+
+	var findings []finding.Finding
+	for i := range r.SensitivePaths {
+		p := &r.SensitivePaths[i]
+		msg := fmt.Sprintf("%s file owned by %s", p.Type, strings.Join(p.Owners, ", "))
+		outcome := finding.OutcomePositive
+		if len(p.Owners) == 0 {
+			msg = fmt.Sprintf("%s file has no code owners", p.Type)
+			outcome = finding.OutcomeNegative
+		}
+		loc := &finding.Location{
+			Type: finding.FileTypeText,
+			Path: p.Path,
+		}
+		f, err := finding.NewWith(fs, Probe, msg, loc, outcome)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		findings = append(findings, *f)
+	}
+	return findings, Probe, nil
+}
@@ -0,0 +1,75 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// nolint:stylecheck
+package codeownersOwnersValid
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/clients"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+//go:embed *.yml
+var fs embed.FS
+
+const Probe = "codeownersOwnersValid"
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	r := &raw.CodeownersResults
+	if r.File == nil || len(r.Owners) == 0 {
+		f, err := finding.NewWith(fs, Probe,
+			"no code owners found", nil,
+			finding.OutcomeNotApplicable)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+
+	var findings []finding.Finding
+	for i := range r.Owners {
+		o := &r.Owners[i]
+		var msg string
+		var outcome finding.Outcome
+		switch o.Access {
+		case clients.CodeOwnerAccessWrite:
+			msg = fmt.Sprintf("code owner %s has write access", o.Name)
+			outcome = finding.OutcomePositive
+		case clients.CodeOwnerAccessRead:
+			msg = fmt.Sprintf("code owner %s does not have write access", o.Name)
+			outcome = finding.OutcomeNegative
+		case clients.CodeOwnerAccessNotFound:
+			msg = fmt.Sprintf("code owner %s does not exist", o.Name)
+			outcome = finding.OutcomeNegative
+		default:
+			msg = fmt.Sprintf("could not determine the access of code owner %s", o.Name)
+			outcome = finding.OutcomeNotAvailable
+		}
+		f, err := finding.NewWith(fs, Probe, msg, o.File.Location(), outcome)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		findings = append(findings, *f)
+	}
+	return findings, Probe, nil
+}
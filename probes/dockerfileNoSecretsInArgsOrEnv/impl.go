@@ -0,0 +1,49 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// nolint:stylecheck
+package dockerfileNoSecretsInArgsOrEnv
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/dockerfile"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+//go:embed *.yml
+var fs embed.FS
+
+const Probe = "dockerfileNoSecretsInArgsOrEnv"
+
+func issueText(issue *checker.DockerfileIssue) string {
+	if issue.Stage == "" {
+		// Build arguments declared before the first FROM are global.
+		return "global build argument holds a secret"
+	}
+	return fmt.Sprintf("stage %s passes a secret with ARG or ENV", issue.Stage)
+}
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+	//nolint:wrapcheck
+	return dockerfile.Run(&raw.DockerfileHardeningResults, fs, Probe,
+		checker.DockerfileSecretInArgOrEnv, issueText,
+		"no secrets passed with ARG or ENV")
+}
@@ -0,0 +1,61 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// nolint:stylecheck
+package dockerfileNoSecretsInArgsOrEnv
+
+import (
+	"testing"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/test"
+)
+
+// The shared behavior of the probe is tested in probes/internal/utils/dockerfile:
+// only the issues of the probe are reported.
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	var issues []checker.DockerfileIssue
+	for _, issueType := range []checker.DockerfileIssueType{
+		checker.DockerfileRunsAsRoot,
+		checker.DockerfileRemoteAdd,
+		checker.DockerfileSecretInArgOrEnv,
+		checker.DockerfileFetchPipeExecute,
+		checker.DockerfileMissingHealthcheck,
+		checker.DockerfileLatestBaseImage,
+	} {
+		issues = append(issues, checker.DockerfileIssue{
+			Type:  issueType,
+			Stage: "builder",
+			File:  checker.File{Path: "Dockerfile", Offset: 1},
+		})
+	}
+	raw := &checker.RawResults{
+		DockerfileHardeningResults: checker.DockerfileHardeningData{
+			NumDockerfiles: 1,
+			Issues:         issues,
+		},
+	}
+
+	findings, s, err := Run(raw)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	test.AssertCorrect(t, Probe, s, findings, []finding.Outcome{finding.OutcomeNegative})
+	want := "stage builder passes a secret with ARG or ENV"
+	if len(findings) == 1 && findings[0].Message != want {
+		t.Errorf("unexpected message: %s", findings[0].Message)
+	}
+}
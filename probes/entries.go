@@ -17,7 +17,22 @@ package probes
 import (
 	"github.com/ossf/scorecard/v4/checker"
 	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/backlogNotStale"
+	"github.com/ossf/scorecard/v4/probes/busFactorAboveOne"
+	"github.com/ossf/scorecard/v4/probes/codeownersCoverFiles"
+	"github.com/ossf/scorecard/v4/probes/codeownersCoverSensitivePaths"
+	"github.com/ossf/scorecard/v4/probes/codeownersOwnersValid"
+	"github.com/ossf/scorecard/v4/probes/commitsFromMultipleAffiliations"
 	"github.com/ossf/scorecard/v4/probes/contributorsFromOrgOrCompany"
+	"github.com/ossf/scorecard/v4/probes/dependabotSecurityUpdatesEnabled"
+	"github.com/ossf/scorecard/v4/probes/dependencySourcesScoped"
+	"github.com/ossf/scorecard/v4/probes/dockerfileNoFetchPipeExecute"
+	"github.com/ossf/scorecard/v4/probes/dockerfileNoLatestBaseImage"
+	"github.com/ossf/scorecard/v4/probes/dockerfileNoRemoteAdd"
+	"github.com/ossf/scorecard/v4/probes/dockerfileNoSecretsInArgsOrEnv"
+	"github.com/ossf/scorecard/v4/probes/dockerfileRunsAsNonRoot"
+	"github.com/ossf/scorecard/v4/probes/dockerfileServicesHaveHealthcheck"
+	"github.com/ossf/scorecard/v4/probes/externalPullRequestsGetFeedback"
 	"github.com/ossf/scorecard/v4/probes/fuzzedWithCLibFuzzer"
 	"github.com/ossf/scorecard/v4/probes/fuzzedWithClusterFuzzLite"
 	"github.com/ossf/scorecard/v4/probes/fuzzedWithCppLibFuzzer"
@@ -31,18 +46,48 @@ import (
 	"github.com/ossf/scorecard/v4/probes/fuzzedWithPythonAtheris"
 	"github.com/ossf/scorecard/v4/probes/fuzzedWithRustCargofuzz"
 	"github.com/ossf/scorecard/v4/probes/fuzzedWithSwiftLibFuzzer"
+	"github.com/ossf/scorecard/v4/probes/hasCodeownersFile"
 	"github.com/ossf/scorecard/v4/probes/hasFSFOrOSIApprovedLicense"
+	"github.com/ossf/scorecard/v4/probes/hasHardcodedSecrets"
+	"github.com/ossf/scorecard/v4/probes/hasHighEntropySecrets"
+	"github.com/ossf/scorecard/v4/probes/hasLicenseDeclaredInSourceFiles"
+	"github.com/ossf/scorecard/v4/probes/hasLicenseExpressionsMatchingLicenseFile"
 	"github.com/ossf/scorecard/v4/probes/hasLicenseFile"
 	"github.com/ossf/scorecard/v4/probes/hasLicenseFileAtTopDir"
 	"github.com/ossf/scorecard/v4/probes/hasOSVVulnerabilities"
+	"github.com/ossf/scorecard/v4/probes/hasValidLicenseExpressions"
+	"github.com/ossf/scorecard/v4/probes/maintainersRespondToIssues"
+	"github.com/ossf/scorecard/v4/probes/maintainersRespondToPullRequests"
+	"github.com/ossf/scorecard/v4/probes/packageRegistriesUseHTTPS"
+	"github.com/ossf/scorecard/v4/probes/packagedInRegistry"
 	"github.com/ossf/scorecard/v4/probes/packagedWithAutomatedWorkflow"
+	"github.com/ossf/scorecard/v4/probes/packagedWithProvenance"
+	"github.com/ossf/scorecard/v4/probes/packagedWithTrustedPublishing"
+	"github.com/ossf/scorecard/v4/probes/privateVulnerabilityReportingEnabled"
+	"github.com/ossf/scorecard/v4/probes/registryCredentialsNotEmbedded"
+	"github.com/ossf/scorecard/v4/probes/sastToolConfigured"
+	"github.com/ossf/scorecard/v4/probes/sastToolRunsOnAllCommits"
+	"github.com/ossf/scorecard/v4/probes/secretPushProtectionEnabled"
+	"github.com/ossf/scorecard/v4/probes/secretScanningEnabled"
 	"github.com/ossf/scorecard/v4/probes/securityPolicyContainsLinks"
 	"github.com/ossf/scorecard/v4/probes/securityPolicyContainsText"
 	"github.com/ossf/scorecard/v4/probes/securityPolicyContainsVulnerabilityDisclosure"
+	"github.com/ossf/scorecard/v4/probes/securityPolicyDefinesEmbargo"
+	"github.com/ossf/scorecard/v4/probes/securityPolicyDefinesResponseTime"
+	"github.com/ossf/scorecard/v4/probes/securityPolicyDefinesSupportedVersions"
+	"github.com/ossf/scorecard/v4/probes/securityPolicyHasPrivateReportingChannel"
 	"github.com/ossf/scorecard/v4/probes/securityPolicyPresent"
+	"github.com/ossf/scorecard/v4/probes/securityPolicyProvidesEncryptionKey"
+	"github.com/ossf/scorecard/v4/probes/securityTxtValid"
 	"github.com/ossf/scorecard/v4/probes/toolDependabotInstalled"
 	"github.com/ossf/scorecard/v4/probes/toolPyUpInstalled"
 	"github.com/ossf/scorecard/v4/probes/toolRenovateInstalled"
+	"github.com/ossf/scorecard/v4/probes/workflowSecretsNotExposedToForks"
+	"github.com/ossf/scorecard/v4/probes/workflowSecretsNotInheritedByThirdParty"
+	"github.com/ossf/scorecard/v4/probes/workflowSecretsNotLogged"
+	"github.com/ossf/scorecard/v4/probes/workflowSecretsNotPassedToUnpinnedThirdParty"
+	"github.com/ossf/scorecard/v4/probes/workflowTokenReadOnlyByDefault"
+	"github.com/ossf/scorecard/v4/probes/workflowsCannotApprovePullRequests"
 )
 
 // ProbeImpl is the implementation of a probe.
@@ -58,6 +103,12 @@ var (
 		securityPolicyContainsLinks.Run,
 		securityPolicyContainsVulnerabilityDisclosure.Run,
 		securityPolicyContainsText.Run,
+		securityPolicyHasPrivateReportingChannel.Run,
+		securityPolicyDefinesSupportedVersions.Run,
+		securityPolicyDefinesResponseTime.Run,
+		securityPolicyDefinesEmbargo.Run,
+		securityPolicyProvidesEncryptionKey.Run,
+		securityTxtValid.Run,
 	}
 	// DependencyToolUpdates is all the probes for the
 	// DpendencyUpdateTool check.
@@ -83,18 +134,73 @@ var (
 	}
 	Packaging = []ProbeImpl{
 		packagedWithAutomatedWorkflow.Run,
+		packagedWithTrustedPublishing.Run,
+		packagedWithProvenance.Run,
+		packagedInRegistry.Run,
 	}
 	License = []ProbeImpl{
 		hasLicenseFile.Run,
 		hasFSFOrOSIApprovedLicense.Run,
 		hasLicenseFileAtTopDir.Run,
+		hasLicenseDeclaredInSourceFiles.Run,
+		hasValidLicenseExpressions.Run,
+		hasLicenseExpressionsMatchingLicenseFile.Run,
 	}
 	Contributors = []ProbeImpl{
 		contributorsFromOrgOrCompany.Run,
+		busFactorAboveOne.Run,
+		commitsFromMultipleAffiliations.Run,
 	}
 	Vulnerabilities = []ProbeImpl{
 		hasOSVVulnerabilities.Run,
 	}
+	SecuritySettings = []ProbeImpl{
+		secretScanningEnabled.Run,
+		secretPushProtectionEnabled.Run,
+		dependabotSecurityUpdatesEnabled.Run,
+		privateVulnerabilityReportingEnabled.Run,
+		workflowTokenReadOnlyByDefault.Run,
+		workflowsCannotApprovePullRequests.Run,
+	}
+	Secrets = []ProbeImpl{
+		hasHardcodedSecrets.Run,
+		hasHighEntropySecrets.Run,
+	}
+	WorkflowSecrets = []ProbeImpl{
+		workflowSecretsNotInheritedByThirdParty.Run,
+		workflowSecretsNotPassedToUnpinnedThirdParty.Run,
+		workflowSecretsNotLogged.Run,
+		workflowSecretsNotExposedToForks.Run,
+	}
+	Codeowners = []ProbeImpl{
+		hasCodeownersFile.Run,
+		codeownersOwnersValid.Run,
+		codeownersCoverSensitivePaths.Run,
+		codeownersCoverFiles.Run,
+	}
+	DockerfileHardening = []ProbeImpl{
+		dockerfileRunsAsNonRoot.Run,
+		dockerfileNoRemoteAdd.Run,
+		dockerfileNoSecretsInArgsOrEnv.Run,
+		dockerfileNoFetchPipeExecute.Run,
+		dockerfileServicesHaveHealthcheck.Run,
+		dockerfileNoLatestBaseImage.Run,
+	}
+	DependencyConfusion = []ProbeImpl{
+		dependencySourcesScoped.Run,
+		packageRegistriesUseHTTPS.Run,
+		registryCredentialsNotEmbedded.Run,
+	}
+	SAST = []ProbeImpl{
+		sastToolConfigured.Run,
+		sastToolRunsOnAllCommits.Run,
+	}
+	Maintained = []ProbeImpl{
+		maintainersRespondToIssues.Run,
+		maintainersRespondToPullRequests.Run,
+		externalPullRequestsGetFeedback.Run,
+		backlogNotStale.Run,
+	}
 )
 
 //nolint:gochecknoinits
@@ -105,6 +211,14 @@ func init() {
 		Fuzzing,
 		License,
 		Contributors,
+		SecuritySettings,
+		Secrets,
+		WorkflowSecrets,
+		Codeowners,
+		DockerfileHardening,
+		DependencyConfusion,
+		SAST,
+		Maintained,
 	})
 }
 
@@ -0,0 +1,76 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// nolint:stylecheck
+package externalPullRequestsGetFeedback
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/responsiveness"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+//go:embed *.yml
+var fs embed.FS
+
+const Probe = "externalPullRequestsGetFeedback"
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	var findings []finding.Finding
+	items := raw.MaintainedResults.TrackedItems
+	for i := range items {
+		item := &items[i]
+		if item.Type != checker.TrackedItemPullRequest || !item.External || item.ClosedAt == nil {
+			continue
+		}
+		var f *finding.Finding
+		var err error
+		switch {
+		case item.MergedAt != nil:
+			f, err = finding.NewWith(fs, Probe,
+				fmt.Sprintf("%s merged", responsiveness.Describe(item)), nil,
+				finding.OutcomePositive)
+		case item.FirstMaintainerResponse != nil:
+			f, err = finding.NewWith(fs, Probe,
+				fmt.Sprintf("%s closed with feedback from a maintainer", responsiveness.Describe(item)), nil,
+				finding.OutcomePositive)
+		default:
+			f, err = finding.NewWith(fs, Probe,
+				fmt.Sprintf("%s closed without feedback from a maintainer", responsiveness.Describe(item)), nil,
+				finding.OutcomeNegative)
+		}
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		findings = append(findings, *f)
+	}
+	if len(findings) == 0 {
+		f, err := finding.NewWith(fs, Probe,
+			"no recently closed pull requests opened by external users", nil,
+			finding.OutcomeNotApplicable)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+	return findings, Probe, nil
+}
@@ -0,0 +1,101 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// nolint:stylecheck
+package externalPullRequestsGetFeedback
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/test"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	created := time.Date(2023, time.July, 1, 0, 0, 0, 0, time.UTC)
+	later := created.AddDate(0, 0, 1)
+	// nolint:govet
+	tests := []struct {
+		name     string
+		raw      *checker.RawResults
+		outcomes []finding.Outcome
+		err      error
+	}{
+		{
+			name: "no pull requests",
+			raw:  &checker.RawResults{},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNotApplicable,
+			},
+		},
+		{
+			name: "open and maintainer pull requests are ignored",
+			raw: &checker.RawResults{
+				MaintainedResults: checker.MaintainedData{
+					TrackedItems: []checker.TrackedItem{
+						{Type: checker.TrackedItemPullRequest, Number: 1, External: true, CreatedAt: created},
+						{Type: checker.TrackedItemPullRequest, Number: 2, CreatedAt: created, ClosedAt: &later},
+						{Type: checker.TrackedItemIssue, URI: "3", External: true, CreatedAt: created, ClosedAt: &later},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNotApplicable,
+			},
+		},
+		{
+			name: "closed external pull requests",
+			raw: &checker.RawResults{
+				MaintainedResults: checker.MaintainedData{
+					TrackedItems: []checker.TrackedItem{
+						{Type: checker.TrackedItemPullRequest, Number: 1, External: true, CreatedAt: created, ClosedAt: &later, MergedAt: &later},
+						{Type: checker.TrackedItemPullRequest, Number: 2, External: true, CreatedAt: created, ClosedAt: &later, FirstMaintainerResponse: &later},
+						{Type: checker.TrackedItemPullRequest, Number: 3, External: true, CreatedAt: created, ClosedAt: &later},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomePositive,
+				finding.OutcomePositive,
+				finding.OutcomeNegative,
+			},
+		},
+		{
+			name: "nil raw",
+			err:  uerror.ErrNil,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			findings, s, err := Run(tt.raw)
+			if !cmp.Equal(tt.err, err, cmpopts.EquateErrors()) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(tt.err, err, cmpopts.EquateErrors()))
+			}
+			if err != nil {
+				return
+			}
+			test.AssertCorrect(t, Probe, s, findings, tt.outcomes)
+		})
+	}
+}
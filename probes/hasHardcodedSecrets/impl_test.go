@@ -0,0 +1,55 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// nolint:stylecheck
+package hasHardcodedSecrets
+
+import (
+	"testing"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/test"
+)
+
+// The shared behavior of the probe is tested in probes/internal/utils/secrets:
+// only the secrets of the confidence level of the probe are reported.
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	raw := &checker.RawResults{
+		SecretsResults: checker.SecretsData{
+			Secrets: []checker.Secret{
+				{
+					Type:           checker.SecretAWSAccessKey,
+					HighConfidence: true,
+					File:           checker.File{Path: "config.yml", Offset: 1, Snippet: "key: [REDACTED]"},
+				},
+				{
+					Type: checker.SecretHighEntropy,
+					File: checker.File{Path: "config.yml", Offset: 2, Snippet: "password: [REDACTED]"},
+				},
+			},
+		},
+	}
+
+	findings, s, err := Run(raw)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	test.AssertCorrect(t, Probe, s, findings, []finding.Outcome{finding.OutcomeNegative})
+	want := "hard-coded secret of type awsAccessKey found"
+	if len(findings) == 1 && findings[0].Message != want {
+		t.Errorf("unexpected message: %s", findings[0].Message)
+	}
+}
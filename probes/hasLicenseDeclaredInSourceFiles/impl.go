@@ -0,0 +1,57 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// nolint:stylecheck
+package hasLicenseDeclaredInSourceFiles
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+//go:embed *.yml
+var fs embed.FS
+
+const Probe = "hasLicenseDeclaredInSourceFiles"
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	r := &raw.LicenseResults
+	var f *finding.Finding
+	var err error
+	switch {
+	case r.SourceFiles == 0:
+		f, err = finding.NewWith(fs, Probe,
+			"project has no source files", nil,
+			finding.OutcomeNotApplicable)
+	case r.DeclaredSourceFiles == r.SourceFiles:
+		f, err = finding.NewPositive(fs, Probe,
+			fmt.Sprintf("all %d source files have a license declaration", r.SourceFiles), nil)
+	default:
+		f, err = finding.NewNegative(fs, Probe,
+			fmt.Sprintf("%d out of %d source files (%d%%) have a license declaration",
+				r.DeclaredSourceFiles, r.SourceFiles, r.DeclaredSourceFiles*100/r.SourceFiles), nil)
+	}
+	if err != nil {
+		return nil, Probe, fmt.Errorf("create finding: %w", err)
+	}
+	return []finding.Finding{*f}, Probe, nil
+}
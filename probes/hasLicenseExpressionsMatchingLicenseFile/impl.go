@@ -0,0 +1,80 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// nolint:stylecheck
+package hasLicenseExpressionsMatchingLicenseFile
+
+import (
+	"embed"
+	"fmt"
+	"strings"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+//go:embed *.yml
+var fs embed.FS
+
+const Probe = "hasLicenseExpressionsMatchingLicenseFile"
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	r := &raw.LicenseResults
+	valid := 0
+	var findings []finding.Finding
+	for i := range r.Declarations {
+		d := &r.Declarations[i]
+		if d.Error != "" {
+			continue
+		}
+		valid++
+		if !d.ConflictsWithLicenseFile {
+			continue
+		}
+		f, err := finding.NewNegative(fs, Probe,
+			fmt.Sprintf("license expression '%s' conflicts with the project licenses: %s",
+				d.Expression, strings.Join(r.ProjectLicenses, ", ")), d.File.Location())
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		findings = append(findings, *f)
+	}
+
+	var f *finding.Finding
+	var err error
+	switch {
+	case len(findings) > 0:
+		return findings, Probe, nil
+	case valid == 0:
+		f, err = finding.NewWith(fs, Probe,
+			"project has no valid license declarations", nil,
+			finding.OutcomeNotApplicable)
+	case len(r.ProjectLicenses) == 0:
+		f, err = finding.NewWith(fs, Probe,
+			"the licenses of the project are unknown", nil,
+			finding.OutcomeNotApplicable)
+	default:
+		f, err = finding.NewPositive(fs, Probe,
+			fmt.Sprintf("%d license declarations are consistent with the project licenses", valid), nil)
+	}
+	if err != nil {
+		return nil, Probe, fmt.Errorf("create finding: %w", err)
+	}
+	return []finding.Finding{*f}, Probe, nil
+}
@@ -0,0 +1,121 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// nolint:stylecheck
+package hasValidLicenseExpressions
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/test"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	// nolint:govet
+	tests := []struct {
+		name     string
+		raw      *checker.RawResults
+		outcomes []finding.Outcome
+		err      error
+	}{
+		{
+			name: "no license declarations",
+			raw:  &checker.RawResults{},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNotApplicable,
+			},
+		},
+		{
+			name: "valid license declarations",
+			raw: &checker.RawResults{
+				LicenseResults: checker.LicenseData{
+					Declarations: []checker.LicenseDeclaration{
+						{
+							File:       checker.File{Path: "main.go", Offset: 1},
+							Source:     checker.LicenseDeclarationSourceHeader,
+							Expression: "Apache-2.0",
+							Licenses:   []string{"Apache-2.0"},
+						},
+						{
+							File:       checker.File{Path: "REUSE.toml"},
+							Source:     checker.LicenseDeclarationSourceREUSEToml,
+							Expression: "MIT OR Apache-2.0",
+							Licenses:   []string{"MIT", "Apache-2.0"},
+						},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomePositive,
+			},
+		},
+		{
+			name: "invalid license declarations",
+			raw: &checker.RawResults{
+				LicenseResults: checker.LicenseData{
+					Declarations: []checker.LicenseDeclaration{
+						{
+							File:       checker.File{Path: "main.go", Offset: 1},
+							Source:     checker.LicenseDeclarationSourceHeader,
+							Expression: "Apache-2.0",
+							Licenses:   []string{"Apache-2.0"},
+						},
+						{
+							File:       checker.File{Path: "lib.py", Offset: 2},
+							Source:     checker.LicenseDeclarationSourceHeader,
+							Expression: "MIT AND",
+							Error:      "invalid SPDX license expression: unexpected end of expression",
+						},
+						{
+							File:       checker.File{Path: ".reuse/dep5", Offset: 5},
+							Source:     checker.LicenseDeclarationSourceREUSEDep5,
+							Expression: "MIT/X11",
+							Error:      "invalid SPDX license expression: unexpected \"MIT/X11\"",
+						},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNegative,
+				finding.OutcomeNegative,
+			},
+		},
+		{
+			name: "nil raw",
+			err:  uerror.ErrNil,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			findings, s, err := Run(tt.raw)
+			if !cmp.Equal(tt.err, err, cmpopts.EquateErrors()) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(tt.err, err, cmpopts.EquateErrors()))
+			}
+			if err != nil {
+				return
+			}
+			test.AssertCorrect(t, Probe, s, findings, tt.outcomes)
+		})
+	}
+}
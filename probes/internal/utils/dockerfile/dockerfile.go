@@ -0,0 +1,66 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dockerfile
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+)
+
+// Run runs the probe for a type of Dockerfile hardening issue.
+// The function creates a finding with a negative outcome for each issue of type 'issueType',
+// using 'issueText' for its message. If no issue is found, it returns a finding with
+// a positive outcome and the 'noIssueText' message. If the repository has no Dockerfiles,
+// it returns a finding with a not applicable outcome.
+func Run(r *checker.DockerfileHardeningData, fs embed.FS, probeID string, issueType checker.DockerfileIssueType,
+	issueText func(*checker.DockerfileIssue) string, noIssueText string,
+) ([]finding.Finding, string, error) {
+	if r.NumDockerfiles == 0 {
+		f, err := finding.NewWith(fs, probeID,
+			"no Dockerfiles found", nil,
+			finding.OutcomeNotApplicable)
+		if err != nil {
+			return nil, probeID, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, probeID, nil
+	}
+
+	var findings []finding.Finding
+	for i := range r.Issues {
+		issue := &r.Issues[i]
+		if issue.Type != issueType {
+			continue
+		}
+		f, err := finding.NewWith(fs, probeID, issueText(issue), issue.File.Location(),
+			finding.OutcomeNegative)
+		if err != nil {
+			return nil, probeID, fmt.Errorf("create finding: %w", err)
+		}
+		findings = append(findings, *f)
+	}
+
+	if len(findings) == 0 {
+		f, err := finding.NewWith(fs, probeID, noIssueText, nil,
+			finding.OutcomePositive)
+		if err != nil {
+			return nil, probeID, fmt.Errorf("create finding: %w", err)
+		}
+		findings = append(findings, *f)
+	}
+	return findings, probeID, nil
+}
@@ -0,0 +1,108 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dockerfile_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/dockerfileNoRemoteAdd"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/test"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+// The shared behavior is tested through one of the probes.
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	// otherIssue is not reported by the probe.
+	otherIssue := checker.DockerfileIssue{
+		Type: checker.DockerfileRunsAsRoot,
+		File: checker.File{Path: "Dockerfile", Offset: 1},
+	}
+	//nolint:govet
+	tests := []struct {
+		name     string
+		raw      *checker.RawResults
+		outcomes []finding.Outcome
+		err      error
+	}{
+		{
+			name: "no Dockerfiles",
+			raw:  &checker.RawResults{},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNotApplicable,
+			},
+		},
+		{
+			name: "no issues",
+			raw: &checker.RawResults{
+				DockerfileHardeningResults: checker.DockerfileHardeningData{
+					NumDockerfiles: 1,
+					Issues:         []checker.DockerfileIssue{otherIssue},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomePositive,
+			},
+		},
+		{
+			name: "one finding per issue",
+			raw: &checker.RawResults{
+				DockerfileHardeningResults: checker.DockerfileHardeningData{
+					NumDockerfiles: 2,
+					Issues: []checker.DockerfileIssue{
+						{
+							Type:  checker.DockerfileRemoteAdd,
+							Stage: "builder",
+							File:  checker.File{Path: "Dockerfile", Offset: 3},
+						},
+						otherIssue,
+						{
+							Type:  checker.DockerfileRemoteAdd,
+							Stage: "alpine",
+							File:  checker.File{Path: "build/Dockerfile", Offset: 8},
+						},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNegative,
+				finding.OutcomeNegative,
+			},
+		},
+		{
+			name: "nil raw",
+			err:  uerror.ErrNil,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			findings, s, err := dockerfileNoRemoteAdd.Run(tt.raw)
+			if !cmp.Equal(tt.err, err, cmpopts.EquateErrors()) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(tt.err, err, cmpopts.EquateErrors()))
+			}
+			if err != nil {
+				return
+			}
+			test.AssertCorrect(t, dockerfileNoRemoteAdd.Probe, s, findings, tt.outcomes)
+		})
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registryconfig_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/test"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+	"github.com/ossf/scorecard/v4/probes/packageRegistriesUseHTTPS"
+)
+
+// The shared behavior is tested through one of the probes.
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	//nolint:govet
+	tests := []struct {
+		name     string
+		raw      *checker.RawResults
+		outcomes []finding.Outcome
+		err      error
+	}{
+		{
+			name: "no configuration files",
+			raw:  &checker.RawResults{},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNotApplicable,
+			},
+		},
+		{
+			name: "no issues",
+			raw: &checker.RawResults{
+				DependencyConfusionResults: checker.DependencyConfusionData{
+					NumConfigFiles: 1,
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomePositive,
+			},
+		},
+		{
+			name: "one finding per issue",
+			raw: &checker.RawResults{
+				DependencyConfusionResults: checker.DependencyConfusionData{
+					NumConfigFiles: 2,
+					Issues: []checker.RegistryConfigIssue{
+						{
+							Type:      checker.RegistryConfigInsecureURL,
+							Ecosystem: "npm",
+							Registry:  "http://registry.example.com",
+							File:      checker.File{Path: ".npmrc", Offset: 1},
+						},
+						{
+							Type:      checker.RegistryConfigInsecureURL,
+							Ecosystem: "pypi",
+							Registry:  "http://pypi.example.com/simple",
+							File:      checker.File{Path: "pip.conf", Offset: 2},
+						},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNegative,
+				finding.OutcomeNegative,
+			},
+		},
+		{
+			name: "nil raw",
+			err:  uerror.ErrNil,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			findings, s, err := packageRegistriesUseHTTPS.Run(tt.raw)
+			if !cmp.Equal(tt.err, err, cmpopts.EquateErrors()) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(tt.err, err, cmpopts.EquateErrors()))
+			}
+			if err != nil {
+				return
+			}
+			test.AssertCorrect(t, packageRegistriesUseHTTPS.Probe, s, findings, tt.outcomes)
+		})
+	}
+}
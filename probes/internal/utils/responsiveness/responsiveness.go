@@ -0,0 +1,96 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package responsiveness
+
+import (
+	"embed"
+	"fmt"
+	"time"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+)
+
+const (
+	// ResponseDays is the number of days within which the maintainers
+	// are expected to respond to the issues and pull requests.
+	ResponseDays = 14
+	// ResponseHoursKey is the name of the value holding the number of
+	// hours to the first response of a maintainer.
+	ResponseHoursKey = "responseHours"
+)
+
+// Describe returns a short description of an issue or a pull request.
+func Describe(item *checker.TrackedItem) string {
+	if item.Type == checker.TrackedItemPullRequest {
+		return fmt.Sprintf("pull request #%d", item.Number)
+	}
+	return fmt.Sprintf("issue %s", item.URI)
+}
+
+// isResponseDue returns true if the maintainers should have responded to
+// the item by now: the items closed quickly, e.g. by their authors,
+// are not expected to get a response.
+func isResponseDue(item *checker.TrackedItem, now time.Time) bool {
+	end := now
+	if item.ClosedAt != nil {
+		end = *item.ClosedAt
+	}
+	return end.Sub(item.CreatedAt) >= ResponseDays*24*time.Hour
+}
+
+// FirstResponseFindings returns one finding for each item of type itemType
+// opened by an external user, positive if a maintainer responded within
+// ResponseDays. The items which are not due for a response are skipped.
+func FirstResponseFindings(fs embed.FS, probe string, items []checker.TrackedItem,
+	itemType checker.TrackedItemType, now time.Time,
+) ([]finding.Finding, error) {
+	var findings []finding.Finding
+	for i := range items {
+		item := &items[i]
+		if item.Type != itemType || !item.External {
+			continue
+		}
+		var f *finding.Finding
+		var err error
+		switch {
+		case item.FirstMaintainerResponse != nil:
+			hours := int(item.FirstMaintainerResponse.Sub(item.CreatedAt).Hours())
+			outcome := finding.OutcomePositive
+			if hours > ResponseDays*24 {
+				outcome = finding.OutcomeNegative
+			}
+			f, err = finding.NewWith(fs, probe,
+				fmt.Sprintf("%s: first maintainer response after %d hours", Describe(item), hours), nil,
+				outcome)
+			if err == nil {
+				f.Values = map[string]int{
+					ResponseHoursKey: hours,
+				}
+			}
+		case isResponseDue(item, now):
+			f, err = finding.NewWith(fs, probe,
+				fmt.Sprintf("%s: no maintainer response", Describe(item)), nil,
+				finding.OutcomeNegative)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("create finding: %w", err)
+		}
+		findings = append(findings, *f)
+	}
+	return findings, nil
+}
@@ -15,7 +15,12 @@
 package secpolicy
 
 import (
+	"embed"
+	"fmt"
+	"time"
+
 	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
 )
 
 func CountSecInfo(secInfo []checker.SecurityPolicyInformation,
@@ -51,3 +56,55 @@ func FindSecInfo(secInfo []checker.SecurityPolicyInformation,
 	}
 	return secList
 }
+
+// InformationFindings returns one finding per security policy file, positive
+// if the file contains information of the given type, or a negative finding
+// if there is no security policy file.
+func InformationFindings(fs embed.FS, probe string, raw *checker.RawResults,
+	infoType checker.SecurityPolicyInformationType, found, notFound string,
+) ([]finding.Finding, error) {
+	var findings []finding.Finding
+	policies := raw.SecurityPolicyResults.PolicyFiles
+	for i := range policies {
+		policy := &policies[i]
+		hits := FindSecInfo(policy.Information, infoType, true)
+		var f *finding.Finding
+		var err error
+		if len(hits) > 0 {
+			loc := policy.File.Location()
+			line := hits[0].InformationValue.LineNumber
+			loc.LineStart = &line
+			snippet := hits[0].InformationValue.Match
+			loc.Snippet = &snippet
+			f, err = finding.NewPositive(fs, probe, found, loc)
+		} else {
+			f, err = finding.NewNegative(fs, probe, notFound, policy.File.Location())
+		}
+		if err != nil {
+			return nil, fmt.Errorf("create finding: %w", err)
+		}
+		findings = append(findings, *f)
+	}
+
+	if len(findings) == 0 {
+		f, err := finding.NewNegative(fs, probe, "no security file to analyze", nil)
+		if err != nil {
+			return nil, fmt.Errorf("create finding: %w", err)
+		}
+		findings = append(findings, *f)
+	}
+	return findings, nil
+}
+
+// ValidSecurityTxtFiles returns the security.txt files without
+// errors which have not expired at the given time.
+func ValidSecurityTxtFiles(raw *checker.RawResults, now time.Time) []checker.SecurityTxt {
+	var files []checker.SecurityTxt
+	for i := range raw.SecurityPolicyResults.SecurityTxtFiles {
+		txt := &raw.SecurityPolicyResults.SecurityTxtFiles[i]
+		if len(txt.Errors) == 0 && txt.Expires != nil && txt.Expires.After(now) {
+			files = append(files, *txt)
+		}
+	}
+	return files
+}
@@ -0,0 +1,62 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+)
+
+// Run runs the probe for the secrets of a confidence level.
+// The function creates a finding with a negative outcome for each secret whose HighConfidence
+// field equals 'highConfidence', using 'secretText' for its message. If no secret is found,
+// it returns a finding with a positive outcome and the 'noSecretText' message.
+func Run(r *checker.SecretsData, fs embed.FS, probeID string, highConfidence bool,
+	secretText func(*checker.Secret) string, noSecretText string,
+) ([]finding.Finding, string, error) {
+	var findings []finding.Finding
+	for i := range r.Secrets {
+		secret := &r.Secrets[i]
+		if secret.HighConfidence != highConfidence {
+			continue
+		}
+		// The snippet never contains the value of the secret.
+		loc := &finding.Location{
+			Type:      secret.File.Type,
+			Path:      secret.File.Path,
+			LineStart: &secret.File.Offset,
+			LineEnd:   &secret.File.EndOffset,
+			Snippet:   &secret.File.Snippet,
+		}
+		f, err := finding.NewWith(fs, probeID, secretText(secret), loc, finding.OutcomeNegative)
+		if err != nil {
+			return nil, probeID, fmt.Errorf("create finding: %w", err)
+		}
+		findings = append(findings, *f)
+	}
+
+	if len(findings) == 0 {
+		f, err := finding.NewWith(fs, probeID, noSecretText, nil,
+			finding.OutcomePositive)
+		if err != nil {
+			return nil, probeID, fmt.Errorf("create finding: %w", err)
+		}
+		findings = append(findings, *f)
+	}
+	return findings, probeID, nil
+}
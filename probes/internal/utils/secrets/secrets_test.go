@@ -0,0 +1,123 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/hasHardcodedSecrets"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/test"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+// The shared behavior is tested through one of the probes.
+
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	// nolint:govet
+	tests := []struct {
+		name     string
+		raw      *checker.RawResults
+		outcomes []finding.Outcome
+		err      error
+	}{
+		{
+			name: "no secrets",
+			raw:  &checker.RawResults{},
+			outcomes: []finding.Outcome{
+				finding.OutcomePositive,
+			},
+		},
+		{
+			name: "secrets found",
+			raw: &checker.RawResults{
+				SecretsResults: checker.SecretsData{
+					Secrets: []checker.Secret{
+						{
+							Type:           checker.SecretAWSAccessKey,
+							HighConfidence: true,
+							File: checker.File{
+								Path:    "config.yml",
+								Offset:  1,
+								Snippet: "key: [REDACTED]",
+							},
+						},
+						{
+							Type:           checker.SecretHighEntropy,
+							HighConfidence: false,
+							File: checker.File{
+								Path:    "config.yml",
+								Offset:  2,
+								Snippet: "password: [REDACTED]",
+							},
+						},
+						{
+							Type:           checker.SecretGitHubToken,
+							HighConfidence: true,
+							File: checker.File{
+								Path:    "deploy.sh",
+								Offset:  7,
+								Snippet: "export GITHUB_TOKEN=[REDACTED]",
+							},
+						},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNegative,
+				finding.OutcomeNegative,
+			},
+		},
+		{
+			name: "only other secrets found",
+			raw: &checker.RawResults{
+				SecretsResults: checker.SecretsData{
+					Secrets: []checker.Secret{
+						{
+							Type:           checker.SecretHighEntropy,
+							HighConfidence: false,
+						},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomePositive,
+			},
+		},
+		{
+			name: "nil raw",
+			err:  uerror.ErrNil,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			findings, s, err := hasHardcodedSecrets.Run(tt.raw)
+			if !cmp.Equal(tt.err, err, cmpopts.EquateErrors()) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(tt.err, err, cmpopts.EquateErrors()))
+			}
+			if err != nil {
+				return
+			}
+			test.AssertCorrect(t, hasHardcodedSecrets.Probe, s, findings, tt.outcomes)
+		})
+	}
+}
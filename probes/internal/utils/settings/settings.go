@@ -0,0 +1,59 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package settings
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+// Setting is a repository setting checked by a probe, and the texts of its findings.
+type Setting struct {
+	// Value returns the setting, or nil if it could not be determined.
+	Value func(*checker.SecuritySettingsData) *bool
+	// Secure is the value of the setting that results in a positive outcome.
+	Secure bool
+	// Unknown, Positive and Negative are the texts of the findings.
+	Unknown, Positive, Negative string
+}
+
+// Run runs the probe for a repository setting.
+// It creates a single finding, with a positive outcome if the setting has its secure value,
+// a negative outcome if not, and OutcomeNotAvailable if the setting could not be determined.
+func Run(raw *checker.RawResults, fs embed.FS, probeID string, setting *Setting) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	value := setting.Value(&raw.SecuritySettingsResults)
+	var f *finding.Finding
+	var err error
+	switch {
+	case value == nil:
+		f, err = finding.NewWith(fs, probeID, setting.Unknown, nil, finding.OutcomeNotAvailable)
+	case *value == setting.Secure:
+		f, err = finding.NewWith(fs, probeID, setting.Positive, nil, finding.OutcomePositive)
+	default:
+		f, err = finding.NewWith(fs, probeID, setting.Negative, nil, finding.OutcomeNegative)
+	}
+	if err != nil {
+		return nil, probeID, fmt.Errorf("create finding: %w", err)
+	}
+	return []finding.Finding{*f}, probeID, nil
+}
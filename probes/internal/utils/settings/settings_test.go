@@ -0,0 +1,119 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package settings_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/clients"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/test"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+	"github.com/ossf/scorecard/v4/probes/secretScanningEnabled"
+	"github.com/ossf/scorecard/v4/probes/workflowsCannotApprovePullRequests"
+)
+
+type probeRun func(*checker.RawResults) ([]finding.Finding, string, error)
+
+// The settings are tested through a probe whose secure value is true,
+// and one whose secure value is false.
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	enabled, disabled := true, false
+	//nolint:govet
+	tests := []struct {
+		name     string
+		run      probeRun
+		probe    string
+		raw      *checker.RawResults
+		outcomes []finding.Outcome
+		err      error
+	}{
+		{
+			name:  "secure setting enabled",
+			run:   secretScanningEnabled.Run,
+			probe: secretScanningEnabled.Probe,
+			raw: &checker.RawResults{
+				SecuritySettingsResults: checker.SecuritySettingsData{
+					Settings: clients.SecuritySettings{SecretScanning: &enabled},
+				},
+			},
+			outcomes: []finding.Outcome{finding.OutcomePositive},
+		},
+		{
+			name:  "secure setting disabled",
+			run:   secretScanningEnabled.Run,
+			probe: secretScanningEnabled.Probe,
+			raw: &checker.RawResults{
+				SecuritySettingsResults: checker.SecuritySettingsData{
+					Settings: clients.SecuritySettings{SecretScanning: &disabled},
+				},
+			},
+			outcomes: []finding.Outcome{finding.OutcomeNegative},
+		},
+		{
+			name:  "insecure setting enabled",
+			run:   workflowsCannotApprovePullRequests.Run,
+			probe: workflowsCannotApprovePullRequests.Probe,
+			raw: &checker.RawResults{
+				SecuritySettingsResults: checker.SecuritySettingsData{
+					WorkflowPermissions: clients.WorkflowPermissions{CanApprovePullRequests: &enabled},
+				},
+			},
+			outcomes: []finding.Outcome{finding.OutcomeNegative},
+		},
+		{
+			name:  "insecure setting disabled",
+			run:   workflowsCannotApprovePullRequests.Run,
+			probe: workflowsCannotApprovePullRequests.Probe,
+			raw: &checker.RawResults{
+				SecuritySettingsResults: checker.SecuritySettingsData{
+					WorkflowPermissions: clients.WorkflowPermissions{CanApprovePullRequests: &disabled},
+				},
+			},
+			outcomes: []finding.Outcome{finding.OutcomePositive},
+		},
+		{
+			name:     "setting not available",
+			run:      secretScanningEnabled.Run,
+			probe:    secretScanningEnabled.Probe,
+			raw:      &checker.RawResults{},
+			outcomes: []finding.Outcome{finding.OutcomeNotAvailable},
+		},
+		{
+			name: "nil raw",
+			run:  secretScanningEnabled.Run,
+			err:  uerror.ErrNil,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			findings, s, err := tt.run(tt.raw)
+			if !cmp.Equal(tt.err, err, cmpopts.EquateErrors()) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(tt.err, err, cmpopts.EquateErrors()))
+			}
+			if err != nil {
+				return
+			}
+			test.AssertCorrect(t, tt.probe, s, findings, tt.outcomes)
+		})
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workflowsecrets
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+// Exposures describes the exposures of the secrets of the workflows checked by a probe.
+type Exposures struct {
+	// Text returns the text of the finding of an exposure.
+	Text func(e *checker.WorkflowSecretExposure) string
+	// NoneText is the text of the finding created when there are no exposures.
+	NoneText string
+	Type     checker.WorkflowSecretExposureType
+	// ThirdPartyOnly is true if only the exposures to third-party receivers are negative.
+	// The exposures to first-party receivers then have a positive outcome.
+	ThirdPartyOnly bool
+}
+
+// Run runs the probe for a type of exposure of the secrets of the workflows.
+// It creates a finding for every exposure of the type, or a positive finding if there are
+// none. The outcome is not applicable if the repository has no workflows.
+func Run(raw *checker.RawResults, fs embed.FS, probeID string,
+	exposures *Exposures,
+) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	r := &raw.WorkflowSecretsResults
+	if r.NumWorkflows == 0 {
+		f, err := finding.NewWith(fs, probeID,
+			"no workflows found", nil,
+			finding.OutcomeNotApplicable)
+		if err != nil {
+			return nil, probeID, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, probeID, nil
+	}
+
+	var findings []finding.Finding
+	for i := range r.Exposures {
+		e := &r.Exposures[i]
+		if e.Type != exposures.Type {
+			continue
+		}
+		outcome := finding.OutcomeNegative
+		if exposures.ThirdPartyOnly && !e.ThirdParty {
+			outcome = finding.OutcomePositive
+		}
+		f, err := finding.NewWith(fs, probeID, exposures.Text(e), e.File.Location(), outcome)
+		if err != nil {
+			return nil, probeID, fmt.Errorf("create finding: %w", err)
+		}
+		findings = append(findings, *f)
+	}
+
+	if len(findings) == 0 {
+		f, err := finding.NewWith(fs, probeID, exposures.NoneText, nil, finding.OutcomePositive)
+		if err != nil {
+			return nil, probeID, fmt.Errorf("create finding: %w", err)
+		}
+		findings = append(findings, *f)
+	}
+	return findings, probeID, nil
+}
+
+// SecretName returns the name of a secret in the texts of the findings.
+func SecretName(secret string) string {
+	if secret == "" {
+		return "all secrets"
+	}
+	return fmt.Sprintf("secret %s", secret)
+}
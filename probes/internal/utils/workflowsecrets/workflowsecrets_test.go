@@ -0,0 +1,111 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workflowsecrets_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/test"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/workflowsecrets"
+	"github.com/ossf/scorecard/v4/probes/workflowSecretsNotLogged"
+)
+
+// The shared behavior is tested through one of the probes,
+// whose own filter is tested by the probe.
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	//nolint:govet
+	tests := []struct {
+		name     string
+		raw      *checker.RawResults
+		outcomes []finding.Outcome
+		err      error
+	}{
+		{
+			name: "no workflows",
+			raw:  &checker.RawResults{},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNotApplicable,
+			},
+		},
+		{
+			name: "no exposures",
+			raw: &checker.RawResults{
+				WorkflowSecretsResults: checker.WorkflowSecretsData{NumWorkflows: 1},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomePositive,
+			},
+		},
+		{
+			name: "one finding per exposure",
+			raw: &checker.RawResults{
+				WorkflowSecretsResults: checker.WorkflowSecretsData{
+					NumWorkflows: 1,
+					Exposures: []checker.WorkflowSecretExposure{
+						{
+							Type:   checker.WorkflowSecretLogged,
+							Secret: "NPM_TOKEN",
+							File:   checker.File{Path: ".github/workflows/release.yml", Offset: 7},
+						},
+						{
+							Type:   checker.WorkflowSecretLogged,
+							Secret: "API_KEY",
+							File:   checker.File{Path: ".github/workflows/release.yml", Offset: 9},
+						},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNegative,
+				finding.OutcomeNegative,
+			},
+		},
+		{
+			name: "nil raw",
+			err:  uerror.ErrNil,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			findings, s, err := workflowSecretsNotLogged.Run(tt.raw)
+			if !cmp.Equal(tt.err, err, cmpopts.EquateErrors()) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(tt.err, err, cmpopts.EquateErrors()))
+			}
+			if err != nil {
+				return
+			}
+			test.AssertCorrect(t, workflowSecretsNotLogged.Probe, s, findings, tt.outcomes)
+		})
+	}
+}
+
+func TestSecretName(t *testing.T) {
+	t.Parallel()
+	if got := workflowsecrets.SecretName("NPM_TOKEN"); got != "secret NPM_TOKEN" {
+		t.Errorf("SecretName() = %q", got)
+	}
+	if got := workflowsecrets.SecretName(""); got != "all secrets" {
+		t.Errorf("SecretName() = %q", got)
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// nolint:stylecheck
+package maintainersRespondToIssues
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/responsiveness"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/test"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	monthAgo := now.AddDate(0, 0, -30)
+	oneDayAfter := monthAgo.AddDate(0, 0, 1)
+	twentyDaysAfter := monthAgo.AddDate(0, 0, 20)
+	twoDaysAgo := now.AddDate(0, 0, -2)
+	// nolint:govet
+	tests := []struct {
+		name     string
+		raw      *checker.RawResults
+		outcomes []finding.Outcome
+		hours    []int
+		err      error
+	}{
+		{
+			name: "no issues",
+			raw:  &checker.RawResults{},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNotApplicable,
+			},
+		},
+		{
+			name: "issues of maintainers and pull requests are ignored",
+			raw: &checker.RawResults{
+				MaintainedResults: checker.MaintainedData{
+					TrackedItems: []checker.TrackedItem{
+						{Type: checker.TrackedItemIssue, URI: "1", CreatedAt: monthAgo},
+						{Type: checker.TrackedItemPullRequest, Number: 2, External: true, CreatedAt: monthAgo},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNotApplicable,
+			},
+		},
+		{
+			name: "external issues",
+			raw: &checker.RawResults{
+				MaintainedResults: checker.MaintainedData{
+					TrackedItems: []checker.TrackedItem{
+						{Type: checker.TrackedItemIssue, URI: "1", External: true, CreatedAt: monthAgo, FirstMaintainerResponse: &oneDayAfter},
+						{Type: checker.TrackedItemIssue, URI: "2", External: true, CreatedAt: monthAgo, FirstMaintainerResponse: &twentyDaysAfter},
+						{Type: checker.TrackedItemIssue, URI: "3", External: true, CreatedAt: monthAgo},
+						// Not due for a response yet.
+						{Type: checker.TrackedItemIssue, URI: "4", External: true, CreatedAt: twoDaysAgo},
+						// Closed quickly, e.g., by the author.
+						{Type: checker.TrackedItemIssue, URI: "5", External: true, CreatedAt: monthAgo, ClosedAt: &oneDayAfter},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomePositive,
+				finding.OutcomeNegative,
+				finding.OutcomeNegative,
+			},
+			hours: []int{24, 480, 0},
+		},
+		{
+			name: "nil raw",
+			err:  uerror.ErrNil,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			findings, s, err := Run(tt.raw)
+			if !cmp.Equal(tt.err, err, cmpopts.EquateErrors()) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(tt.err, err, cmpopts.EquateErrors()))
+			}
+			if err != nil {
+				return
+			}
+			test.AssertCorrect(t, Probe, s, findings, tt.outcomes)
+			for i, hours := range tt.hours {
+				if got := findings[i].Values[responsiveness.ResponseHoursKey]; got != hours {
+					t.Errorf("finding %d: got %d hours, want %d", i, got, hours)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// nolint:stylecheck
+package packagedInRegistry
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+//go:embed *.yml
+var fs embed.FS
+
+const Probe = "packagedInRegistry"
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	var findings []finding.Finding
+	for i := range raw.PackagingResults.Targets {
+		t := &raw.PackagingResults.Targets[i]
+		// The registry was not looked up.
+		if t.Verified == nil {
+			continue
+		}
+		var f *finding.Finding
+		var err error
+		if *t.Verified {
+			f, err = finding.NewWith(fs, Probe,
+				fmt.Sprintf("%s package %s has the repository as source", t.Registry, t.Name), nil,
+				finding.OutcomePositive)
+		} else {
+			f, err = finding.NewWith(fs, Probe,
+				fmt.Sprintf("%s package %s does not have the repository as source", t.Registry, t.Name), nil,
+				finding.OutcomeNegative)
+		}
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		f = f.WithLocation(&finding.Location{
+			Path:      t.File.Path,
+			Type:      t.File.Type,
+			LineStart: &t.File.Offset,
+			Snippet:   &t.File.Snippet,
+		})
+		findings = append(findings, *f)
+	}
+
+	if len(findings) > 0 {
+		return findings, Probe, nil
+	}
+
+	f, err := finding.NewWith(fs, Probe,
+		"no publication target looked up in its registry", nil,
+		finding.OutcomeNotApplicable)
+	if err != nil {
+		return nil, Probe, fmt.Errorf("create finding: %w", err)
+	}
+	return []finding.Finding{*f}, Probe, nil
+}
@@ -0,0 +1,59 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// nolint:stylecheck
+package registryCredentialsNotEmbedded
+
+import (
+	"testing"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/test"
+)
+
+// The shared behavior of the probe is tested in probes/internal/utils/registryconfig:
+// only the issues of the probe are reported.
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	var issues []checker.RegistryConfigIssue
+	for _, issueType := range []checker.RegistryConfigIssueType{
+		checker.RegistryConfigUnscopedSources,
+		checker.RegistryConfigInsecureURL,
+		checker.RegistryConfigEmbeddedCredentials,
+	} {
+		issues = append(issues, checker.RegistryConfigIssue{
+			Type:      issueType,
+			Ecosystem: "npm",
+			Registry:  "http://registry.example.com",
+			File:      checker.File{Path: ".npmrc", Offset: 1},
+		})
+	}
+	raw := &checker.RawResults{
+		DependencyConfusionResults: checker.DependencyConfusionData{
+			NumConfigFiles: 1,
+			Issues:         issues,
+		},
+	}
+
+	findings, s, err := Run(raw)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	test.AssertCorrect(t, Probe, s, findings, []finding.Outcome{finding.OutcomeNegative})
+	want := "npm registry credentials stored in the configuration: http://registry.example.com"
+	if len(findings) == 1 && findings[0].Message != want {
+		t.Errorf("unexpected message: %s", findings[0].Message)
+	}
+}
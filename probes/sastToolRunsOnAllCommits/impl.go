@@ -0,0 +1,80 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// nolint:stylecheck
+package sastToolRunsOnAllCommits
+
+import (
+	"embed"
+	"fmt"
+	"strings"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+//go:embed *.yml
+var fs embed.FS
+
+const (
+	Probe = "sastToolRunsOnAllCommits"
+	// PullRequestKey is the name of the value holding the number of the pull request.
+	PullRequestKey = "pullRequest"
+)
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	r := &raw.SASTResults
+	if len(r.PullRequests) == 0 {
+		f, err := finding.NewWith(fs, Probe,
+			"no pull requests merged into dev branch", nil,
+			finding.OutcomeNotApplicable)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		return []finding.Finding{*f}, Probe, nil
+	}
+
+	var findings []finding.Finding
+	for i := range r.PullRequests {
+		pr := &r.PullRequests[i]
+		var f *finding.Finding
+		var err error
+		if len(pr.Tools) == 0 {
+			f, err = finding.NewWith(fs, Probe,
+				fmt.Sprintf("merged PR %d without SAST analysis at HEAD: %s", pr.Number, pr.HeadSHA), nil,
+				finding.OutcomeNegative)
+		} else {
+			tools := make([]string, len(pr.Tools))
+			for j, tool := range pr.Tools {
+				tools[j] = string(tool)
+			}
+			f, err = finding.NewWith(fs, Probe,
+				fmt.Sprintf("merged PR %d analyzed by %s", pr.Number, strings.Join(tools, ", ")), nil,
+				finding.OutcomePositive)
+		}
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		f.Values = map[string]int{
+			PullRequestKey: pr.Number,
+		}
+		findings = append(findings, *f)
+	}
+	return findings, Probe, nil
+}
@@ -0,0 +1,64 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// nolint:stylecheck
+package secretScanningEnabled
+
+import (
+	"testing"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/clients"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/test"
+)
+
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	secure, insecure := true, false
+	tests := []struct {
+		name     string
+		value    *bool
+		outcomes []finding.Outcome
+	}{
+		{
+			name:     "secret scanning is enabled",
+			value:    &secure,
+			outcomes: []finding.Outcome{finding.OutcomePositive},
+		},
+		{
+			name:     "secret scanning is disabled",
+			value:    &insecure,
+			outcomes: []finding.Outcome{finding.OutcomeNegative},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			raw := &checker.RawResults{
+				SecuritySettingsResults: checker.SecuritySettingsData{
+					Settings: clients.SecuritySettings{
+						SecretScanning: tt.value,
+					},
+				},
+			}
+			findings, s, err := Run(raw)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			test.AssertCorrect(t, Probe, s, findings, tt.outcomes)
+		})
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// nolint:stylecheck
+package securityPolicyDefinesEmbargo
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/test"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	// nolint:govet
+	tests := []struct {
+		name     string
+		raw      *checker.RawResults
+		outcomes []finding.Outcome
+		err      error
+	}{
+		{
+			name: "no security policy",
+			raw: &checker.RawResults{
+				SecurityPolicyResults: checker.SecurityPolicyData{},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNegative,
+			},
+		},
+		{
+			name: "information not found",
+			raw: &checker.RawResults{
+				SecurityPolicyResults: checker.SecurityPolicyData{
+					PolicyFiles: []checker.SecurityPolicyFile{
+						{
+							File: checker.File{
+								Path: "SECURITY.md",
+								Type: finding.FileTypeText,
+							},
+							Information: []checker.SecurityPolicyInformation{
+								{
+									InformationType: checker.SecurityPolicyInformationTypeLink,
+									InformationValue: checker.SecurityPolicyValueType{
+										Match:      "https://example.com",
+										LineNumber: 3,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomeNegative,
+			},
+		},
+		{
+			name: "information found",
+			raw: &checker.RawResults{
+				SecurityPolicyResults: checker.SecurityPolicyData{
+					PolicyFiles: []checker.SecurityPolicyFile{
+						{
+							File: checker.File{
+								Path: "SECURITY.md",
+								Type: finding.FileTypeText,
+							},
+							Information: []checker.SecurityPolicyInformation{
+								{
+									InformationType: checker.SecurityPolicyInformationTypeEmbargo,
+									InformationValue: checker.SecurityPolicyValueType{
+										Match:      "coordinated disclosure",
+										LineNumber: 3,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomePositive,
+			},
+		},
+		{
+			name: "multiple files",
+			raw: &checker.RawResults{
+				SecurityPolicyResults: checker.SecurityPolicyData{
+					PolicyFiles: []checker.SecurityPolicyFile{
+						{
+							File: checker.File{
+								Path: "SECURITY.md",
+								Type: finding.FileTypeText,
+							},
+							Information: []checker.SecurityPolicyInformation{
+								{
+									InformationType: checker.SecurityPolicyInformationTypeEmbargo,
+									InformationValue: checker.SecurityPolicyValueType{
+										Match:      "coordinated disclosure",
+										LineNumber: 3,
+									},
+								},
+							},
+						},
+						{
+							File: checker.File{
+								Path: "SECURITY.md",
+								Type: finding.FileTypeText,
+							},
+						},
+					},
+				},
+			},
+			outcomes: []finding.Outcome{
+				finding.OutcomePositive,
+				finding.OutcomeNegative,
+			},
+		},
+		{
+			name: "nil raw",
+			err:  uerror.ErrNil,
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			findings, s, err := Run(tt.raw)
+			if !cmp.Equal(tt.err, err, cmpopts.EquateErrors()) {
+				t.Errorf("mismatch (-want +got):\n%s", cmp.Diff(tt.err, err, cmpopts.EquateErrors()))
+			}
+			if err != nil {
+				return
+			}
+			test.AssertCorrect(t, Probe, s, findings, tt.outcomes)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// nolint:stylecheck
+package securityPolicyHasPrivateReportingChannel
+
+import (
+	"embed"
+	"fmt"
+	"time"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/secpolicy"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+//go:embed *.yml
+var fs embed.FS
+
+const Probe = "securityPolicyHasPrivateReportingChannel"
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+	findings, err := secpolicy.InformationFindings(fs, Probe, raw,
+		checker.SecurityPolicyInformationTypePrivateReporting,
+		"security policy provides a private reporting channel", "no private reporting channel in security policy")
+	if err != nil {
+		return nil, Probe, err
+	}
+
+	if enabled := raw.SecurityPolicyResults.PrivateVulnerabilityReporting; enabled != nil && *enabled {
+		f, err := finding.NewPositive(fs, Probe, "private vulnerability reporting is enabled", nil)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		findings = append(findings, *f)
+	}
+
+	for _, txt := range secpolicy.ValidSecurityTxtFiles(raw, time.Now()) {
+		f, err := finding.NewPositive(fs, Probe, "security.txt provides a contact", txt.File.Location())
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		findings = append(findings, *f)
+	}
+	return findings, Probe, nil
+}
@@ -0,0 +1,56 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// nolint:stylecheck
+package securityPolicyProvidesEncryptionKey
+
+import (
+	"embed"
+	"fmt"
+	"time"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/secpolicy"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+//go:embed *.yml
+var fs embed.FS
+
+const Probe = "securityPolicyProvidesEncryptionKey"
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+	findings, err := secpolicy.InformationFindings(fs, Probe, raw,
+		checker.SecurityPolicyInformationTypeEncryptionKey,
+		"security policy provides an encryption key", "no encryption key in security policy")
+	if err != nil {
+		return nil, Probe, err
+	}
+
+	for _, txt := range secpolicy.ValidSecurityTxtFiles(raw, time.Now()) {
+		if len(txt.Encryption) == 0 {
+			continue
+		}
+		f, err := finding.NewPositive(fs, Probe, "security.txt provides an encryption key", txt.File.Location())
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		findings = append(findings, *f)
+	}
+	return findings, Probe, nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// nolint:stylecheck
+package securityTxtValid
+
+import (
+	"embed"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/uerror"
+)
+
+//go:embed *.yml
+var fs embed.FS
+
+const Probe = "securityTxtValid"
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	if raw == nil {
+		return nil, "", fmt.Errorf("%w: raw", uerror.ErrNil)
+	}
+
+	now := time.Now()
+	var findings []finding.Finding
+	for i := range raw.SecurityPolicyResults.SecurityTxtFiles {
+		txt := &raw.SecurityPolicyResults.SecurityTxtFiles[i]
+		var f *finding.Finding
+		var err error
+		switch {
+		case len(txt.Errors) > 0:
+			f, err = finding.NewNegative(fs, Probe,
+				fmt.Sprintf("invalid security.txt: %s", strings.Join(txt.Errors, ", ")), txt.File.Location())
+		case txt.Expires.Before(now):
+			f, err = finding.NewNegative(fs, Probe,
+				fmt.Sprintf("security.txt expired on %s", txt.Expires.Format("2006-01-02")), txt.File.Location())
+		default:
+			f, err = finding.NewPositive(fs, Probe,
+				fmt.Sprintf("security.txt valid until %s", txt.Expires.Format("2006-01-02")), txt.File.Location())
+		}
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		findings = append(findings, *f)
+	}
+
+	if len(findings) == 0 {
+		f, err := finding.NewWith(fs, Probe, "no security.txt file", nil, finding.OutcomeNotApplicable)
+		if err != nil {
+			return nil, Probe, fmt.Errorf("create finding: %w", err)
+		}
+		findings = append(findings, *f)
+	}
+	return findings, Probe, nil
+}
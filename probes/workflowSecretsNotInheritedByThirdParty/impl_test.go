@@ -0,0 +1,101 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// nolint:stylecheck
+package workflowSecretsNotInheritedByThirdParty
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/test"
+)
+
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		exposures []checker.WorkflowSecretExposure
+		outcomes  []finding.Outcome
+		messages  []string
+	}{
+		{
+			name: "secrets inherited by a third-party workflow",
+			exposures: []checker.WorkflowSecretExposure{
+				{
+					Type:       checker.WorkflowSecretInherited,
+					Receiver:   "other-org/workflows/.github/workflows/release.yml@v1",
+					ThirdParty: true,
+				},
+			},
+			outcomes: []finding.Outcome{finding.OutcomeNegative},
+			messages: []string{
+				"secrets inherited by third-party workflow other-org/workflows/.github/workflows/release.yml@v1",
+			},
+		},
+		{
+			name: "secrets inherited by a first-party workflow",
+			exposures: []checker.WorkflowSecretExposure{
+				{
+					Type:     checker.WorkflowSecretInherited,
+					Receiver: "ossf/workflows/.github/workflows/release.yml@v1",
+				},
+			},
+			outcomes: []finding.Outcome{finding.OutcomePositive},
+			messages: []string{
+				"secrets inherited by first-party workflow ossf/workflows/.github/workflows/release.yml@v1",
+			},
+		},
+		{
+			name: "secret passed explicitly to a third-party workflow",
+			exposures: []checker.WorkflowSecretExposure{
+				{
+					Type:       checker.WorkflowSecretUnpinnedReceiver,
+					Secret:     "NPM_TOKEN",
+					Receiver:   "other-org/workflows/.github/workflows/release.yml@v1",
+					ThirdParty: true,
+				},
+			},
+			outcomes: []finding.Outcome{finding.OutcomePositive},
+			messages: []string{"no secrets inherited by reusable workflows"},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			raw := &checker.RawResults{
+				WorkflowSecretsResults: checker.WorkflowSecretsData{
+					NumWorkflows: 1,
+					Exposures:    tt.exposures,
+				},
+			}
+			findings, s, err := Run(raw)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			test.AssertCorrect(t, Probe, s, findings, tt.outcomes)
+			var messages []string
+			for i := range findings {
+				messages = append(messages, findings[i].Message)
+			}
+			if diff := cmp.Diff(tt.messages, messages); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
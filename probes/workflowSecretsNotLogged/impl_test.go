@@ -0,0 +1,85 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// nolint:stylecheck
+package workflowSecretsNotLogged
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/test"
+)
+
+func Test_Run(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		exposures []checker.WorkflowSecretExposure
+		outcomes  []finding.Outcome
+		messages  []string
+	}{
+		{
+			name: "secret printed by a script",
+			exposures: []checker.WorkflowSecretExposure{
+				{Type: checker.WorkflowSecretLogged, Secret: "NPM_TOKEN"},
+			},
+			outcomes: []finding.Outcome{finding.OutcomeNegative},
+			messages: []string{"secret NPM_TOKEN printed to the logs"},
+		},
+		{
+			name: "all secrets printed by a script",
+			exposures: []checker.WorkflowSecretExposure{
+				{Type: checker.WorkflowSecretLogged},
+			},
+			outcomes: []finding.Outcome{finding.OutcomeNegative},
+			messages: []string{"all secrets printed to the logs"},
+		},
+		{
+			name: "secret used by a script without printing it",
+			exposures: []checker.WorkflowSecretExposure{
+				{Type: checker.WorkflowSecretForkTrigger, Secret: "NPM_TOKEN"},
+			},
+			outcomes: []finding.Outcome{finding.OutcomePositive},
+			messages: []string{"no secrets printed to the logs"},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt // Re-initializing variable so it is not changed while executing the closure below
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			raw := &checker.RawResults{
+				WorkflowSecretsResults: checker.WorkflowSecretsData{
+					NumWorkflows: 1,
+					Exposures:    tt.exposures,
+				},
+			}
+			findings, s, err := Run(raw)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			test.AssertCorrect(t, Probe, s, findings, tt.outcomes)
+			var messages []string
+			for i := range findings {
+				messages = append(messages, findings[i].Message)
+			}
+			if diff := cmp.Diff(tt.messages, messages); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
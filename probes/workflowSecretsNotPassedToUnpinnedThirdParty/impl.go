@@ -0,0 +1,49 @@
+// Copyright 2023 OpenSSF Scorecard Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// nolint:stylecheck
+package workflowSecretsNotPassedToUnpinnedThirdParty
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/ossf/scorecard/v4/checker"
+	"github.com/ossf/scorecard/v4/finding"
+	"github.com/ossf/scorecard/v4/probes/internal/utils/workflowsecrets"
+)
+
+//go:embed *.yml
+var fs embed.FS
+
+const Probe = "workflowSecretsNotPassedToUnpinnedThirdParty"
+
+// Actions and reusable workflows of the same owner are updated by the
+// project itself: passing them secrets is not flagged even if they are not pinned.
+var exposures = workflowsecrets.Exposures{
+	Type: checker.WorkflowSecretUnpinnedReceiver,
+	Text: func(e *checker.WorkflowSecretExposure) string {
+		if e.ThirdParty {
+			return fmt.Sprintf("%s passed to unpinned third-party %s", workflowsecrets.SecretName(e.Secret), e.Receiver)
+		}
+		return fmt.Sprintf("%s passed to unpinned first-party %s", workflowsecrets.SecretName(e.Secret), e.Receiver)
+	},
+	NoneText:       "no secrets passed to unpinned third-party actions or workflows",
+	ThirdPartyOnly: true,
+}
+
+func Run(raw *checker.RawResults) ([]finding.Finding, string, error) {
+	//nolint:wrapcheck
+	return workflowsecrets.Run(raw, fs, Probe, &exposures)
+}
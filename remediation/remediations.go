@@ -17,6 +17,7 @@ package remediation
 import (
 	"errors"
 	"fmt"
+	"path"
 	"strings"
 
 	"github.com/google/go-containerregistry/pkg/crane"
@@ -32,6 +33,10 @@ var (
 	//nolint
 	workflowMarkdown  = "update your workflow using [https://app.stepsecurity.io](https://app.stepsecurity.io/secureworkflow/%s/%s/%s?enable=%s)"
 	dockerfilePinText = "pin your Docker image by updating %[1]s to %[1]s@%s"
+	//nolint
+	terraformModulePinText = "pin the module %s by commit SHA using `?ref=<commit SHA>` for git sources, or by an exact `version` for registry modules"
+	//nolint
+	terraformProviderPinText = "commit the provider hashes of %s to %s using `terraform providers lock` or `tofu providers lock`"
 )
 
 // TODO fix how this info makes it checks/evaluation.
@@ -120,3 +125,34 @@ func CreateDockerfilePinningRemediation(dep *checker.Dependency, digester Digest
 		Markdown: markdown,
 	}
 }
+
+// CreateTerraformModulePinningRemediation create remediation for pinning Terraform modules.
+func CreateTerraformModulePinningRemediation(dep *checker.Dependency) *rule.Remediation {
+	if dep.Name == nil || *dep.Name == "" {
+		return nil
+	}
+
+	text := fmt.Sprintf(terraformModulePinText, *dep.Name)
+	markdown := text
+
+	return &rule.Remediation{
+		Text:     text,
+		Markdown: markdown,
+	}
+}
+
+// CreateTerraformProviderPinningRemediation create remediation for pinning Terraform providers.
+func CreateTerraformProviderPinningRemediation(dep *checker.Dependency) *rule.Remediation {
+	if dep.Name == nil || *dep.Name == "" || dep.Location == nil {
+		return nil
+	}
+
+	lockfile := path.Join(path.Dir(dep.Location.Path), ".terraform.lock.hcl")
+	text := fmt.Sprintf(terraformProviderPinText, *dep.Name, lockfile)
+	markdown := text
+
+	return &rule.Remediation{
+		Text:     text,
+		Markdown: markdown,
+	}
+}
@@ -192,3 +192,52 @@ func TestCreateWorkflowPinningRemediation(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateTerraformPinningRemediation(t *testing.T) {
+	t.Parallel()
+
+	//nolint:govet
+	tests := []struct {
+		name     string
+		dep      checker.Dependency
+		module   *rule.Remediation
+		provider *rule.Remediation
+	}{
+		{
+			name: "valid input",
+			dep: checker.Dependency{
+				Name:     asPointer("hashicorp/aws"),
+				Location: &checker.File{Path: "infra/main.tf"},
+			},
+			module: &rule.Remediation{
+				Text:     fmt.Sprintf(terraformModulePinText, "hashicorp/aws"),
+				Markdown: fmt.Sprintf(terraformModulePinText, "hashicorp/aws"),
+			},
+			provider: &rule.Remediation{
+				Text:     fmt.Sprintf(terraformProviderPinText, "hashicorp/aws", "infra/.terraform.lock.hcl"),
+				Markdown: fmt.Sprintf(terraformProviderPinText, "hashicorp/aws", "infra/.terraform.lock.hcl"),
+			},
+		},
+		{
+			name: "empty name",
+			dep: checker.Dependency{
+				Location: &checker.File{Path: "main.tf"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got := CreateTerraformModulePinningRemediation(&tt.dep)
+			if !cmp.Equal(got, tt.module) {
+				t.Errorf(cmp.Diff(got, tt.module))
+			}
+			got = CreateTerraformProviderPinningRemediation(&tt.dep)
+			if !cmp.Equal(got, tt.provider) {
+				t.Errorf(cmp.Diff(got, tt.provider))
+			}
+		})
+	}
+}